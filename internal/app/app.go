@@ -12,15 +12,31 @@ import (
 	"github.com/Everest13/fin-aggregator-service/internal/config"
 	"github.com/Everest13/fin-aggregator-service/internal/server"
 	"github.com/Everest13/fin-aggregator-service/internal/server/handler"
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	"github.com/Everest13/fin-aggregator-service/internal/service/alert"
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
 	"github.com/Everest13/fin-aggregator-service/internal/service/bank"
+	"github.com/Everest13/fin-aggregator-service/internal/service/cache"
 	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/classifier"
+	"github.com/Everest13/fin-aggregator-service/internal/service/event"
+	"github.com/Everest13/fin-aggregator-service/internal/service/goal"
+	"github.com/Everest13/fin-aggregator-service/internal/service/household"
+	"github.com/Everest13/fin-aggregator-service/internal/service/insight"
 	"github.com/Everest13/fin-aggregator-service/internal/service/monzo"
+	"github.com/Everest13/fin-aggregator-service/internal/service/networth"
+	"github.com/Everest13/fin-aggregator-service/internal/service/report"
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
 	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
 	"github.com/Everest13/fin-aggregator-service/internal/service/uploader"
 	"github.com/Everest13/fin-aggregator-service/internal/service/user"
+	"github.com/Everest13/fin-aggregator-service/internal/service/webhook"
 	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
 )
 
+// defaultReportInterval is how often the scheduled report job checks for due reports.
+const defaultReportInterval = 6 * time.Hour
+
 type App struct {
 	cfg                 *config.Config
 	dBPool              *pgxpool.Pool
@@ -32,6 +48,19 @@ type App struct {
 	userService         *user.Service
 	categoryService     *category.Service
 	uploaderService     *uploader.Service
+	insightService      *insight.Service
+	householdService    *household.Service
+	accountService      *account.Service
+	balanceService      *balance.Service
+	networthService     *networth.Service
+	goalService         *goal.Service
+	reportService       *report.Service
+	alertService        *alert.Service
+	webhookService      *webhook.Service
+	eventService        *event.Service
+	ruleService         *rule.Service
+	classifierService   *classifier.Service
+	cacheService        *cache.Service
 }
 
 func NewApp(ctx context.Context) (*App, error) {
@@ -45,6 +74,16 @@ func NewApp(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run() error {
+	go a.eventService.Run(context.Background())
+	go a.webhookService.RunWorker(context.Background())
+	go a.ruleService.RunHitCounter(context.Background())
+	go a.classifierService.RunTrainer(context.Background())
+	go a.cacheService.Run(context.Background())
+
+	if a.cfg.Report.OutputDir != "" {
+		go a.reportService.RunScheduler(context.Background(), a.cfg.Report.OutputDir, a.cfg.Report.Interval)
+	}
+
 	return a.server.Run()
 }
 
@@ -95,6 +134,15 @@ func (a *App) initConfig() error {
 		return fmt.Errorf("failed to parse dBPool max conns lifetime duration config: %w", err)
 	}
 
+	reportInterval := defaultReportInterval
+	if interval := viper.GetString(config.ReportInterval); interval != "" {
+		reportInterval, err = time.ParseDuration(interval)
+		if err != nil {
+			logger.Error("failed to parse report interval", err)
+			return fmt.Errorf("failed to parse report interval duration config: %w", err)
+		}
+	}
+
 	a.cfg = &config.Config{
 		GRPC: config.GRPCConfig{
 			Port:    viper.GetString(config.GRPCPort),
@@ -122,6 +170,17 @@ func (a *App) initConfig() error {
 			MinCons:        viper.GetInt32(config.DBMinCons),
 			MaxConLifetime: dBMaxConLifetime,
 		},
+		Report: config.ReportConfig{
+			OutputDir: viper.GetString(config.ReportOutputDir),
+			Interval:  reportInterval,
+		},
+		SMTP: config.SMTPConfig{
+			Host:     viper.GetString(config.SMTPHost),
+			Port:     viper.GetString(config.SMTPPort),
+			Username: viper.GetString(config.SMTPUsername),
+			Password: viper.GetString(config.SMTPPassword),
+			From:     viper.GetString(config.SMTPFrom),
+		},
 	}
 
 	return nil
@@ -135,6 +194,18 @@ func (a *App) initImpl() {
 		a.userService,
 		a.uploaderService,
 		a.monzoService,
+		a.insightService,
+		a.householdService,
+		a.accountService,
+		a.balanceService,
+		a.networthService,
+		a.goalService,
+		a.reportService,
+		a.alertService,
+		a.webhookService,
+		a.ruleService,
+		a.classifierService,
+		a.cacheService,
 	)
 }
 
@@ -207,14 +278,72 @@ func (a *App) initService(ctx context.Context) error {
 		return err
 	}
 
-	a.transactionService = transaction.NewService(a.dBPool, a.categoryService)
+	a.accountService = account.NewService(a.dBPool, a.bankService)
+	a.balanceService = balance.NewService(a.dBPool, a.accountService)
+
+	a.eventService = event.NewService(a.dBPool)
+
+	a.transactionService = transaction.NewService(a.dBPool, a.categoryService, a.eventService)
 	err = a.transactionService.Initialize(ctx)
 	if err != nil {
 		logger.Error("failed to initialize transaction service stores", err)
 		return err
 	}
 
-	a.uploaderService = uploader.NewService(a.dBPool, a.bankService, a.transactionService, a.categoryService)
+	a.classifierService = classifier.NewService(a.dBPool, a.categoryService, a.transactionService)
+	err = a.classifierService.Initialize(ctx)
+	if err != nil {
+		logger.Error("failed to initialize categorization model", err)
+		return err
+	}
+
+	a.ruleService = rule.NewService(a.dBPool, a.categoryService, a.transactionService, a.classifierService)
+	err = a.ruleService.Initialize(ctx)
+	if err != nil {
+		logger.Error("failed to initialize categorization rule store", err)
+		return err
+	}
+	a.categoryService.OnChange(func(ctx context.Context) {
+		if err := a.ruleService.Reload(ctx); err != nil {
+			logger.Error("failed to reload categorization rules", err)
+		}
+	})
+
+	a.alertService = alert.NewService(a.dBPool, &alert.SMTPCfg{
+		Host:     a.cfg.SMTP.Host,
+		Port:     a.cfg.SMTP.Port,
+		Username: a.cfg.SMTP.Username,
+		Password: a.cfg.SMTP.Password,
+		From:     a.cfg.SMTP.From,
+	},
+		a.cfg.HTTP.ClientTimeout,
+		a.categoryService,
+		a.accountService,
+		a.balanceService,
+	)
+
+	a.webhookService = webhook.NewService(a.dBPool, a.cfg.HTTP.ClientTimeout)
+
+	a.eventService.Subscribe("alert.transactions", a.alertService.HandleTransactionsCreated, event.TransactionsCreatedType)
+	a.eventService.Subscribe("alert.import-failed", a.alertService.HandleImportFailed, event.ImportFailedType)
+	a.eventService.Subscribe("rule.suggestions", a.ruleService.HandleTransactionRecategorized, event.TransactionRecategorizedType)
+	a.eventService.Subscribe("webhook", a.webhookService.HandleEvent,
+		event.TransactionsCreatedType,
+		event.TransactionUpdatedType,
+		event.ImportCompletedType,
+		event.ImportFailedType,
+	)
+
+	a.uploaderService = uploader.NewService(
+		a.dBPool,
+		a.bankService,
+		a.accountService,
+		a.balanceService,
+		a.transactionService,
+		a.categoryService,
+		a.eventService,
+		a.ruleService,
+	)
 	err = a.uploaderService.Initialize(ctx)
 	if err != nil {
 		logger.Error("failed to initialize csv process service stores", err)
@@ -229,7 +358,28 @@ func (a *App) initService(ctx context.Context) error {
 		a.cfg.HTTP.ClientTimeout,
 		a.transactionService,
 		a.categoryService,
+		a.accountService,
+		a.balanceService,
+		a.eventService,
+		a.ruleService,
 	)
 
+	a.insightService = insight.NewService(a.dBPool, a.categoryService)
+
+	a.householdService = household.NewService(a.dBPool, a.transactionService)
+
+	a.networthService = networth.NewService(a.dBPool, a.accountService, a.balanceService)
+
+	a.goalService = goal.NewService(a.dBPool, a.accountService, a.categoryService, a.balanceService)
+
+	a.reportService = report.NewService(a.transactionService, a.categoryService)
+
+	a.cacheService = cache.NewService(a.dBPool)
+	a.cacheService.Register("bank", a.bankService.Reload, "bank", "bank_import_method")
+	a.cacheService.Register("category", a.categoryService.Reload, "category", "category_keyword", "provider_category_mapping")
+	a.cacheService.Register("header_mapping", a.uploaderService.ReloadHeaderMappings, "bank_header", "bank_header_mapping")
+	a.cacheService.Register("categorization_rule", a.ruleService.Reload, "categorization_rule")
+	a.cacheService.Register("categorization_model", a.classifierService.Reload, "categorization_model")
+
 	return nil
 }
@@ -25,6 +25,13 @@ const (
 	DBMaxCons           = "database.max_cons"
 	DBMinCons           = "database.min_cons"
 	DBMaxConLifetime    = "database.max_con_lifetime"
+	ReportOutputDir     = "report.output_dir"
+	SMTPHost            = "smtp.host"
+	SMTPPort            = "smtp.port"
+	SMTPUsername        = "smtp.username"
+	SMTPPassword        = "SMTP_PASSWORD"
+	SMTPFrom            = "smtp.from"
+	ReportInterval      = "report.interval"
 )
 
 type Monzo struct {
@@ -57,11 +64,27 @@ type DBConfig struct {
 	MaxConLifetime time.Duration
 }
 
+// ReportConfig enables the scheduled report job when OutputDir is set.
+type ReportConfig struct {
+	OutputDir string
+	Interval  time.Duration
+}
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
 type Config struct {
-	GRPC  GRPCConfig
-	HTTP  HTTPConfig
-	Monzo Monzo
-	DB    DBConfig
+	GRPC   GRPCConfig
+	HTTP   HTTPConfig
+	Monzo  Monzo
+	DB     DBConfig
+	Report ReportConfig
+	SMTP   SMTPConfig
 }
 
 func LoadValues() error {
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) AcceptCategorySuggestion(ctx context.Context, req *pb.AcceptCategorySuggestionRequest) (*pb.AcceptCategorySuggestionResponse, error) {
+	accepted, reassigned, err := f.ruleService.AcceptSuggestion(ctx, &rule.SuggestionAcceptData{
+		ID:                 req.GetSuggestionId(),
+		Kind:               mapPbToCategorySuggestionKind(req.GetKind()),
+		Pattern:            req.Pattern,
+		ApplyRetroactively: req.GetApplyRetroactively(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AcceptCategorySuggestionResponse{
+		Suggestion:             convertCategorySuggestionToPb(accepted),
+		ReassignedTransactions: reassigned,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListAccount(ctx context.Context, req *pb.ListAccountRequest) (*pb.ListAccountResponse, error) {
+	accounts, err := f.accountService.AccountList(ctx, &account.AccountFilter{
+		UserID: req.UserId,
+		BankID: req.BankId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Account, len(accounts))
+	for i := range accounts {
+		res[i] = convertAccountToPb(&accounts[i])
+	}
+
+	return &pb.ListAccountResponse{
+		Accounts: res,
+	}, nil
+}
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListAccountType(_ context.Context, _ *pb.ListAccountTypeRequest) (*pb.ListAccountTypeResponse, error) {
+	types := f.accountService.GetAccountTypeList()
+
+	res := make([]pb.AccountType, 0, len(types))
+	for _, t := range types {
+		res = append(res, mapAccountTypeToPb(t))
+	}
+
+	return &pb.ListAccountTypeResponse{
+		Type: res,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/networth"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) AddAssetValuation(ctx context.Context, req *pb.AddAssetValuationRequest) (*pb.AddAssetValuationResponse, error) {
+	valuation := &networth.Valuation{
+		AssetID: req.GetAssetId(),
+		Value:   req.GetValue(),
+		Note:    req.Note,
+	}
+	if req.ValuationDate != nil {
+		valuation.ValuationDate = req.GetValuationDate().AsTime()
+	}
+
+	saved, err := f.networthService.AddValuation(ctx, valuation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddAssetValuationResponse{
+		Valuation: convertAssetValuationToPb(saved),
+	}, nil
+}
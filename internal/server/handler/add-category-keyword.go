@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) AddCategoryKeyword(ctx context.Context, req *pb.AddCategoryKeywordRequest) (*pb.AddCategoryKeywordResponse, error) {
+	created, err := f.categoryService.AddKeyword(ctx, &category.CategoryKeyword{
+		CategoryID: req.GetCategoryId(),
+		Name:       req.GetName(),
+		UserID:     req.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddCategoryKeywordResponse{
+		Keyword: convertCategoryKeywordToPb(created),
+	}, nil
+}
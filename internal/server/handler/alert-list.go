@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/alert"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (f *FinAggregatorServer) ListAlert(ctx context.Context, req *pb.ListAlertRequest) (*pb.ListAlertResponse, error) {
+	alerts, err := f.alertService.AlertList(ctx, &alert.AlertFilter{
+		RuleID: req.RuleId,
+		Limit:  req.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Alert, len(alerts))
+	for i, a := range alerts {
+		res[i] = &pb.Alert{
+			Id:            a.ID,
+			RuleId:        a.RuleID,
+			Message:       a.Message,
+			TransactionId: a.TransactionID,
+			Delivered:     a.Delivered,
+			DeliveryError: a.DeliveryError,
+			CreatedAt:     timestamppb.New(a.CreatedAt),
+		}
+	}
+
+	return &pb.ListAlertResponse{
+		Alerts: res,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/alert"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListAlertRule(ctx context.Context, req *pb.ListAlertRuleRequest) (*pb.ListAlertRuleResponse, error) {
+	rules, err := f.alertService.RuleList(ctx, &alert.RuleFilter{UserID: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.AlertRule, len(rules))
+	for i := range rules {
+		res[i] = convertAlertRuleToPb(&rules[i])
+	}
+
+	return &pb.ListAlertRuleResponse{
+		Rules: res,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/networth"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListAsset(ctx context.Context, req *pb.ListAssetRequest) (*pb.ListAssetResponse, error) {
+	assets, err := f.networthService.AssetList(ctx, &networth.AssetFilter{UserID: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Asset, len(assets))
+	for i := range assets {
+		res[i] = convertAssetToPb(&assets[i])
+	}
+
+	return &pb.ListAssetResponse{
+		Assets: res,
+	}, nil
+}
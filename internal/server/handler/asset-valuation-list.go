@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListAssetValuation(ctx context.Context, req *pb.ListAssetValuationRequest) (*pb.ListAssetValuationResponse, error) {
+	valuations, err := f.networthService.ValuationList(ctx, req.GetAssetId())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.AssetValuation, len(valuations))
+	for i := range valuations {
+		res[i] = convertAssetValuationToPb(&valuations[i])
+	}
+
+	return &pb.ListAssetValuationResponse{
+		Valuations: res,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListBalanceSnapshot(ctx context.Context, req *pb.ListBalanceSnapshotRequest) (*pb.ListBalanceSnapshotResponse, error) {
+	snapshots, err := f.balanceService.SnapshotList(ctx, convertSnapshotFilter(req.GetAccountId(), req.GetFrom(), req.GetTo()))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.BalanceSnapshot, len(snapshots))
+	for i := range snapshots {
+		res[i] = convertBalanceSnapshotToPb(&snapshots[i])
+	}
+
+	return &pb.ListBalanceSnapshotResponse{
+		Snapshots: res,
+	}, nil
+}
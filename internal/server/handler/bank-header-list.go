@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListBankHeader(ctx context.Context, req *pb.ListBankHeaderRequest) (*pb.ListBankHeaderResponse, error) {
+	headers, missing, err := f.uploaderService.HeaderMappingList(ctx, req.GetBankId())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.BankHeader, len(headers))
+	for i := range headers {
+		res[i] = convertBankHeaderToPb(&headers[i])
+	}
+
+	return &pb.ListBankHeaderResponse{
+		Headers:               res,
+		MissingRequiredFields: convertTransactionFieldsToPb(missing),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListCategorizationRule(ctx context.Context, req *pb.ListCategorizationRuleRequest) (*pb.ListCategorizationRuleResponse, error) {
+	rules, err := f.ruleService.RuleList(ctx, &rule.RuleFilter{
+		MaxHitCount: req.MaxHitCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.CategorizationRule, len(rules))
+	for i := range rules {
+		res[i] = convertCategorizationRuleToPb(&rules[i])
+	}
+
+	return &pb.ListCategorizationRuleResponse{
+		Rules: res,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListCategoryKeyword(ctx context.Context, req *pb.ListCategoryKeywordRequest) (*pb.ListCategoryKeywordResponse, error) {
+	categoryID := req.GetCategoryId()
+	keywords, err := f.categoryService.KeywordList(ctx, &categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.CategoryKeyword, len(keywords))
+	for i := range keywords {
+		res[i] = convertCategoryKeywordToPb(&keywords[i])
+	}
+
+	return &pb.ListCategoryKeywordResponse{
+		Keywords: res,
+	}, nil
+}
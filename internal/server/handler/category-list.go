@@ -5,8 +5,19 @@ import (
 	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
 )
 
-func (f *FinAggregatorServer) ListCategory(ctx context.Context, _ *pb.ListCategoryRequest) (*pb.ListCategoryResponse, error) {
-	categories, err := f.categoryService.CategoryList(ctx)
+func (f *FinAggregatorServer) ListCategory(ctx context.Context, req *pb.ListCategoryRequest) (*pb.ListCategoryResponse, error) {
+	if req.GetTree() {
+		nodes, err := f.categoryService.CategoryTree(ctx, req.GetIncludeArchived(), req.UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.ListCategoryResponse{
+			Category: convertCategoryTreeToPb(nodes),
+		}, nil
+	}
+
+	categories, err := f.categoryService.CategoryList(ctx, req.GetIncludeArchived(), req.UserId)
 	if err != nil {
 		return nil, err
 	}
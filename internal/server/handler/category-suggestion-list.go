@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListCategorySuggestion(ctx context.Context, req *pb.ListCategorySuggestionRequest) (*pb.ListCategorySuggestionResponse, error) {
+	filter := &rule.SuggestionFilter{}
+	if req.GetStatus() != pb.CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_UNSPECIFIED {
+		suggestionStatus := mapPbToCategorySuggestionStatus(req.GetStatus())
+		filter.Status = &suggestionStatus
+	}
+
+	suggestions, err := f.ruleService.SuggestionList(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.CategorySuggestion, len(suggestions))
+	for i := range suggestions {
+		res[i] = convertCategorySuggestionToPb(&suggestions[i])
+	}
+
+	return &pb.ListCategorySuggestionResponse{
+		Suggestions: res,
+	}, nil
+}
@@ -1,10 +1,25 @@
 package handler
 
 import (
+	"strconv"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	"github.com/Everest13/fin-aggregator-service/internal/service/alert"
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
 	"github.com/Everest13/fin-aggregator-service/internal/service/bank"
 	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/classifier"
+	"github.com/Everest13/fin-aggregator-service/internal/service/goal"
+	"github.com/Everest13/fin-aggregator-service/internal/service/household"
+	"github.com/Everest13/fin-aggregator-service/internal/service/insight"
+	"github.com/Everest13/fin-aggregator-service/internal/service/networth"
+	"github.com/Everest13/fin-aggregator-service/internal/service/report"
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
 	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Everest13/fin-aggregator-service/internal/service/uploader"
+	csvParser "github.com/Everest13/fin-aggregator-service/internal/service/uploader/csv-parser"
 	"github.com/Everest13/fin-aggregator-service/internal/service/user"
+	"github.com/Everest13/fin-aggregator-service/internal/service/webhook"
 	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -53,16 +68,88 @@ func convertUserListToPb(users []user.User) []*pb.User {
 
 func convertCategoryListToPb(categories []category.Category) []*pb.Category {
 	res := make([]*pb.Category, len(categories))
-	for i, c := range categories {
-		res[i] = &pb.Category{
-			Id:   c.ID,
-			Name: c.Name,
-		}
+	for i := range categories {
+		res[i] = convertCategoryToPb(&categories[i])
+	}
+
+	return res
+}
+
+func convertCategoryTreeToPb(nodes []category.CategoryNode) []*pb.Category {
+	res := make([]*pb.Category, len(nodes))
+	for i := range nodes {
+		res[i] = convertCategoryToPb(&nodes[i].Category)
+		res[i].Children = convertCategoryTreeToPb(nodes[i].Children)
 	}
 
 	return res
 }
 
+func convertCategoryToPb(c *category.Category) *pb.Category {
+	return &pb.Category{
+		Id:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		Archived:    c.Archived,
+		ParentId:    c.ParentID,
+		Kind:        mapCategoryKindToPb(c.Kind),
+		UserId:      c.UserID,
+	}
+}
+
+func mapCategoryKindToPb(kind category.Kind) pb.CategoryKind {
+	switch kind {
+	case category.ExpenseKind:
+		return pb.CategoryKind_CATEGORY_KIND_EXPENSE
+	case category.IncomeKind:
+		return pb.CategoryKind_CATEGORY_KIND_INCOME
+	case category.TransferKind:
+		return pb.CategoryKind_CATEGORY_KIND_TRANSFER
+	case category.ExcludedKind:
+		return pb.CategoryKind_CATEGORY_KIND_EXCLUDED
+	case category.SavingsKind:
+		return pb.CategoryKind_CATEGORY_KIND_SAVINGS
+	default:
+		return pb.CategoryKind_CATEGORY_KIND_UNSPECIFIED
+	}
+}
+
+func mapPbToCategoryKind(kind pb.CategoryKind) category.Kind {
+	switch kind {
+	case pb.CategoryKind_CATEGORY_KIND_EXPENSE:
+		return category.ExpenseKind
+	case pb.CategoryKind_CATEGORY_KIND_INCOME:
+		return category.IncomeKind
+	case pb.CategoryKind_CATEGORY_KIND_TRANSFER:
+		return category.TransferKind
+	case pb.CategoryKind_CATEGORY_KIND_EXCLUDED:
+		return category.ExcludedKind
+	case pb.CategoryKind_CATEGORY_KIND_SAVINGS:
+		return category.SavingsKind
+	default:
+		return ""
+	}
+}
+
+func convertCategoryKeywordToPb(k *category.CategoryKeyword) *pb.CategoryKeyword {
+	return &pb.CategoryKeyword{
+		Id:         k.ID,
+		CategoryId: k.CategoryID,
+		Name:       k.Name,
+		UserId:     k.UserID,
+	}
+}
+
+func convertProviderMappingToPb(m *category.ProviderMapping) *pb.ProviderCategoryMapping {
+	return &pb.ProviderCategoryMapping{
+		Id:               m.ID,
+		BankId:           m.BankID,
+		ExternalCategory: m.ExternalCategory,
+		CategoryId:       m.CategoryID,
+		CreatedAt:        timestamppb.New(m.CreatedAt),
+	}
+}
+
 func convertRecordErrorsPb(recordErrs map[int64][]error) []*pb.RecordError {
 	pbRecordError := make([]*pb.RecordError, 0, len(recordErrs))
 	for rowID, errs := range recordErrs {
@@ -83,19 +170,24 @@ func convertTransactionsToPb(transactions []transaction.EnrichedTransaction) []*
 	res := make([]*pb.Transaction, len(transactions))
 	for i, tr := range transactions {
 		res[i] = &pb.Transaction{
-			Id:              tr.ID,
-			BankId:          tr.BankID,
-			ExternalId:      tr.ExternalID,
-			UserId:          tr.UserID,
-			Amount:          tr.Amount,
-			CategoryId:      tr.CategoryID,
-			Description:     tr.Description,
-			Type:            mapTransactionTypeToPb(tr.Type),
-			TransactionDate: timestamppb.New(tr.TransactionDate),
-			CreatedAt:       timestamppb.New(tr.CreatedAt),
-			BankName:        tr.BankName,
-			CategoryName:    tr.CategoryName,
-			UserName:        tr.UserName,
+			Id:                 tr.ID,
+			BankId:             tr.BankID,
+			AccountId:          tr.AccountID,
+			ExternalId:         tr.ExternalID,
+			UserId:             tr.UserID,
+			Amount:             tr.Amount,
+			CategoryId:         tr.CategoryID,
+			Description:        tr.Description,
+			Type:               mapTransactionTypeToPb(tr.Type),
+			TransactionDate:    timestamppb.New(tr.TransactionDate),
+			CreatedAt:          timestamppb.New(tr.CreatedAt),
+			BankName:           tr.BankName,
+			AccountName:        tr.AccountName,
+			CategoryName:       tr.CategoryName,
+			UserName:           tr.UserName,
+			Merchant:           tr.Merchant,
+			Tags:               tr.Tags,
+			CategoryProvenance: convertCategoryProvenanceToPb(&tr.CategoryProvenance),
 		}
 	}
 
@@ -104,18 +196,81 @@ func convertTransactionsToPb(transactions []transaction.EnrichedTransaction) []*
 
 func convertTransactionToPb(tr *transaction.EnrichedTransaction) *pb.Transaction {
 	return &pb.Transaction{
-		Id:              tr.ID,
-		BankId:          tr.BankID,
-		ExternalId:      tr.ExternalID,
-		UserId:          tr.UserID,
-		Amount:          tr.Amount,
-		CategoryId:      tr.CategoryID,
-		Description:     tr.Description,
-		Type:            mapTransactionTypeToPb(tr.Type),
-		TransactionDate: timestamppb.New(tr.TransactionDate),
-		CreatedAt:       timestamppb.New(tr.CreatedAt),
-		BankName:        tr.BankName,
-		CategoryName:    tr.CategoryName,
+		Id:                 tr.ID,
+		BankId:             tr.BankID,
+		AccountId:          tr.AccountID,
+		ExternalId:         tr.ExternalID,
+		UserId:             tr.UserID,
+		Amount:             tr.Amount,
+		CategoryId:         tr.CategoryID,
+		Description:        tr.Description,
+		Type:               mapTransactionTypeToPb(tr.Type),
+		TransactionDate:    timestamppb.New(tr.TransactionDate),
+		CreatedAt:          timestamppb.New(tr.CreatedAt),
+		BankName:           tr.BankName,
+		AccountName:        tr.AccountName,
+		CategoryName:       tr.CategoryName,
+		Merchant:           tr.Merchant,
+		Tags:               tr.Tags,
+		CategoryProvenance: convertCategoryProvenanceToPb(&tr.CategoryProvenance),
+	}
+}
+
+// convertCategoryProvenanceToPb returns nil when no provenance was recorded.
+func convertCategoryProvenanceToPb(p *transaction.CategoryProvenance) *pb.CategoryProvenance {
+	if p.CategorySource == nil {
+		return nil
+	}
+
+	return &pb.CategoryProvenance{
+		Source:      mapCategorySourceToPb(*p.CategorySource),
+		RuleId:      p.CategoryRuleID,
+		MatchedText: p.CategoryMatch,
+		Confidence:  p.CategoryConfidence,
+	}
+}
+
+func mapCategorySourceToPb(source transaction.CategorySource) pb.CategorySource {
+	switch source {
+	case transaction.RuleCategorySource:
+		return pb.CategorySource_CATEGORY_SOURCE_RULE
+	case transaction.ProviderCategorySource:
+		return pb.CategorySource_CATEGORY_SOURCE_PROVIDER_MAPPING
+	case transaction.KeywordCategorySource:
+		return pb.CategorySource_CATEGORY_SOURCE_KEYWORD
+	case transaction.ClassifierCategorySource:
+		return pb.CategorySource_CATEGORY_SOURCE_CLASSIFIER
+	case transaction.ManualCategorySource:
+		return pb.CategorySource_CATEGORY_SOURCE_MANUAL
+	default:
+		return pb.CategorySource_CATEGORY_SOURCE_UNSPECIFIED
+	}
+}
+
+func convertKeywordMatchesToPb(matches []category.KeywordMatch) []*pb.CategoryKeywordMatch {
+	res := make([]*pb.CategoryKeywordMatch, len(matches))
+	for i, m := range matches {
+		res[i] = &pb.CategoryKeywordMatch{
+			KeywordId:  m.Keyword.ID,
+			Keyword:    m.Keyword.Name,
+			CategoryId: m.Keyword.CategoryID,
+			Start:      int32(m.Start),
+			End:        int32(m.End),
+		}
+	}
+
+	return res
+}
+
+func convertCategoryChangeToPb(change *transaction.CategoryChange) *pb.TransactionCategoryChange {
+	return &pb.TransactionCategoryChange{
+		TransactionId:      change.TransactionID,
+		TransactionDate:    timestamppb.New(change.TransactionDate),
+		Description:        change.Description,
+		PreviousCategoryId: change.PreviousCategoryID,
+		CategoryId:         change.CategoryID,
+		PreviousType:       mapTransactionTypeToPb(change.PreviousType),
+		Type:               mapTransactionTypeToPb(change.Type),
 	}
 }
 
@@ -149,3 +304,958 @@ func convertTransactionTypeList(types []transaction.TransactionType) []pb.Transa
 
 	return res
 }
+
+func convertBankHeaderToPb(h *uploader.HeaderMapping) *pb.BankHeader {
+	return &pb.BankHeader{
+		Id:                h.ID,
+		BankId:            h.BankID,
+		Name:              h.Name,
+		Required:          h.Required,
+		TransactionFields: convertTransactionFieldsToPb(h.TrFields),
+	}
+}
+
+func convertTransactionFieldsToPb(fields []transaction.TransactionField) []pb.TransactionField {
+	res := make([]pb.TransactionField, len(fields))
+	for i, field := range fields {
+		res[i] = mapTransactionFieldToPb(field)
+	}
+
+	return res
+}
+
+func convertPbToTransactionFields(fields []pb.TransactionField) []transaction.TransactionField {
+	res := make([]transaction.TransactionField, len(fields))
+	for i, field := range fields {
+		res[i] = mapPbToTransactionField(field)
+	}
+
+	return res
+}
+
+func mapTransactionFieldToPb(field transaction.TransactionField) pb.TransactionField {
+	switch field {
+	case transaction.DateTransactionField:
+		return pb.TransactionField_TRANSACTION_FIELD_DATE
+	case transaction.AmountTransactionField:
+		return pb.TransactionField_TRANSACTION_FIELD_AMOUNT
+	case transaction.DescriptionTransactionField:
+		return pb.TransactionField_TRANSACTION_FIELD_DESCRIPTION
+	case transaction.CategoryTransactionField:
+		return pb.TransactionField_TRANSACTION_FIELD_CATEGORY
+	case transaction.ExternalIDTransactionField:
+		return pb.TransactionField_TRANSACTION_FIELD_EXTERNAL_ID
+	case transaction.BalanceTransactionField:
+		return pb.TransactionField_TRANSACTION_FIELD_BALANCE
+	case transaction.DebitTransactionField:
+		return pb.TransactionField_TRANSACTION_FIELD_DEBIT
+	case transaction.CreditTransactionField:
+		return pb.TransactionField_TRANSACTION_FIELD_CREDIT
+	default:
+		return pb.TransactionField_TRANSACTION_FIELD_UNSPECIFIED
+	}
+}
+
+func mapPbToTransactionField(field pb.TransactionField) transaction.TransactionField {
+	switch field {
+	case pb.TransactionField_TRANSACTION_FIELD_DATE:
+		return transaction.DateTransactionField
+	case pb.TransactionField_TRANSACTION_FIELD_AMOUNT:
+		return transaction.AmountTransactionField
+	case pb.TransactionField_TRANSACTION_FIELD_DESCRIPTION:
+		return transaction.DescriptionTransactionField
+	case pb.TransactionField_TRANSACTION_FIELD_CATEGORY:
+		return transaction.CategoryTransactionField
+	case pb.TransactionField_TRANSACTION_FIELD_EXTERNAL_ID:
+		return transaction.ExternalIDTransactionField
+	case pb.TransactionField_TRANSACTION_FIELD_BALANCE:
+		return transaction.BalanceTransactionField
+	case pb.TransactionField_TRANSACTION_FIELD_DEBIT:
+		return transaction.DebitTransactionField
+	case pb.TransactionField_TRANSACTION_FIELD_CREDIT:
+		return transaction.CreditTransactionField
+	default:
+		return ""
+	}
+}
+
+func convertParsingProfileToPb(p *csvParser.Profile) *pb.BankParsingProfile {
+	return &pb.BankParsingProfile{
+		BankId:             p.BankID,
+		DateLayouts:        p.DateLayouts,
+		Timezone:           p.Timezone,
+		DecimalSeparator:   p.DecimalSeparator,
+		ThousandsSeparator: p.ThousandsSeparator,
+		SignConvention:     mapSignConventionToPb(p.SignConvention),
+		AmountMode:         mapAmountModeToPb(p.AmountMode),
+		Delimiter:          p.Delimiter,
+		Encoding:           mapCsvEncodingToPb(p.Encoding),
+		HeaderRow:          p.HeaderRow,
+		SkipRows:           p.SkipRows,
+		IsDefault:          p.Default,
+	}
+}
+
+func convertCsvDialectToPb(d *csvParser.Dialect) *pb.CsvDialect {
+	return &pb.CsvDialect{
+		Encoding:      mapCsvEncodingToPb(d.Encoding),
+		Bom:           d.BOM,
+		Delimiter:     d.Delimiter,
+		Quote:         d.Quote,
+		LenientQuotes: d.LenientQuotes,
+		HeaderRow:     d.HeaderRow,
+	}
+}
+
+func mapSignConventionToPb(convention csvParser.SignConvention) pb.SignConvention {
+	switch convention {
+	case csvParser.SignedConvention:
+		return pb.SignConvention_SIGN_CONVENTION_SIGNED
+	case csvParser.NegativeOutcomeConvention:
+		return pb.SignConvention_SIGN_CONVENTION_NEGATIVE_OUTCOME
+	case csvParser.PositiveOutcomeConvention:
+		return pb.SignConvention_SIGN_CONVENTION_POSITIVE_OUTCOME
+	case csvParser.CategoryKindConvention:
+		return pb.SignConvention_SIGN_CONVENTION_CATEGORY_KIND
+	default:
+		return pb.SignConvention_SIGN_CONVENTION_UNSPECIFIED
+	}
+}
+
+func mapPbToSignConvention(convention pb.SignConvention) csvParser.SignConvention {
+	switch convention {
+	case pb.SignConvention_SIGN_CONVENTION_SIGNED:
+		return csvParser.SignedConvention
+	case pb.SignConvention_SIGN_CONVENTION_NEGATIVE_OUTCOME:
+		return csvParser.NegativeOutcomeConvention
+	case pb.SignConvention_SIGN_CONVENTION_POSITIVE_OUTCOME:
+		return csvParser.PositiveOutcomeConvention
+	case pb.SignConvention_SIGN_CONVENTION_CATEGORY_KIND:
+		return csvParser.CategoryKindConvention
+	default:
+		return ""
+	}
+}
+
+func mapAmountModeToPb(mode csvParser.AmountMode) pb.AmountMode {
+	switch mode {
+	case csvParser.SingleAmountMode:
+		return pb.AmountMode_AMOUNT_MODE_SINGLE
+	case csvParser.DebitCreditAmountMode:
+		return pb.AmountMode_AMOUNT_MODE_DEBIT_CREDIT
+	default:
+		return pb.AmountMode_AMOUNT_MODE_UNSPECIFIED
+	}
+}
+
+func mapPbToAmountMode(mode pb.AmountMode) csvParser.AmountMode {
+	switch mode {
+	case pb.AmountMode_AMOUNT_MODE_SINGLE:
+		return csvParser.SingleAmountMode
+	case pb.AmountMode_AMOUNT_MODE_DEBIT_CREDIT:
+		return csvParser.DebitCreditAmountMode
+	default:
+		return ""
+	}
+}
+
+func mapCsvEncodingToPb(encoding csvParser.Encoding) pb.CsvEncoding {
+	switch encoding {
+	case csvParser.UTF8Encoding:
+		return pb.CsvEncoding_CSV_ENCODING_UTF8
+	case csvParser.UTF16LEEncoding:
+		return pb.CsvEncoding_CSV_ENCODING_UTF16LE
+	case csvParser.UTF16BEEncoding:
+		return pb.CsvEncoding_CSV_ENCODING_UTF16BE
+	case csvParser.Windows1252Encoding:
+		return pb.CsvEncoding_CSV_ENCODING_WINDOWS_1252
+	case csvParser.ISO88591Encoding:
+		return pb.CsvEncoding_CSV_ENCODING_ISO_8859_1
+	default:
+		return pb.CsvEncoding_CSV_ENCODING_UNSPECIFIED
+	}
+}
+
+func mapPbToCsvEncoding(encoding pb.CsvEncoding) csvParser.Encoding {
+	switch encoding {
+	case pb.CsvEncoding_CSV_ENCODING_UTF8:
+		return csvParser.UTF8Encoding
+	case pb.CsvEncoding_CSV_ENCODING_UTF16LE:
+		return csvParser.UTF16LEEncoding
+	case pb.CsvEncoding_CSV_ENCODING_UTF16BE:
+		return csvParser.UTF16BEEncoding
+	case pb.CsvEncoding_CSV_ENCODING_WINDOWS_1252:
+		return csvParser.Windows1252Encoding
+	case pb.CsvEncoding_CSV_ENCODING_ISO_8859_1:
+		return csvParser.ISO88591Encoding
+	default:
+		return ""
+	}
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+func convertCategoryInsightsToPb(insights []insight.CategoryInsight) []*pb.CategorySpendingInsight {
+	res := make([]*pb.CategorySpendingInsight, len(insights))
+	for i, in := range insights {
+		res[i] = &pb.CategorySpendingInsight{
+			CategoryId:                  in.CategoryID,
+			CategoryName:                in.CategoryName,
+			HasSubcategories:            in.HasSubcategories,
+			Amount:                      formatAmount(in.Amount),
+			PreviousMonthAmount:         formatAmount(in.PreviousMonthAmount),
+			MonthOverMonthChangePercent: in.MonthOverMonthChangePercent,
+			LastYearAmount:              formatAmount(in.LastYearAmount),
+			YearOverYearChangePercent:   in.YearOverYearChangePercent,
+			RollingMean:                 formatAmount(in.RollingMean),
+			RollingStdDev:               formatAmount(in.RollingStdDev),
+			ZScore:                      in.ZScore,
+			IsAnomaly:                   in.IsAnomaly,
+		}
+	}
+
+	return res
+}
+
+func convertTransactionInsightsToPb(insights []insight.TransactionInsight) []*pb.TransactionSpendingInsight {
+	res := make([]*pb.TransactionSpendingInsight, len(insights))
+	for i, in := range insights {
+		res[i] = &pb.TransactionSpendingInsight{
+			TransactionId:   in.Transaction.ID,
+			UserId:          in.Transaction.UserID,
+			BankId:          in.Transaction.BankID,
+			CategoryId:      in.Transaction.CategoryID,
+			CategoryName:    in.Transaction.CategoryName,
+			Description:     in.Transaction.Description,
+			Amount:          formatAmount(in.Transaction.Amount),
+			TransactionDate: timestamppb.New(in.Transaction.TransactionDate),
+			BaselineSource:  mapBaselineSourceToPb(in.BaselineSource),
+			BaselineMean:    formatAmount(in.BaselineMean),
+			BaselineStdDev:  formatAmount(in.BaselineStdDev),
+			ZScore:          in.ZScore,
+		}
+	}
+
+	return res
+}
+
+func mapBaselineSourceToPb(source insight.BaselineSource) pb.InsightBaselineSource {
+	switch source {
+	case insight.MerchantBaselineSource:
+		return pb.InsightBaselineSource_BASELINE_MERCHANT
+	case insight.CategoryBaselineSource:
+		return pb.InsightBaselineSource_BASELINE_CATEGORY
+	default:
+		return pb.InsightBaselineSource_BASELINE_UNSPECIFIED
+	}
+}
+
+func convertSharedExpenseToPb(expense *household.SharedExpense) *pb.SharedExpense {
+	shares := make([]*pb.ExpenseShare, len(expense.Shares))
+	for i, share := range expense.Shares {
+		amount := share.Amount
+		shares[i] = &pb.ExpenseShare{
+			UserId:     share.UserID,
+			Percentage: share.Percentage,
+			Amount:     &amount,
+		}
+	}
+
+	return &pb.SharedExpense{
+		Id:            expense.ID,
+		TransactionId: expense.TransactionID,
+		PayerUserId:   expense.PayerUserID,
+		TotalAmount:   expense.TotalAmount,
+		SplitMethod:   mapSplitMethodToPb(expense.SplitMethod),
+		Shares:        shares,
+		CreatedAt:     timestamppb.New(expense.CreatedAt),
+	}
+}
+
+func mapSplitMethodToPb(method household.SplitMethod) pb.SplitMethod {
+	switch method {
+	case household.EqualSplitMethod:
+		return pb.SplitMethod_EQUAL
+	case household.PercentageSplitMethod:
+		return pb.SplitMethod_PERCENTAGE
+	case household.ExactSplitMethod:
+		return pb.SplitMethod_EXACT
+	default:
+		return pb.SplitMethod_SPLIT_METHOD_UNSPECIFIED
+	}
+}
+
+func mapPbToSplitMethod(method pb.SplitMethod) household.SplitMethod {
+	switch method {
+	case pb.SplitMethod_PERCENTAGE:
+		return household.PercentageSplitMethod
+	case pb.SplitMethod_EXACT:
+		return household.ExactSplitMethod
+	default:
+		return household.EqualSplitMethod
+	}
+}
+
+func convertSettlementToPb(settlement *household.Settlement) *pb.Settlement {
+	return &pb.Settlement{
+		Id:            settlement.ID,
+		FromUserId:    settlement.FromUserID,
+		ToUserId:      settlement.ToUserID,
+		Amount:        settlement.Amount,
+		TransactionId: settlement.TransactionID,
+		Note:          settlement.Note,
+		SettledAt:     timestamppb.New(settlement.SettledAt),
+		CreatedAt:     timestamppb.New(settlement.CreatedAt),
+	}
+}
+
+func convertAccountToPb(acc *account.Account) *pb.Account {
+	return &pb.Account{
+		Id:          acc.ID,
+		BankId:      acc.BankID,
+		Type:        mapAccountTypeToPb(acc.Type),
+		Currency:    acc.Currency,
+		ExternalId:  acc.ExternalID,
+		DisplayName: acc.DisplayName,
+		OwnerIds:    acc.OwnerIDs,
+		CreatedAt:   timestamppb.New(acc.CreatedAt),
+	}
+}
+
+func mapAccountTypeToPb(t account.AccountType) pb.AccountType {
+	switch t {
+	case account.CurrentAccountType:
+		return pb.AccountType_CURRENT
+	case account.SavingsAccountType:
+		return pb.AccountType_SAVINGS
+	case account.CreditCardAccountType:
+		return pb.AccountType_CREDIT_CARD
+	case account.LoanAccountType:
+		return pb.AccountType_LOAN
+	case account.OtherAccountType:
+		return pb.AccountType_OTHER
+	default:
+		return pb.AccountType_ACCOUNT_TYPE_UNSPECIFIED
+	}
+}
+
+func mapPbToAccountType(t pb.AccountType) account.AccountType {
+	switch t {
+	case pb.AccountType_CURRENT:
+		return account.CurrentAccountType
+	case pb.AccountType_SAVINGS:
+		return account.SavingsAccountType
+	case pb.AccountType_CREDIT_CARD:
+		return account.CreditCardAccountType
+	case pb.AccountType_LOAN:
+		return account.LoanAccountType
+	case pb.AccountType_OTHER:
+		return account.OtherAccountType
+	default:
+		return ""
+	}
+}
+
+func convertBalanceSnapshotToPb(snapshot *balance.Snapshot) *pb.BalanceSnapshot {
+	return &pb.BalanceSnapshot{
+		Id:          snapshot.ID,
+		AccountId:   snapshot.AccountID,
+		Balance:     snapshot.Balance,
+		BalanceDate: timestamppb.New(snapshot.BalanceDate),
+		Source:      mapSnapshotSourceToPb(snapshot.Source),
+		Note:        snapshot.Note,
+		CreatedAt:   timestamppb.New(snapshot.CreatedAt),
+	}
+}
+
+func mapSnapshotSourceToPb(source balance.SnapshotSource) pb.BalanceSnapshotSource {
+	switch source {
+	case balance.CSVSnapshotSource:
+		return pb.BalanceSnapshotSource_BALANCE_SOURCE_CSV
+	case balance.APISnapshotSource:
+		return pb.BalanceSnapshotSource_BALANCE_SOURCE_API
+	case balance.ManualSnapshotSource:
+		return pb.BalanceSnapshotSource_BALANCE_SOURCE_MANUAL
+	default:
+		return pb.BalanceSnapshotSource_BALANCE_SOURCE_UNSPECIFIED
+	}
+}
+
+func convertSnapshotFilter(accountID int64, from, to *timestamppb.Timestamp) *balance.SnapshotFilter {
+	filter := &balance.SnapshotFilter{AccountID: accountID}
+	if from != nil {
+		t := from.AsTime()
+		filter.From = &t
+	}
+	if to != nil {
+		t := to.AsTime()
+		filter.To = &t
+	}
+
+	return filter
+}
+
+func convertReconciliationPeriodToPb(period *balance.ReconciliationPeriod) *pb.ReconciliationPeriod {
+	if period == nil {
+		return nil
+	}
+
+	return &pb.ReconciliationPeriod{
+		StartDate:              timestamppb.New(period.StartDate),
+		EndDate:                timestamppb.New(period.EndDate),
+		OpeningBalance:         formatAmount(period.OpeningBalance),
+		ExpectedClosingBalance: formatAmount(period.ExpectedClosingBalance),
+		ActualClosingBalance:   formatAmount(period.ActualClosingBalance),
+		Difference:             formatAmount(period.Difference),
+		TransactionCount:       int32(period.TransactionCount),
+		Status:                 mapReconciliationStatusToPb(period.Status),
+	}
+}
+
+func mapReconciliationStatusToPb(st balance.ReconciliationStatus) pb.ReconciliationStatus {
+	switch st {
+	case balance.OKReconciliationStatus:
+		return pb.ReconciliationStatus_RECONCILIATION_OK
+	case balance.MismatchReconciliationStatus:
+		return pb.ReconciliationStatus_RECONCILIATION_MISMATCH
+	case balance.GapReconciliationStatus:
+		return pb.ReconciliationStatus_RECONCILIATION_GAP
+	default:
+		return pb.ReconciliationStatus_RECONCILIATION_STATUS_UNSPECIFIED
+	}
+}
+
+func convertAssetToPb(asset *networth.Asset) *pb.Asset {
+	return &pb.Asset{
+		Id:          asset.ID,
+		Name:        asset.Name,
+		Kind:        mapAssetKindToPb(asset.Kind),
+		AssetClass:  mapAssetClassToPb(asset.AssetClass),
+		Currency:    asset.Currency,
+		OwnerUserId: asset.OwnerUserID,
+		CreatedAt:   timestamppb.New(asset.CreatedAt),
+	}
+}
+
+func convertAssetValuationToPb(valuation *networth.Valuation) *pb.AssetValuation {
+	return &pb.AssetValuation{
+		Id:            valuation.ID,
+		AssetId:       valuation.AssetID,
+		Value:         valuation.Value,
+		ValuationDate: timestamppb.New(valuation.ValuationDate),
+		Note:          valuation.Note,
+	}
+}
+
+func mapAssetKindToPb(kind networth.AssetKind) pb.AssetKind {
+	switch kind {
+	case networth.AssetAssetKind:
+		return pb.AssetKind_ASSET
+	case networth.LiabilityAssetKind:
+		return pb.AssetKind_LIABILITY
+	default:
+		return pb.AssetKind_ASSET_KIND_UNSPECIFIED
+	}
+}
+
+func mapPbToAssetKind(kind pb.AssetKind) networth.AssetKind {
+	switch kind {
+	case pb.AssetKind_ASSET:
+		return networth.AssetAssetKind
+	case pb.AssetKind_LIABILITY:
+		return networth.LiabilityAssetKind
+	default:
+		return ""
+	}
+}
+
+func mapAssetClassToPb(assetClass networth.AssetClass) pb.AssetClass {
+	switch assetClass {
+	case networth.CashAssetClass:
+		return pb.AssetClass_ASSET_CLASS_CASH
+	case networth.SavingsAssetClass:
+		return pb.AssetClass_ASSET_CLASS_SAVINGS
+	case networth.InvestmentAssetClass:
+		return pb.AssetClass_ASSET_CLASS_INVESTMENT
+	case networth.PensionAssetClass:
+		return pb.AssetClass_ASSET_CLASS_PENSION
+	case networth.PropertyAssetClass:
+		return pb.AssetClass_ASSET_CLASS_PROPERTY
+	case networth.VehicleAssetClass:
+		return pb.AssetClass_ASSET_CLASS_VEHICLE
+	case networth.MortgageAssetClass:
+		return pb.AssetClass_ASSET_CLASS_MORTGAGE
+	case networth.LoanAssetClass:
+		return pb.AssetClass_ASSET_CLASS_LOAN
+	case networth.CreditCardAssetClass:
+		return pb.AssetClass_ASSET_CLASS_CREDIT_CARD
+	case networth.OtherAssetClass:
+		return pb.AssetClass_ASSET_CLASS_OTHER
+	default:
+		return pb.AssetClass_ASSET_CLASS_UNSPECIFIED
+	}
+}
+
+func mapPbToAssetClass(assetClass pb.AssetClass) networth.AssetClass {
+	switch assetClass {
+	case pb.AssetClass_ASSET_CLASS_CASH:
+		return networth.CashAssetClass
+	case pb.AssetClass_ASSET_CLASS_SAVINGS:
+		return networth.SavingsAssetClass
+	case pb.AssetClass_ASSET_CLASS_INVESTMENT:
+		return networth.InvestmentAssetClass
+	case pb.AssetClass_ASSET_CLASS_PENSION:
+		return networth.PensionAssetClass
+	case pb.AssetClass_ASSET_CLASS_PROPERTY:
+		return networth.PropertyAssetClass
+	case pb.AssetClass_ASSET_CLASS_VEHICLE:
+		return networth.VehicleAssetClass
+	case pb.AssetClass_ASSET_CLASS_MORTGAGE:
+		return networth.MortgageAssetClass
+	case pb.AssetClass_ASSET_CLASS_LOAN:
+		return networth.LoanAssetClass
+	case pb.AssetClass_ASSET_CLASS_CREDIT_CARD:
+		return networth.CreditCardAssetClass
+	case pb.AssetClass_ASSET_CLASS_OTHER:
+		return networth.OtherAssetClass
+	default:
+		return ""
+	}
+}
+
+func convertSavingsGoalToPb(savingsGoal *goal.SavingsGoal) *pb.SavingsGoal {
+	return &pb.SavingsGoal{
+		Id:           savingsGoal.ID,
+		Name:         savingsGoal.Name,
+		TargetAmount: savingsGoal.TargetAmount,
+		TargetDate:   timestamppb.New(savingsGoal.TargetDate),
+		StartDate:    timestamppb.New(savingsGoal.StartDate),
+		AccountId:    savingsGoal.AccountID,
+		CategoryId:   savingsGoal.CategoryID,
+		OwnerUserId:  savingsGoal.OwnerUserID,
+		CreatedAt:    timestamppb.New(savingsGoal.CreatedAt),
+	}
+}
+
+func mapGoalStatusToPb(goalStatus goal.GoalStatus) pb.SavingsGoalStatus {
+	switch goalStatus {
+	case goal.AchievedGoalStatus:
+		return pb.SavingsGoalStatus_SAVINGS_GOAL_STATUS_ACHIEVED
+	case goal.OnTrackGoalStatus:
+		return pb.SavingsGoalStatus_SAVINGS_GOAL_STATUS_ON_TRACK
+	case goal.BehindGoalStatus:
+		return pb.SavingsGoalStatus_SAVINGS_GOAL_STATUS_BEHIND
+	case goal.OverdueGoalStatus:
+		return pb.SavingsGoalStatus_SAVINGS_GOAL_STATUS_OVERDUE
+	default:
+		return pb.SavingsGoalStatus_SAVINGS_GOAL_STATUS_UNSPECIFIED
+	}
+}
+
+func mapPbToReportPeriod(period pb.ReportPeriod) report.Period {
+	switch period {
+	case pb.ReportPeriod_REPORT_PERIOD_MONTHLY:
+		return report.MonthlyPeriod
+	case pb.ReportPeriod_REPORT_PERIOD_ANNUAL:
+		return report.AnnualPeriod
+	default:
+		return ""
+	}
+}
+
+// mapPbToReportFormat defaults to HTML so reports open in the browser without extra parameters.
+func mapPbToReportFormat(format pb.ReportFormat) report.Format {
+	switch format {
+	case pb.ReportFormat_REPORT_FORMAT_PDF:
+		return report.PDFFormat
+	default:
+		return report.HTMLFormat
+	}
+}
+
+func convertAlertRuleToPb(rule *alert.Rule) *pb.AlertRule {
+	res := &pb.AlertRule{
+		Id:         rule.ID,
+		Name:       rule.Name,
+		RuleType:   mapAlertRuleTypeToPb(rule.RuleType),
+		UserId:     rule.UserID,
+		AccountId:  rule.AccountID,
+		CategoryId: rule.CategoryID,
+		Threshold:  rule.Threshold,
+		Channel:    mapAlertChannelToPb(rule.Channel),
+		Target:     rule.Target,
+		Enabled:    rule.Enabled,
+		CreatedAt:  timestamppb.New(rule.CreatedAt),
+	}
+
+	if rule.Period != nil {
+		switch *rule.Period {
+		case alert.WeekPeriod:
+			res.Period = pb.AlertPeriod_ALERT_PERIOD_WEEK
+		case alert.MonthPeriod:
+			res.Period = pb.AlertPeriod_ALERT_PERIOD_MONTH
+		}
+	}
+
+	return res
+}
+
+func mapAlertRuleTypeToPb(ruleType alert.RuleType) pb.AlertRuleType {
+	switch ruleType {
+	case alert.LargeTransactionRuleType:
+		return pb.AlertRuleType_ALERT_RULE_TYPE_LARGE_TRANSACTION
+	case alert.CategorySpendRuleType:
+		return pb.AlertRuleType_ALERT_RULE_TYPE_CATEGORY_SPEND
+	case alert.NewMerchantRuleType:
+		return pb.AlertRuleType_ALERT_RULE_TYPE_NEW_MERCHANT
+	case alert.LowBalanceRuleType:
+		return pb.AlertRuleType_ALERT_RULE_TYPE_LOW_BALANCE
+	case alert.ImportFailedRuleType:
+		return pb.AlertRuleType_ALERT_RULE_TYPE_IMPORT_FAILED
+	default:
+		return pb.AlertRuleType_ALERT_RULE_TYPE_UNSPECIFIED
+	}
+}
+
+func mapPbToAlertRuleType(ruleType pb.AlertRuleType) alert.RuleType {
+	switch ruleType {
+	case pb.AlertRuleType_ALERT_RULE_TYPE_LARGE_TRANSACTION:
+		return alert.LargeTransactionRuleType
+	case pb.AlertRuleType_ALERT_RULE_TYPE_CATEGORY_SPEND:
+		return alert.CategorySpendRuleType
+	case pb.AlertRuleType_ALERT_RULE_TYPE_NEW_MERCHANT:
+		return alert.NewMerchantRuleType
+	case pb.AlertRuleType_ALERT_RULE_TYPE_LOW_BALANCE:
+		return alert.LowBalanceRuleType
+	case pb.AlertRuleType_ALERT_RULE_TYPE_IMPORT_FAILED:
+		return alert.ImportFailedRuleType
+	default:
+		return ""
+	}
+}
+
+func mapPbToAlertPeriod(period pb.AlertPeriod) *alert.Period {
+	var res alert.Period
+	switch period {
+	case pb.AlertPeriod_ALERT_PERIOD_WEEK:
+		res = alert.WeekPeriod
+	case pb.AlertPeriod_ALERT_PERIOD_MONTH:
+		res = alert.MonthPeriod
+	default:
+		return nil
+	}
+
+	return &res
+}
+
+func mapAlertChannelToPb(channel alert.ChannelType) pb.AlertChannel {
+	switch channel {
+	case alert.EmailChannelType:
+		return pb.AlertChannel_ALERT_CHANNEL_EMAIL
+	case alert.WebhookChannelType:
+		return pb.AlertChannel_ALERT_CHANNEL_WEBHOOK
+	default:
+		return pb.AlertChannel_ALERT_CHANNEL_UNSPECIFIED
+	}
+}
+
+func mapPbToAlertChannel(channel pb.AlertChannel) alert.ChannelType {
+	switch channel {
+	case pb.AlertChannel_ALERT_CHANNEL_EMAIL:
+		return alert.EmailChannelType
+	case pb.AlertChannel_ALERT_CHANNEL_WEBHOOK:
+		return alert.WebhookChannelType
+	default:
+		return ""
+	}
+}
+
+func convertWebhookSubscriptionToPb(subscription *webhook.Subscription) *pb.WebhookSubscription {
+	return &pb.WebhookSubscription{
+		Id:          subscription.ID,
+		Url:         subscription.URL,
+		EventTypes:  subscription.EventTypes,
+		Description: subscription.Description,
+		Enabled:     subscription.Enabled,
+		CreatedAt:   timestamppb.New(subscription.CreatedAt),
+	}
+}
+
+func convertWebhookDeliveryToPb(delivery *webhook.Delivery) *pb.WebhookDelivery {
+	res := &pb.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Payload:        string(delivery.Payload),
+		Status:         mapWebhookDeliveryStatusToPb(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+
+	if delivery.DeliveredAt != nil {
+		res.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+
+	return res
+}
+
+func mapWebhookDeliveryStatusToPb(deliveryStatus webhook.DeliveryStatus) pb.WebhookDeliveryStatus {
+	switch deliveryStatus {
+	case webhook.PendingDeliveryStatus:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case webhook.SucceededDeliveryStatus:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case webhook.DeadDeliveryStatus:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+	default:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
+func mapPbToWebhookDeliveryStatus(deliveryStatus pb.WebhookDeliveryStatus) webhook.DeliveryStatus {
+	switch deliveryStatus {
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		return webhook.PendingDeliveryStatus
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED:
+		return webhook.SucceededDeliveryStatus
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:
+		return webhook.DeadDeliveryStatus
+	default:
+		return ""
+	}
+}
+
+func convertCategorizationRuleToPb(r *rule.Rule) *pb.CategorizationRule {
+	conditions := make([]*pb.CategorizationCondition, len(r.Conditions))
+	for i, c := range r.Conditions {
+		conditions[i] = &pb.CategorizationCondition{
+			Type:      mapCategorizationConditionTypeToPb(c.Type),
+			MinAmount: c.MinAmount,
+			MaxAmount: c.MaxAmount,
+			BankId:    c.BankID,
+			UserId:    c.UserID,
+			Sign:      mapAmountSignToPb(c.Sign),
+			FromDay:   c.FromDay,
+			ToDay:     c.ToDay,
+		}
+		if c.Value != "" {
+			value := c.Value
+			conditions[i].Value = &value
+		}
+	}
+
+	actions := &pb.CategorizationActions{
+		CategoryId: r.Actions.CategoryID,
+		Tags:       r.Actions.Tags,
+		Merchant:   r.Actions.Merchant,
+	}
+	if r.Actions.Type != nil {
+		trType := mapTransactionTypeToPb(*r.Actions.Type)
+		actions.Type = &trType
+	}
+
+	res := &pb.CategorizationRule{
+		Id:         r.ID,
+		Name:       r.Name,
+		Priority:   r.Priority,
+		Conditions: conditions,
+		Actions:    actions,
+		Enabled:    r.Enabled,
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+		HitCount:   r.HitCount,
+	}
+	if r.LastHitAt != nil {
+		res.LastHitAt = timestamppb.New(*r.LastHitAt)
+	}
+
+	return res
+}
+
+func convertPbToCategorizationConditions(conditions []*pb.CategorizationCondition) []rule.Condition {
+	res := make([]rule.Condition, len(conditions))
+	for i, c := range conditions {
+		res[i] = rule.Condition{
+			Type:      mapPbToCategorizationConditionType(c.GetType()),
+			Value:     c.GetValue(),
+			MinAmount: c.MinAmount,
+			MaxAmount: c.MaxAmount,
+			BankID:    c.BankId,
+			UserID:    c.UserId,
+			Sign:      mapPbToAmountSign(c.GetSign()),
+			FromDay:   c.FromDay,
+			ToDay:     c.ToDay,
+		}
+	}
+
+	return res
+}
+
+func convertPbToCategorizationActions(actions *pb.CategorizationActions) rule.Actions {
+	if actions == nil {
+		return rule.Actions{}
+	}
+
+	res := rule.Actions{
+		CategoryID: actions.CategoryId,
+		Tags:       actions.GetTags(),
+		Merchant:   actions.Merchant,
+	}
+
+	if actions.Type != nil {
+		trType := mapPbToTransactionType(actions.GetType())
+		res.Type = &trType
+	}
+
+	return res
+}
+
+func mapCategorizationConditionTypeToPb(conditionType rule.ConditionType) pb.CategorizationConditionType {
+	switch conditionType {
+	case rule.ContainsConditionType:
+		return pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_CONTAINS
+	case rule.RegexConditionType:
+		return pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_REGEX
+	case rule.ExactConditionType:
+		return pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_EXACT
+	case rule.AmountRangeConditionType:
+		return pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_AMOUNT_RANGE
+	case rule.BankConditionType:
+		return pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_BANK
+	case rule.UserConditionType:
+		return pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_USER
+	case rule.SignConditionType:
+		return pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_SIGN
+	case rule.DayOfMonthConditionType:
+		return pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_DAY_OF_MONTH
+	default:
+		return pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_UNSPECIFIED
+	}
+}
+
+func mapPbToCategorizationConditionType(conditionType pb.CategorizationConditionType) rule.ConditionType {
+	switch conditionType {
+	case pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_CONTAINS:
+		return rule.ContainsConditionType
+	case pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_REGEX:
+		return rule.RegexConditionType
+	case pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_EXACT:
+		return rule.ExactConditionType
+	case pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_AMOUNT_RANGE:
+		return rule.AmountRangeConditionType
+	case pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_BANK:
+		return rule.BankConditionType
+	case pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_USER:
+		return rule.UserConditionType
+	case pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_SIGN:
+		return rule.SignConditionType
+	case pb.CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_DAY_OF_MONTH:
+		return rule.DayOfMonthConditionType
+	default:
+		return ""
+	}
+}
+
+func mapAmountSignToPb(sign rule.Sign) pb.AmountSign {
+	switch sign {
+	case rule.PositiveSign:
+		return pb.AmountSign_AMOUNT_SIGN_POSITIVE
+	case rule.NegativeSign:
+		return pb.AmountSign_AMOUNT_SIGN_NEGATIVE
+	default:
+		return pb.AmountSign_AMOUNT_SIGN_UNSPECIFIED
+	}
+}
+
+func mapPbToAmountSign(sign pb.AmountSign) rule.Sign {
+	switch sign {
+	case pb.AmountSign_AMOUNT_SIGN_POSITIVE:
+		return rule.PositiveSign
+	case pb.AmountSign_AMOUNT_SIGN_NEGATIVE:
+		return rule.NegativeSign
+	default:
+		return ""
+	}
+}
+
+func convertCategorySuggestionToPb(s *rule.Suggestion) *pb.CategorySuggestion {
+	res := &pb.CategorySuggestion{
+		Id:            s.ID,
+		Pattern:       s.Pattern,
+		CategoryId:    s.CategoryID,
+		TransactionId: s.TransactionID,
+		Description:   s.Description,
+		Occurrences:   s.Occurrences,
+		Status:        mapCategorySuggestionStatusToPb(s.Status),
+		KeywordId:     s.KeywordID,
+		RuleId:        s.RuleID,
+		CreatedAt:     timestamppb.New(s.CreatedAt),
+	}
+	if s.Kind != nil {
+		res.Kind = mapCategorySuggestionKindToPb(*s.Kind)
+	}
+
+	return res
+}
+
+func mapCategorySuggestionStatusToPb(suggestionStatus rule.SuggestionStatus) pb.CategorySuggestionStatus {
+	switch suggestionStatus {
+	case rule.PendingSuggestionStatus:
+		return pb.CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_PENDING
+	case rule.AcceptedSuggestionStatus:
+		return pb.CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_ACCEPTED
+	case rule.RejectedSuggestionStatus:
+		return pb.CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_REJECTED
+	default:
+		return pb.CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_UNSPECIFIED
+	}
+}
+
+func mapPbToCategorySuggestionStatus(suggestionStatus pb.CategorySuggestionStatus) rule.SuggestionStatus {
+	switch suggestionStatus {
+	case pb.CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_PENDING:
+		return rule.PendingSuggestionStatus
+	case pb.CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_ACCEPTED:
+		return rule.AcceptedSuggestionStatus
+	case pb.CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_REJECTED:
+		return rule.RejectedSuggestionStatus
+	default:
+		return ""
+	}
+}
+
+func mapCategorySuggestionKindToPb(kind rule.SuggestionKind) pb.CategorySuggestionKind {
+	switch kind {
+	case rule.KeywordSuggestionKind:
+		return pb.CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_KEYWORD
+	case rule.RuleSuggestionKind:
+		return pb.CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_RULE
+	default:
+		return pb.CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_UNSPECIFIED
+	}
+}
+
+func mapPbToCategorySuggestionKind(kind pb.CategorySuggestionKind) rule.SuggestionKind {
+	switch kind {
+	case pb.CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_KEYWORD:
+		return rule.KeywordSuggestionKind
+	case pb.CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_RULE:
+		return rule.RuleSuggestionKind
+	default:
+		return ""
+	}
+}
+
+func convertCategorizationModelToPb(info *classifier.ModelInfo) *pb.CategorizationModel {
+	return &pb.CategorizationModel{
+		SampleCount:   info.SampleCount,
+		CategoryCount: info.CategoryCount,
+		TrainedAt:     timestamppb.New(info.TrainedAt),
+		MinConfidence: classifier.MinConfidence,
+	}
+}
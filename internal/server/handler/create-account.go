@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	acc, err := f.accountService.CreateAccount(ctx, &account.AccountData{
+		BankID:      req.GetBankId(),
+		Type:        mapPbToAccountType(req.GetType()),
+		Currency:    req.GetCurrency(),
+		ExternalID:  req.ExternalId,
+		DisplayName: req.GetDisplayName(),
+		OwnerIDs:    req.GetOwnerIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAccountResponse{
+		Account: convertAccountToPb(acc),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/alert"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateAlertRule(ctx context.Context, req *pb.CreateAlertRuleRequest) (*pb.CreateAlertRuleResponse, error) {
+	rule, err := f.alertService.CreateRule(ctx, &alert.Rule{
+		Name:       req.GetName(),
+		RuleType:   mapPbToAlertRuleType(req.GetRuleType()),
+		UserID:     req.UserId,
+		AccountID:  req.AccountId,
+		CategoryID: req.CategoryId,
+		Threshold:  req.Threshold,
+		Period:     mapPbToAlertPeriod(req.GetPeriod()),
+		Channel:    mapPbToAlertChannel(req.GetChannel()),
+		Target:     req.GetTarget(),
+		Enabled:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAlertRuleResponse{
+		Rule: convertAlertRuleToPb(rule),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/networth"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateAsset(ctx context.Context, req *pb.CreateAssetRequest) (*pb.CreateAssetResponse, error) {
+	asset, err := f.networthService.CreateAsset(ctx, &networth.Asset{
+		Name:        req.GetName(),
+		Kind:        mapPbToAssetKind(req.GetKind()),
+		AssetClass:  mapPbToAssetClass(req.GetAssetClass()),
+		Currency:    req.GetCurrency(),
+		OwnerUserID: req.OwnerUserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAssetResponse{
+		Asset: convertAssetToPb(asset),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateBalanceSnapshot(ctx context.Context, req *pb.CreateBalanceSnapshotRequest) (*pb.CreateBalanceSnapshotResponse, error) {
+	snapshot := &balance.Snapshot{
+		AccountID: req.GetAccountId(),
+		Balance:   req.GetBalance(),
+		Note:      req.Note,
+	}
+	if req.BalanceDate != nil {
+		snapshot.BalanceDate = req.GetBalanceDate().AsTime()
+	}
+
+	saved, err := f.balanceService.CreateSnapshot(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateBalanceSnapshotResponse{
+		Snapshot: convertBalanceSnapshotToPb(saved),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/uploader"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateBankHeader(ctx context.Context, req *pb.CreateBankHeaderRequest) (*pb.CreateBankHeaderResponse, error) {
+	created, err := f.uploaderService.CreateHeaderMapping(ctx, &uploader.HeaderMapping{
+		BankID:   req.GetBankId(),
+		Name:     req.GetName(),
+		Required: req.GetRequired(),
+		TrFields: convertPbToTransactionFields(req.GetTransactionFields()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateBankHeaderResponse{
+		Header: convertBankHeaderToPb(created),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateCategorizationRule(ctx context.Context, req *pb.CreateCategorizationRuleRequest) (*pb.CreateCategorizationRuleResponse, error) {
+	priority := rule.DefaultPriority
+	if req.Priority != nil {
+		priority = req.GetPriority()
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = req.GetEnabled()
+	}
+
+	created, err := f.ruleService.CreateRule(ctx, &rule.Rule{
+		Name:       req.GetName(),
+		Priority:   priority,
+		Conditions: convertPbToCategorizationConditions(req.GetConditions()),
+		Actions:    convertPbToCategorizationActions(req.GetActions()),
+		Enabled:    enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateCategorizationRuleResponse{
+		Rule: convertCategorizationRuleToPb(created),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	created, err := f.categoryService.CreateCategory(ctx, &category.Category{
+		Name:        req.GetName(),
+		Description: req.Description,
+		ParentID:    req.ParentId,
+		Kind:        mapPbToCategoryKind(req.GetKind()),
+		UserID:      req.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateCategoryResponse{
+		Category: convertCategoryToPb(created),
+	}, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateProviderCategoryMapping(ctx context.Context, req *pb.CreateProviderCategoryMappingRequest) (*pb.CreateProviderCategoryMappingResponse, error) {
+	created, err := f.categoryService.CreateProviderMapping(ctx, &category.ProviderMapping{
+		BankID:           req.GetBankId(),
+		ExternalCategory: req.GetExternalCategory(),
+		CategoryID:       req.GetCategoryId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateProviderCategoryMappingResponse{
+		Mapping: convertProviderMappingToPb(created),
+	}, nil
+}
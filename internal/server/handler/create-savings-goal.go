@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/goal"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateSavingsGoal(ctx context.Context, req *pb.CreateSavingsGoalRequest) (*pb.CreateSavingsGoalResponse, error) {
+	savingsGoal := &goal.SavingsGoal{
+		Name:         req.GetName(),
+		TargetAmount: req.GetTargetAmount(),
+		AccountID:    req.AccountId,
+		CategoryID:   req.CategoryId,
+		OwnerUserID:  req.OwnerUserId,
+	}
+	if req.TargetDate != nil {
+		savingsGoal.TargetDate = req.GetTargetDate().AsTime()
+	}
+	if req.StartDate != nil {
+		savingsGoal.StartDate = req.GetStartDate().AsTime()
+	}
+
+	created, err := f.goalService.CreateGoal(ctx, savingsGoal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateSavingsGoalResponse{
+		Goal: convertSavingsGoalToPb(created),
+	}, nil
+}
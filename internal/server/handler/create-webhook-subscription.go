@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/webhook"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.CreateWebhookSubscriptionResponse, error) {
+	subscription, err := f.webhookService.CreateSubscription(ctx, &webhook.Subscription{
+		URL:         req.GetUrl(),
+		Secret:      req.GetSecret(),
+		EventTypes:  req.GetEventTypes(),
+		Description: req.Description,
+		Enabled:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateWebhookSubscriptionResponse{
+		Subscription: convertWebhookSubscriptionToPb(subscription),
+		Secret:       subscription.Secret,
+	}, nil
+}
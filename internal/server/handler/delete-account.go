@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	err := f.accountService.DeleteAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAccountResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteAlertRule(ctx context.Context, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	err := f.alertService.DeleteRule(ctx, req.GetRuleId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAlertRuleResponse{
+		Success: true,
+	}, nil
+}
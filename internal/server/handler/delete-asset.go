@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteAsset(ctx context.Context, req *pb.DeleteAssetRequest) (*pb.DeleteAssetResponse, error) {
+	err := f.networthService.DeleteAsset(ctx, req.GetAssetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAssetResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteBalanceSnapshot(ctx context.Context, req *pb.DeleteBalanceSnapshotRequest) (*pb.DeleteBalanceSnapshotResponse, error) {
+	err := f.balanceService.DeleteSnapshot(ctx, req.GetSnapshotId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteBalanceSnapshotResponse{
+		Success: true,
+	}, nil
+}
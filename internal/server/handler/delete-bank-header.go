@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteBankHeader(ctx context.Context, req *pb.DeleteBankHeaderRequest) (*pb.DeleteBankHeaderResponse, error) {
+	err := f.uploaderService.DeleteHeaderMapping(ctx, req.GetHeaderId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteBankHeaderResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteBankParsingProfile(ctx context.Context, req *pb.DeleteBankParsingProfileRequest) (*pb.DeleteBankParsingProfileResponse, error) {
+	err := f.uploaderService.DeleteParsingProfile(ctx, req.GetBankId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteBankParsingProfileResponse{
+		Success: true,
+	}, nil
+}
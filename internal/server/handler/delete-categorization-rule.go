@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteCategorizationRule(ctx context.Context, req *pb.DeleteCategorizationRuleRequest) (*pb.DeleteCategorizationRuleResponse, error) {
+	err := f.ruleService.DeleteRule(ctx, req.GetRuleId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCategorizationRuleResponse{
+		Success: true,
+	}, nil
+}
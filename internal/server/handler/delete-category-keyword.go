@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteCategoryKeyword(ctx context.Context, req *pb.DeleteCategoryKeywordRequest) (*pb.DeleteCategoryKeywordResponse, error) {
+	err := f.categoryService.DeleteKeyword(ctx, req.GetKeywordId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCategoryKeywordResponse{
+		Success: true,
+	}, nil
+}
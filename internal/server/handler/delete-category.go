@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	reassigned, err := f.categoryService.DeleteCategory(ctx, req.GetCategoryId(), req.ReassignToCategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCategoryResponse{
+		Success:                true,
+		ReassignedTransactions: reassigned,
+	}, nil
+}
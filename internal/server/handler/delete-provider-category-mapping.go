@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteProviderCategoryMapping(ctx context.Context, req *pb.DeleteProviderCategoryMappingRequest) (*pb.DeleteProviderCategoryMappingResponse, error) {
+	err := f.categoryService.DeleteProviderMapping(ctx, req.GetMappingId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteProviderCategoryMappingResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteSavingsGoal(ctx context.Context, req *pb.DeleteSavingsGoalRequest) (*pb.DeleteSavingsGoalResponse, error) {
+	err := f.goalService.DeleteGoal(ctx, req.GetGoalId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteSavingsGoalResponse{
+		Success: true,
+	}, nil
+}
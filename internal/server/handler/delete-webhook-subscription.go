@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	err := f.webhookService.DeleteSubscription(ctx, req.GetSubscriptionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteWebhookSubscriptionResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ExplainTransactionCategory(ctx context.Context, req *pb.ExplainTransactionCategoryRequest) (*pb.ExplainTransactionCategoryResponse, error) {
+	explanation, err := f.ruleService.ExplainTransaction(ctx, req.GetTransactionId())
+	if err != nil {
+		return nil, err
+	}
+
+	tr := explanation.Transaction
+	current := explanation.Current
+
+	provenance := convertCategoryProvenanceToPb(&tr.CategoryProvenance)
+	if provenance != nil && provenance.RuleId != nil {
+		if name, ok := explanation.RuleNames[*provenance.RuleId]; ok {
+			provenance.RuleName = &name
+		}
+	}
+
+	evaluation := &pb.CategoryEvaluation{
+		CategoryId:     current.CategoryID,
+		MatchedRuleIds: current.RuleIDs,
+		KeywordMatches: convertKeywordMatchesToPb(explanation.KeywordMatches),
+	}
+	if current.CategoryID != nil {
+		if c := f.categoryService.Store().GetCategory(*current.CategoryID); c != nil {
+			evaluation.CategoryName = c.Name
+		}
+
+		p := transaction.NewCategoryProvenance(current.Source, current.CategoryRuleID, current.MatchedText, current.Confidence)
+		evaluation.Provenance = convertCategoryProvenanceToPb(&p)
+		if current.CategoryRuleID != nil {
+			if name, ok := explanation.RuleNames[*current.CategoryRuleID]; ok {
+				evaluation.Provenance.RuleName = &name
+			}
+		}
+	}
+	for _, m := range evaluation.KeywordMatches {
+		if c := f.categoryService.Store().GetCategory(m.CategoryId); c != nil {
+			m.CategoryName = c.Name
+		}
+	}
+
+	return &pb.ExplainTransactionCategoryResponse{
+		TransactionId: tr.ID,
+		CategoryId:    tr.CategoryID,
+		CategoryName:  tr.CategoryName,
+		Provenance:    provenance,
+		Current:       evaluation,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/report"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+)
+
+func (f *FinAggregatorServer) GenerateReport(ctx context.Context, req *pb.GenerateReportRequest) (*httpbody.HttpBody, error) {
+	doc, err := f.reportService.GenerateReport(ctx, &report.ReportRequest{
+		Period: mapPbToReportPeriod(req.GetPeriod()),
+		Year:   req.GetYear(),
+		Month:  req.GetMonth(),
+		Format: mapPbToReportFormat(req.GetFormat()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: doc.ContentType,
+		Data:        doc.Content,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	acc, err := f.accountService.GetAccount(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetAccountResponse{
+		Account: convertAccountToPb(acc),
+	}, nil
+}
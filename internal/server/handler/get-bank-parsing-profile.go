@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) GetBankParsingProfile(ctx context.Context, req *pb.GetBankParsingProfileRequest) (*pb.GetBankParsingProfileResponse, error) {
+	profile, err := f.uploaderService.GetParsingProfile(ctx, req.GetBankId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetBankParsingProfileResponse{
+		Profile: convertParsingProfileToPb(profile),
+	}, nil
+}
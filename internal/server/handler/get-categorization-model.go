@@ -0,0 +1,16 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) GetCategorizationModel(_ context.Context, _ *pb.GetCategorizationModelRequest) (*pb.GetCategorizationModelResponse, error) {
+	res := &pb.GetCategorizationModelResponse{}
+	if info := f.classifierService.Store().GetInfo(); info != nil {
+		res.Model = convertCategorizationModelToPb(info)
+	}
+
+	return res, nil
+}
@@ -7,10 +7,21 @@ import (
 )
 
 func (f *FinAggregatorServer) GetMonzoAccount(ctx context.Context, _ *pb.MonzoAccountRequest) (*pb.MonzoAccountResponse, error) {
-	err := f.monzoService.GetAccountID(ctx)
+	accounts, err := f.monzoService.GetAccounts(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.MonzoAccountResponse{Success: true}, nil
+	res := make([]*pb.MonzoAccount, len(accounts))
+	for i, acc := range accounts {
+		res[i] = &pb.MonzoAccount{
+			Id:          acc.ID,
+			Description: acc.Description,
+			Type:        acc.Type,
+			Currency:    acc.Currency,
+			Closed:      acc.Closed,
+		}
+	}
+
+	return &pb.MonzoAccountResponse{Success: true, Accounts: res}, nil
 }
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/networth"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (f *FinAggregatorServer) GetNetWorthHistory(ctx context.Context, req *pb.GetNetWorthHistoryRequest) (*pb.GetNetWorthHistoryResponse, error) {
+	filter := &networth.HistoryFilter{UserID: req.UserId}
+	if req.From != nil {
+		from := req.GetFrom().AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.GetTo().AsTime()
+		filter.To = &to
+	}
+
+	history, err := f.networthService.GetNetWorthHistory(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]*pb.NetWorthPoint, len(history))
+	for i, point := range history {
+		breakdown := make([]*pb.AssetClassValue, len(point.Breakdown))
+		for j, cv := range point.Breakdown {
+			breakdown[j] = &pb.AssetClassValue{
+				AssetClass: mapAssetClassToPb(cv.AssetClass),
+				Kind:       mapAssetKindToPb(cv.Kind),
+				Value:      formatAmount(cv.Value),
+			}
+		}
+
+		points[i] = &pb.NetWorthPoint{
+			Date:             timestamppb.New(point.Date),
+			TotalAssets:      formatAmount(point.TotalAssets),
+			TotalLiabilities: formatAmount(point.TotalLiabilities),
+			NetWorth:         formatAmount(point.NetWorth),
+			Breakdown:        breakdown,
+		}
+	}
+
+	return &pb.GetNetWorthHistoryResponse{
+		Points: points,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) GetSavingsGoalStatus(ctx context.Context, req *pb.GetSavingsGoalStatusRequest) (*pb.GetSavingsGoalStatusResponse, error) {
+	progress, err := f.goalService.GetGoalStatus(ctx, req.GetGoalId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSavingsGoalStatusResponse{
+		Goal:                        convertSavingsGoalToPb(&progress.Goal),
+		SavedAmount:                 formatAmount(progress.SavedAmount),
+		RemainingAmount:             formatAmount(progress.RemainingAmount),
+		PercentComplete:             progress.PercentComplete,
+		MonthsRemaining:             progress.MonthsRemaining,
+		RequiredMonthlyContribution: formatAmount(progress.RequiredMonthly),
+		AverageMonthlyContribution:  formatAmount(progress.AverageMonthly),
+		ProjectedAmount:             formatAmount(progress.ProjectedAmount),
+		Status:                      mapGoalStatusToPb(progress.Status),
+	}, nil
+}
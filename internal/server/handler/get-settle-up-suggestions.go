@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) GetSettleUpSuggestions(ctx context.Context, _ *pb.GetSettleUpSuggestionsRequest) (*pb.GetSettleUpSuggestionsResponse, error) {
+	suggestions, err := f.householdService.GetSettleUpSuggestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.SettlementSuggestion, len(suggestions))
+	for i, s := range suggestions {
+		res[i] = &pb.SettlementSuggestion{
+			FromUserId: s.FromUserID,
+			ToUserId:   s.ToUserID,
+			Amount:     s.Amount,
+		}
+	}
+
+	return &pb.GetSettleUpSuggestionsResponse{
+		Suggestions: res,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/insight"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) GetSpendingInsights(ctx context.Context, req *pb.GetSpendingInsightsRequest) (*pb.GetSpendingInsightsResponse, error) {
+	insights, err := f.insightService.GetSpendingInsights(ctx, &insight.InsightFilter{
+		Month:            req.GetMonth(),
+		Year:             req.GetYear(),
+		UserID:           req.UserId,
+		ParentCategoryID: req.ParentCategoryId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSpendingInsightsResponse{
+		Month:         insights.Month,
+		Year:          insights.Year,
+		TotalSpend:    formatAmount(insights.TotalSpend),
+		Categories:    convertCategoryInsightsToPb(insights.Categories),
+		LargeExpenses: convertTransactionInsightsToPb(insights.LargeExpenses),
+	}, nil
+}
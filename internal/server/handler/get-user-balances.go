@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) GetUserBalances(ctx context.Context, req *pb.GetUserBalancesRequest) (*pb.GetUserBalancesResponse, error) {
+	balances, err := f.householdService.GetBalances(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.UserBalance, len(balances))
+	for i, b := range balances {
+		res[i] = &pb.UserBalance{
+			DebtorUserId:   b.DebtorUserID,
+			CreditorUserId: b.CreditorUserID,
+			Amount:         b.Amount,
+		}
+	}
+
+	return &pb.GetUserBalancesResponse{
+		Balances: res,
+	}, nil
+}
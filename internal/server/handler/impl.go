@@ -1,12 +1,24 @@
 package handler
 
 import (
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	"github.com/Everest13/fin-aggregator-service/internal/service/alert"
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
 	"github.com/Everest13/fin-aggregator-service/internal/service/bank"
+	"github.com/Everest13/fin-aggregator-service/internal/service/cache"
 	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/classifier"
+	"github.com/Everest13/fin-aggregator-service/internal/service/goal"
+	"github.com/Everest13/fin-aggregator-service/internal/service/household"
+	"github.com/Everest13/fin-aggregator-service/internal/service/insight"
 	"github.com/Everest13/fin-aggregator-service/internal/service/monzo"
+	"github.com/Everest13/fin-aggregator-service/internal/service/networth"
+	"github.com/Everest13/fin-aggregator-service/internal/service/report"
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
 	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
 	"github.com/Everest13/fin-aggregator-service/internal/service/uploader"
 	"github.com/Everest13/fin-aggregator-service/internal/service/user"
+	"github.com/Everest13/fin-aggregator-service/internal/service/webhook"
 	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
 )
 
@@ -18,6 +30,18 @@ type FinAggregatorServer struct {
 	userService        *user.Service
 	uploaderService    *uploader.Service
 	monzoService       *monzo.Service
+	insightService     *insight.Service
+	householdService   *household.Service
+	accountService     *account.Service
+	balanceService     *balance.Service
+	networthService    *networth.Service
+	goalService        *goal.Service
+	reportService      *report.Service
+	alertService       *alert.Service
+	webhookService     *webhook.Service
+	ruleService        *rule.Service
+	classifierService  *classifier.Service
+	cacheService       *cache.Service
 }
 
 func NewFinAggregatorServer(
@@ -27,6 +51,18 @@ func NewFinAggregatorServer(
 	userService *user.Service,
 	uploaderService *uploader.Service,
 	monzoService *monzo.Service,
+	insightService *insight.Service,
+	householdService *household.Service,
+	accountService *account.Service,
+	balanceService *balance.Service,
+	networthService *networth.Service,
+	goalService *goal.Service,
+	reportService *report.Service,
+	alertService *alert.Service,
+	webhookService *webhook.Service,
+	ruleService *rule.Service,
+	classifierService *classifier.Service,
+	cacheService *cache.Service,
 ) *FinAggregatorServer {
 	return &FinAggregatorServer{
 		transactionService: transactionService,
@@ -35,5 +71,17 @@ func NewFinAggregatorServer(
 		userService:        userService,
 		uploaderService:    uploaderService,
 		monzoService:       monzoService,
+		insightService:     insightService,
+		householdService:   householdService,
+		accountService:     accountService,
+		balanceService:     balanceService,
+		networthService:    networthService,
+		goalService:        goalService,
+		reportService:      reportService,
+		alertService:       alertService,
+		webhookService:     webhookService,
+		ruleService:        ruleService,
+		classifierService:  classifierService,
+		cacheService:       cacheService,
 	}
 }
@@ -12,6 +12,7 @@ func (f *FinAggregatorServer) LoadMonzoTransactions(ctx context.Context, req *pb
 		req.GetBefore().AsTime(),
 		req.GetUserId(),
 		req.GetBankId(),
+		req.AccountId,
 	)
 	if err != nil {
 		return nil, err
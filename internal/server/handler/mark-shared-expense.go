@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/household"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) MarkSharedExpense(ctx context.Context, req *pb.MarkSharedExpenseRequest) (*pb.MarkSharedExpenseResponse, error) {
+	shares := make([]household.ShareInput, 0, len(req.GetShares()))
+	for _, share := range req.GetShares() {
+		shares = append(shares, household.ShareInput{
+			UserID:     share.GetUserId(),
+			Percentage: share.Percentage,
+			Amount:     share.Amount,
+		})
+	}
+
+	expense, err := f.householdService.MarkSharedExpense(ctx, &household.SharedExpenseData{
+		TransactionID: req.GetTransactionId(),
+		PayerUserID:   req.PayerUserId,
+		SplitMethod:   mapPbToSplitMethod(req.GetSplitMethod()),
+		Shares:        shares,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MarkSharedExpenseResponse{
+		SharedExpense: convertSharedExpenseToPb(expense),
+	}, nil
+}
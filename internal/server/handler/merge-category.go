@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) MergeCategory(ctx context.Context, req *pb.MergeCategoryRequest) (*pb.MergeCategoryResponse, error) {
+	reassigned, err := f.categoryService.MergeCategory(ctx, req.GetCategoryId(), req.GetTargetCategoryId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MergeCategoryResponse{
+		Success:                true,
+		ReassignedTransactions: reassigned,
+	}, nil
+}
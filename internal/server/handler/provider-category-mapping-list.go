@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListProviderCategoryMapping(ctx context.Context, req *pb.ListProviderCategoryMappingRequest) (*pb.ListProviderCategoryMappingResponse, error) {
+	mappings, err := f.categoryService.ProviderMappingList(ctx, req.BankId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.ProviderCategoryMapping, len(mappings))
+	for i := range mappings {
+		res[i] = convertProviderMappingToPb(&mappings[i])
+	}
+
+	return &pb.ListProviderCategoryMappingResponse{
+		Mappings: res,
+	}, nil
+}
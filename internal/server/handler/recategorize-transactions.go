@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) RecategorizeTransactions(ctx context.Context, req *pb.RecategorizeTransactionsRequest) (*pb.RecategorizeTransactionsResponse, error) {
+	filter := &transaction.RecategorizeFilter{
+		BankID:             req.BankId,
+		UserID:             req.UserId,
+		AccountID:          req.AccountId,
+		CategoryIDs:        req.GetCategoryIds(),
+		SkipManuallyEdited: req.GetSkipManuallyEdited(),
+		DryRun:             req.GetDryRun(),
+	}
+	if req.From != nil {
+		t := req.From.AsTime()
+		filter.From = &t
+	}
+	if req.To != nil {
+		t := req.To.AsTime()
+		filter.To = &t
+	}
+
+	res, err := f.ruleService.RecategorizeTransactions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*pb.TransactionCategoryChange, len(res.Changes))
+	for i := range res.Changes {
+		changes[i] = convertCategoryChangeToPb(&res.Changes[i])
+		if c := f.categoryService.Store().GetCategory(res.Changes[i].PreviousCategoryID); c != nil {
+			changes[i].PreviousCategoryName = c.Name
+		}
+		if c := f.categoryService.Store().GetCategory(res.Changes[i].CategoryID); c != nil {
+			changes[i].CategoryName = c.Name
+		}
+	}
+
+	return &pb.RecategorizeTransactionsResponse{
+		ScannedCount: res.Scanned,
+		ChangedCount: res.Changed,
+		Changes:      changes,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ReconcileAccount(ctx context.Context, req *pb.ReconcileAccountRequest) (*pb.ReconcileAccountResponse, error) {
+	res, err := f.balanceService.Reconcile(ctx, convertSnapshotFilter(req.GetAccountId(), req.GetFrom(), req.GetTo()))
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make([]*pb.ReconciliationPeriod, len(res.Periods))
+	for i := range res.Periods {
+		periods[i] = convertReconciliationPeriodToPb(&res.Periods[i])
+	}
+
+	return &pb.ReconcileAccountResponse{
+		AccountId:     res.AccountID,
+		SnapshotCount: int32(res.SnapshotCount),
+		IsReconciled:  res.IsReconciled,
+		Periods:       periods,
+		FirstIssue:    convertReconciliationPeriodToPb(res.FirstIssue),
+	}, nil
+}
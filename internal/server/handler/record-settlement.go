@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/household"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) RecordSettlement(ctx context.Context, req *pb.RecordSettlementRequest) (*pb.RecordSettlementResponse, error) {
+	data := &household.SettlementData{
+		FromUserID:    req.GetFromUserId(),
+		ToUserID:      req.GetToUserId(),
+		Amount:        req.Amount,
+		TransactionID: req.TransactionId,
+		Note:          req.Note,
+	}
+	if req.SettledAt != nil {
+		data.SettledAt = req.GetSettledAt().AsTime()
+	}
+
+	settlement, err := f.householdService.RecordSettlement(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RecordSettlementResponse{
+		Settlement: convertSettlementToPb(settlement),
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	delivery, err := f.webhookService.Redeliver(ctx, req.GetDeliveryId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RedeliverWebhookResponse{
+		Delivery: convertWebhookDeliveryToPb(delivery),
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) RejectCategorySuggestion(ctx context.Context, req *pb.RejectCategorySuggestionRequest) (*pb.RejectCategorySuggestionResponse, error) {
+	rejected, err := f.ruleService.RejectSuggestion(ctx, req.GetSuggestionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectCategorySuggestionResponse{
+		Suggestion: convertCategorySuggestionToPb(rejected),
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ReloadCaches(ctx context.Context, _ *pb.ReloadCachesRequest) (*pb.ReloadCachesResponse, error) {
+	caches, err := f.cacheService.ReloadAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReloadCachesResponse{
+		Caches: caches,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/goal"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListSavingsGoal(ctx context.Context, req *pb.ListSavingsGoalRequest) (*pb.ListSavingsGoalResponse, error) {
+	goals, err := f.goalService.GoalList(ctx, &goal.SavingsGoalFilter{UserID: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.SavingsGoal, len(goals))
+	for i := range goals {
+		res[i] = convertSavingsGoalToPb(&goals[i])
+	}
+
+	return &pb.ListSavingsGoalResponse{
+		Goals: res,
+	}, nil
+}
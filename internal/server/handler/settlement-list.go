@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListSettlement(ctx context.Context, req *pb.ListSettlementRequest) (*pb.ListSettlementResponse, error) {
+	settlements, err := f.householdService.SettlementList(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Settlement, len(settlements))
+	for i := range settlements {
+		res[i] = convertSettlementToPb(&settlements[i])
+	}
+
+	return &pb.ListSettlementResponse{
+		Settlements: res,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListSharedExpense(ctx context.Context, req *pb.ListSharedExpenseRequest) (*pb.ListSharedExpenseResponse, error) {
+	expenses, err := f.householdService.SharedExpenseList(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.SharedExpense, len(expenses))
+	for i := range expenses {
+		res[i] = convertSharedExpenseToPb(&expenses[i])
+	}
+
+	return &pb.ListSharedExpenseResponse{
+		SharedExpenses: res,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) TestAlertRule(ctx context.Context, req *pb.TestAlertRuleRequest) (*pb.TestAlertRuleResponse, error) {
+	err := f.alertService.TestRule(ctx, req.GetRuleId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TestAlertRuleResponse{
+		Success: true,
+	}, nil
+}
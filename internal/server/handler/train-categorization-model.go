@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) TrainCategorizationModel(ctx context.Context, _ *pb.TrainCategorizationModelRequest) (*pb.TrainCategorizationModelResponse, error) {
+	info, err := f.classifierService.Train(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TrainCategorizationModelResponse{
+		Model: convertCategorizationModelToPb(info),
+	}, nil
+}
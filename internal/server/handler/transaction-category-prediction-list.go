@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListTransactionCategoryPrediction(ctx context.Context, req *pb.ListTransactionCategoryPredictionRequest) (*pb.ListTransactionCategoryPredictionResponse, error) {
+	predictions, err := f.classifierService.PredictTransaction(ctx, req.GetTransactionId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.CategoryPrediction, len(predictions))
+	for i, prediction := range predictions {
+		res[i] = &pb.CategoryPrediction{
+			CategoryId: prediction.CategoryID,
+			Confidence: prediction.Confidence,
+		}
+		if c := f.categoryService.Store().GetCategory(prediction.CategoryID); c != nil {
+			res[i].CategoryName = c.Name
+		}
+	}
+
+	return &pb.ListTransactionCategoryPredictionResponse{
+		Predictions: res,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UnmarkSharedExpense(ctx context.Context, req *pb.UnmarkSharedExpenseRequest) (*pb.UnmarkSharedExpenseResponse, error) {
+	err := f.householdService.UnmarkSharedExpense(ctx, req.GetTransactionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnmarkSharedExpenseResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.UpdateAccountResponse, error) {
+	updateData := &account.AccountUpdateData{
+		ID:          req.GetAccountId(),
+		Currency:    req.Currency,
+		ExternalID:  req.ExternalId,
+		DisplayName: req.DisplayName,
+	}
+
+	if req.Type != nil {
+		accType := mapPbToAccountType(req.GetType())
+		updateData.Type = &accType
+	}
+
+	if len(req.GetOwnerIds()) > 0 {
+		updateData.OwnerIDs = req.GetOwnerIds()
+	}
+
+	acc, err := f.accountService.UpdateAccount(ctx, updateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateAccountResponse{
+		Account: convertAccountToPb(acc),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/alert"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateAlertRule(ctx context.Context, req *pb.UpdateAlertRuleRequest) (*pb.UpdateAlertRuleResponse, error) {
+	updateData := &alert.RuleUpdateData{
+		ID:        req.GetRuleId(),
+		Name:      req.Name,
+		Threshold: req.Threshold,
+		Target:    req.Target,
+		Enabled:   req.Enabled,
+	}
+
+	if req.Period != nil {
+		updateData.Period = mapPbToAlertPeriod(req.GetPeriod())
+	}
+	if req.Channel != nil {
+		channel := mapPbToAlertChannel(req.GetChannel())
+		updateData.Channel = &channel
+	}
+
+	rule, err := f.alertService.UpdateRule(ctx, updateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateAlertRuleResponse{
+		Rule: convertAlertRuleToPb(rule),
+	}, nil
+}
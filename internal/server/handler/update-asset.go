@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/networth"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateAsset(ctx context.Context, req *pb.UpdateAssetRequest) (*pb.UpdateAssetResponse, error) {
+	updateData := &networth.AssetUpdateData{
+		ID:          req.GetAssetId(),
+		Name:        req.Name,
+		OwnerUserID: req.OwnerUserId,
+	}
+
+	if req.AssetClass != nil {
+		assetClass := mapPbToAssetClass(req.GetAssetClass())
+		updateData.AssetClass = &assetClass
+	}
+
+	asset, err := f.networthService.UpdateAsset(ctx, updateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateAssetResponse{
+		Asset: convertAssetToPb(asset),
+	}, nil
+}
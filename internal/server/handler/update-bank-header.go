@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/uploader"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateBankHeader(ctx context.Context, req *pb.UpdateBankHeaderRequest) (*pb.UpdateBankHeaderResponse, error) {
+	updated, err := f.uploaderService.UpdateHeaderMapping(ctx, &uploader.HeaderMappingUpdateData{
+		ID:       req.GetHeaderId(),
+		Name:     req.Name,
+		Required: req.Required,
+		TrFields: convertPbToTransactionFields(req.GetTransactionFields()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateBankHeaderResponse{
+		Header: convertBankHeaderToPb(updated),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+
+	csvParser "github.com/Everest13/fin-aggregator-service/internal/service/uploader/csv-parser"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateBankParsingProfile(ctx context.Context, req *pb.UpdateBankParsingProfileRequest) (*pb.UpdateBankParsingProfileResponse, error) {
+	profile, err := f.uploaderService.SaveParsingProfile(ctx, &csvParser.Profile{
+		BankID:             req.GetBankId(),
+		DateLayouts:        req.GetDateLayouts(),
+		Timezone:           req.GetTimezone(),
+		DecimalSeparator:   req.GetDecimalSeparator(),
+		ThousandsSeparator: req.GetThousandsSeparator(),
+		SignConvention:     mapPbToSignConvention(req.GetSignConvention()),
+		AmountMode:         mapPbToAmountMode(req.GetAmountMode()),
+		Delimiter:          req.GetDelimiter(),
+		Encoding:           mapPbToCsvEncoding(req.GetEncoding()),
+		HeaderRow:          req.HeaderRow,
+		SkipRows:           req.GetSkipRows(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateBankParsingProfileResponse{
+		Profile: convertParsingProfileToPb(profile),
+	}, nil
+}
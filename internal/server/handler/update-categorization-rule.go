@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateCategorizationRule(ctx context.Context, req *pb.UpdateCategorizationRuleRequest) (*pb.UpdateCategorizationRuleResponse, error) {
+	updateData := &rule.RuleUpdateData{
+		ID:         req.GetRuleId(),
+		Name:       req.Name,
+		Priority:   req.Priority,
+		Conditions: convertPbToCategorizationConditions(req.GetConditions()),
+		Enabled:    req.Enabled,
+	}
+
+	if req.Actions != nil {
+		actions := convertPbToCategorizationActions(req.GetActions())
+		updateData.Actions = &actions
+	}
+
+	updated, err := f.ruleService.UpdateRule(ctx, updateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCategorizationRuleResponse{
+		Rule: convertCategorizationRuleToPb(updated),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	data := &category.CategoryUpdateData{
+		ID:          req.GetCategoryId(),
+		Name:        req.Name,
+		Description: req.Description,
+		Archived:    req.Archived,
+		ParentID:    req.ParentId,
+	}
+	if req.Kind != nil {
+		kind := mapPbToCategoryKind(req.GetKind())
+		data.Kind = &kind
+	}
+
+	updated, err := f.categoryService.UpdateCategory(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCategoryResponse{
+		Category: convertCategoryToPb(updated),
+	}, nil
+}
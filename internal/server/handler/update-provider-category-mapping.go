@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateProviderCategoryMapping(ctx context.Context, req *pb.UpdateProviderCategoryMappingRequest) (*pb.UpdateProviderCategoryMappingResponse, error) {
+	updated, err := f.categoryService.UpdateProviderMapping(ctx, req.GetMappingId(), req.GetCategoryId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateProviderCategoryMappingResponse{
+		Mapping: convertProviderMappingToPb(updated),
+	}, nil
+}
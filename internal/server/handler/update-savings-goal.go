@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/goal"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateSavingsGoal(ctx context.Context, req *pb.UpdateSavingsGoalRequest) (*pb.UpdateSavingsGoalResponse, error) {
+	updateData := &goal.SavingsGoalUpdateData{
+		ID:           req.GetGoalId(),
+		Name:         req.Name,
+		TargetAmount: req.TargetAmount,
+		AccountID:    req.AccountId,
+		CategoryID:   req.CategoryId,
+		OwnerUserID:  req.OwnerUserId,
+	}
+
+	if req.TargetDate != nil {
+		targetDate := req.GetTargetDate().AsTime()
+		updateData.TargetDate = &targetDate
+	}
+
+	updated, err := f.goalService.UpdateGoal(ctx, updateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateSavingsGoalResponse{
+		Goal: convertSavingsGoalToPb(updated),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/webhook"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) UpdateWebhookSubscription(ctx context.Context, req *pb.UpdateWebhookSubscriptionRequest) (*pb.UpdateWebhookSubscriptionResponse, error) {
+	subscription, err := f.webhookService.UpdateSubscription(ctx, &webhook.SubscriptionUpdateData{
+		ID:          req.GetSubscriptionId(),
+		URL:         req.Url,
+		EventTypes:  req.GetEventTypes(),
+		Description: req.Description,
+		Enabled:     req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateWebhookSubscriptionResponse{
+		Subscription: convertWebhookSubscriptionToPb(subscription),
+	}, nil
+}
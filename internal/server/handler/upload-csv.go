@@ -6,7 +6,7 @@ import (
 )
 
 func (f *FinAggregatorServer) UploadCSV(ctx context.Context, req *pb.UploadCSVRequest) (*pb.UploadCSVResponse, error) {
-	recordErrs, err := f.uploaderService.UploadCSV(ctx, req.GetBankId(), req.GetUserId(), req.GetCsvData())
+	recordErrs, dialect, err := f.uploaderService.UploadCSV(ctx, req.GetBankId(), req.GetUserId(), req.AccountId, req.GetCsvData())
 	if err != nil {
 		return nil, err
 	}
@@ -14,5 +14,6 @@ func (f *FinAggregatorServer) UploadCSV(ctx context.Context, req *pb.UploadCSVRe
 	return &pb.UploadCSVResponse{
 		Success:     true,
 		RecordError: convertRecordErrorsPb(recordErrs),
+		Dialect:     convertCsvDialectToPb(dialect),
 	}, nil
 }
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/webhook"
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListWebhookDelivery(ctx context.Context, req *pb.ListWebhookDeliveryRequest) (*pb.ListWebhookDeliveryResponse, error) {
+	filter := &webhook.DeliveryFilter{
+		SubscriptionID: req.SubscriptionId,
+		Limit:          req.GetLimit(),
+	}
+	if req.GetStatus() != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+		deliveryStatus := mapPbToWebhookDeliveryStatus(req.GetStatus())
+		filter.Status = &deliveryStatus
+	}
+
+	deliveries, err := f.webhookService.DeliveryList(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.WebhookDelivery, len(deliveries))
+	for i := range deliveries {
+		res[i] = convertWebhookDeliveryToPb(&deliveries[i])
+	}
+
+	return &pb.ListWebhookDeliveryResponse{
+		Deliveries: res,
+	}, nil
+}
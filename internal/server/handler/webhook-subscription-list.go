@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/Everest13/fin-aggregator-service/pkg/api/fin-aggregate-service"
+)
+
+func (f *FinAggregatorServer) ListWebhookSubscription(ctx context.Context, _ *pb.ListWebhookSubscriptionRequest) (*pb.ListWebhookSubscriptionResponse, error) {
+	subscriptions, err := f.webhookService.SubscriptionList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.WebhookSubscription, len(subscriptions))
+	for i := range subscriptions {
+		res[i] = convertWebhookSubscriptionToPb(&subscriptions[i])
+	}
+
+	return &pb.ListWebhookSubscriptionResponse{
+		Subscriptions: res,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package account
+
+import "time"
+
+const (
+	accountTable      = "account"
+	accountOwnerTable = "account_owner"
+	userBankTable     = "user_bank"
+)
+
+const DefaultCurrency = "GBP"
+
+type AccountType string
+
+const (
+	CurrentAccountType    AccountType = "CURRENT"
+	SavingsAccountType    AccountType = "SAVINGS"
+	CreditCardAccountType AccountType = "CREDIT_CARD"
+	LoanAccountType       AccountType = "LOAN"
+	OtherAccountType      AccountType = "OTHER"
+)
+
+type Account struct {
+	ID          int64
+	BankID      int64
+	Type        AccountType
+	Currency    string
+	ExternalID  *string
+	DisplayName string
+	OwnerIDs    []int64
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+type AccountData struct {
+	BankID      int64
+	Type        AccountType
+	Currency    string
+	ExternalID  *string
+	DisplayName string
+	OwnerIDs    []int64
+}
+
+// AccountUpdateData holds optional changes; nil OwnerIDs keep the current owners.
+type AccountUpdateData struct {
+	ID          int64
+	Type        *AccountType
+	Currency    *string
+	ExternalID  *string
+	DisplayName *string
+	OwnerIDs    []int64
+}
+
+type AccountFilter struct {
+	UserID *int64
+	BankID *int64
+}
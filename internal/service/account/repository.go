@@ -0,0 +1,221 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func accountSelect() squirrel.SelectBuilder {
+	return squirrel.
+		Select(
+			"a.id",
+			"a.bank_id",
+			"a.type",
+			"a.currency",
+			"a.external_id",
+			"a.display_name",
+			"ARRAY_REMOVE(ARRAY_AGG(ao.user_id ORDER BY ao.user_id), NULL) AS owner_ids",
+			"a.created_at",
+			"a.updated_at",
+		).
+		From("account a").
+		LeftJoin("account_owner ao ON a.id = ao.account_id").
+		GroupBy("a.id").
+		PlaceholderFormat(squirrel.Dollar)
+}
+
+func (r *repository) getAccount(ctx context.Context, id int64) (*Account, error) {
+	query, args, err := accountSelect().
+		Where(squirrel.Eq{"a.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var account Account
+	if err = pgxscan.Get(ctx, r.dbPool, &account, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+func (r *repository) accountList(ctx context.Context, filter *AccountFilter) ([]Account, error) {
+	queryBuilder := accountSelect().OrderBy("a.bank_id", "a.id")
+
+	if filter.BankID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"a.bank_id": *filter.BankID})
+	}
+
+	if filter.UserID != nil {
+		queryBuilder = queryBuilder.Where(
+			"EXISTS (SELECT 1 FROM account_owner o WHERE o.account_id = a.id AND o.user_id = ?)",
+			*filter.UserID,
+		)
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var accounts []Account
+	if err = pgxscan.Select(ctx, r.dbPool, &accounts, query, args...); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+func (r *repository) createAccount(ctx context.Context, data *AccountData) (int64, error) {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := squirrel.
+		Insert(accountTable).
+		Columns("bank_id", "type", "currency", "external_id", "display_name").
+		Values(data.BankID, data.Type, data.Currency, data.ExternalID, data.DisplayName).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var id int64
+	if err = tx.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	if err = replaceOwners(ctx, tx, id, data.BankID, data.OwnerIDs); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *repository) updateAccount(ctx context.Context, account *Account, replaceOwnerIDs bool) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := squirrel.
+		Update(accountTable).
+		Set("type", account.Type).
+		Set("currency", account.Currency).
+		Set("external_id", account.ExternalID).
+		Set("display_name", account.DisplayName).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": account.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if replaceOwnerIDs {
+		if err = replaceOwners(ctx, tx, account.ID, account.BankID, account.OwnerIDs); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// replaceOwners rewrites account owners and keeps user_bank links in sync for them.
+func replaceOwners(ctx context.Context, tx pgx.Tx, accountID, bankID int64, ownerIDs []int64) error {
+	if _, err := tx.Exec(ctx, "DELETE FROM account_owner WHERE account_id = $1", accountID); err != nil {
+		return fmt.Errorf("failed to delete account owners: %w", err)
+	}
+
+	ownerBuilder := squirrel.
+		Insert(accountOwnerTable).
+		Columns("account_id", "user_id").
+		PlaceholderFormat(squirrel.Dollar)
+	userBankBuilder := squirrel.
+		Insert(userBankTable).
+		Columns("user_id", "bank_id").
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, userID := range ownerIDs {
+		ownerBuilder = ownerBuilder.Values(accountID, userID)
+		userBankBuilder = userBankBuilder.Values(userID, bankID)
+	}
+
+	for _, builder := range []squirrel.InsertBuilder{ownerBuilder, userBankBuilder} {
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build SQL: %w", err)
+		}
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to save account owners: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) deleteAccount(ctx context.Context, id int64) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, "DELETE FROM account WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM account_owner WHERE account_id = $1", id); err != nil {
+		return fmt.Errorf("failed to delete account owners: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *repository) hasTransactions(ctx context.Context, id int64) (bool, error) {
+	var exists bool
+	err := r.dbPool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM transaction WHERE account_id = $1)", id).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check account transactions: %w", err)
+	}
+
+	return exists, nil
+}
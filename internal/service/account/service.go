@@ -0,0 +1,190 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/bank"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	repo        *repository
+	bankService *bank.Service
+}
+
+func NewService(dbPool *pgxpool.Pool, bankService *bank.Service) *Service {
+	return &Service{
+		repo:        newRepository(dbPool),
+		bankService: bankService,
+	}
+}
+
+func (s *Service) GetAccount(ctx context.Context, id int64) (*Account, error) {
+	account, err := s.repo.getAccount(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to get account", err, "account_id", id)
+		return nil, psql.MapPostgresError("failed to get account", err)
+	}
+
+	return account, nil
+}
+
+func (s *Service) AccountList(ctx context.Context, filter *AccountFilter) ([]Account, error) {
+	accounts, err := s.repo.accountList(ctx, filter)
+	if err != nil {
+		logger.Error("failed to get accounts", err)
+		return nil, psql.MapPostgresError("failed to get accounts", err)
+	}
+
+	return accounts, nil
+}
+
+func (s *Service) CreateAccount(ctx context.Context, data *AccountData) (*Account, error) {
+	if _, err := s.bankService.GetBank(ctx, data.BankID); err != nil {
+		return nil, err
+	}
+
+	if data.Currency == "" {
+		data.Currency = DefaultCurrency
+	}
+	data.Currency = strings.ToUpper(data.Currency)
+	data.DisplayName = strings.TrimSpace(data.DisplayName)
+
+	if err := validateAccount(data.Type, data.Currency, data.DisplayName, data.OwnerIDs); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account: %v", err)
+	}
+
+	id, err := s.repo.createAccount(ctx, data)
+	if err != nil {
+		logger.ErrorWithFields("failed to create account", err, "bank_id", data.BankID)
+		return nil, psql.MapPostgresError("failed to create account", err)
+	}
+
+	return s.GetAccount(ctx, id)
+}
+
+func (s *Service) UpdateAccount(ctx context.Context, data *AccountUpdateData) (*Account, error) {
+	account, err := s.GetAccount(ctx, data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Type != nil {
+		account.Type = *data.Type
+	}
+	if data.Currency != nil {
+		account.Currency = strings.ToUpper(*data.Currency)
+	}
+	if data.ExternalID != nil {
+		account.ExternalID = data.ExternalID
+		if *data.ExternalID == "" {
+			account.ExternalID = nil
+		}
+	}
+	if data.DisplayName != nil {
+		account.DisplayName = strings.TrimSpace(*data.DisplayName)
+	}
+	if data.OwnerIDs != nil {
+		account.OwnerIDs = data.OwnerIDs
+	}
+
+	if err = validateAccount(account.Type, account.Currency, account.DisplayName, account.OwnerIDs); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account: %v", err)
+	}
+
+	err = s.repo.updateAccount(ctx, account, data.OwnerIDs != nil)
+	if err != nil {
+		logger.ErrorWithFields("failed to update account", err, "account_id", data.ID)
+		return nil, psql.MapPostgresError("failed to update account", err)
+	}
+
+	return s.GetAccount(ctx, data.ID)
+}
+
+func (s *Service) DeleteAccount(ctx context.Context, id int64) error {
+	hasTransactions, err := s.repo.hasTransactions(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to check account transactions", err, "account_id", id)
+		return psql.MapPostgresError("failed to delete account", err)
+	}
+
+	if hasTransactions {
+		return status.Errorf(codes.FailedPrecondition, "account has transactions")
+	}
+
+	err = s.repo.deleteAccount(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to delete account", err, "account_id", id)
+		return psql.MapPostgresError("failed to delete account", err)
+	}
+
+	return nil
+}
+
+// GetImportAccount returns the account transactions of the given bank and user are imported into.
+func (s *Service) GetImportAccount(ctx context.Context, id, bankID, userID int64) (*Account, error) {
+	account, err := s.GetAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.BankID != bankID {
+		logger.ErrorWithFields("account belongs to another bank", nil, "account_id", id, "bank_id", bankID)
+		return nil, status.Errorf(codes.InvalidArgument, "account does not belong to the bank")
+	}
+
+	for _, ownerID := range account.OwnerIDs {
+		if ownerID == userID {
+			return account, nil
+		}
+	}
+
+	logger.ErrorWithFields("user is not an account owner", nil, "account_id", id, "user_id", userID)
+	return nil, status.Errorf(codes.InvalidArgument, "user is not an account owner")
+}
+
+func (s *Service) GetAccountTypeList() []AccountType {
+	return []AccountType{
+		CurrentAccountType,
+		SavingsAccountType,
+		CreditCardAccountType,
+		LoanAccountType,
+		OtherAccountType,
+	}
+}
+
+func validateAccount(accountType AccountType, currency, displayName string, ownerIDs []int64) error {
+	switch accountType {
+	case CurrentAccountType, SavingsAccountType, CreditCardAccountType, LoanAccountType, OtherAccountType:
+	default:
+		return fmt.Errorf("unknown account type: %s", accountType)
+	}
+
+	if len(currency) != 3 {
+		return fmt.Errorf("invalid currency: %s", currency)
+	}
+
+	if displayName == "" {
+		return fmt.Errorf("display name is required")
+	}
+
+	if len(ownerIDs) == 0 {
+		return fmt.Errorf("at least one owner is required")
+	}
+
+	seen := make(map[int64]struct{}, len(ownerIDs))
+	for _, id := range ownerIDs {
+		if _, ok := seen[id]; ok || id <= 0 {
+			return fmt.Errorf("invalid owner id: %d", id)
+		}
+		seen[id] = struct{}{}
+	}
+
+	return nil
+}
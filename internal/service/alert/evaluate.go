@@ -0,0 +1,237 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+)
+
+// trigger is a rule firing before it is deduplicated and stored.
+type trigger struct {
+	dedupKey      string
+	message       string
+	transactionID *int64
+}
+
+func (s *Service) largeTransactionTriggers(rule *Rule, transactions []transaction.Transaction) []trigger {
+	threshold := ruleThreshold(rule)
+	res := make([]trigger, 0)
+	for i := range transactions {
+		tr := &transactions[i]
+		if tr.Type != transaction.OutcomeTransactionType || !s.matchesTransaction(rule, tr) {
+			continue
+		}
+
+		amount, ok := transactionAmount(tr)
+		if !ok || amount < threshold {
+			continue
+		}
+
+		res = append(res, trigger{
+			dedupKey:      fmt.Sprintf("transaction:%d", tr.ID),
+			message:       fmt.Sprintf("%s: %.2f spent at %q on %s", rule.Name, amount, tr.Description, tr.TransactionDate.Format(time.DateOnly)),
+			transactionID: &tr.ID,
+		})
+	}
+
+	return res
+}
+
+func (s *Service) categorySpendTriggers(ctx context.Context, rule *Rule, transactions []transaction.Transaction) ([]trigger, error) {
+	threshold := ruleThreshold(rule)
+	period := MonthPeriod
+	if rule.Period != nil {
+		period = *rule.Period
+	}
+
+	periodStarts := map[time.Time]struct{}{}
+	for i := range transactions {
+		tr := &transactions[i]
+		if tr.Type == transaction.OutcomeTransactionType && s.matchesTransaction(rule, tr) {
+			periodStarts[periodStart(period, tr.TransactionDate)] = struct{}{}
+		}
+	}
+
+	// Spend on subcategories rolls up to the category of the rule.
+	categoryIDs := s.categoryService.Store().GetSubtreeIDs(*rule.CategoryID)
+
+	res := make([]trigger, 0)
+	for start := range periodStarts {
+		end := periodEnd(period, start)
+		spend, err := s.repo.categorySpend(ctx, categoryIDs, rule.UserID, start, end)
+		if err != nil {
+			return nil, err
+		}
+		if spend < threshold {
+			continue
+		}
+
+		categoryName := strconv.FormatInt(*rule.CategoryID, 10)
+		if category, err := s.categoryService.GetCategoryByID(ctx, *rule.CategoryID); err == nil {
+			categoryName = category.Name
+		}
+
+		res = append(res, trigger{
+			dedupKey: fmt.Sprintf("category:%s", start.Format(time.DateOnly)),
+			message: fmt.Sprintf("%s: %.2f spent on %s since %s, above %.2f",
+				rule.Name, spend, categoryName, start.Format(time.DateOnly), threshold),
+		})
+	}
+
+	return res, nil
+}
+
+func (s *Service) newMerchantTriggers(ctx context.Context, rule *Rule, transactions []transaction.Transaction) ([]trigger, error) {
+	insertedIDs := make([]int64, len(transactions))
+	for i := range transactions {
+		insertedIDs[i] = transactions[i].ID
+	}
+
+	// The first transaction per user and merchant represents it in the alert.
+	firstSeen := map[int64]map[string]*transaction.Transaction{}
+	for i := range transactions {
+		tr := &transactions[i]
+		merchant := merchantKey(tr.Description)
+		if tr.Type != transaction.OutcomeTransactionType || merchant == "" || !s.matchesTransaction(rule, tr) {
+			continue
+		}
+
+		if firstSeen[tr.UserID] == nil {
+			firstSeen[tr.UserID] = map[string]*transaction.Transaction{}
+		}
+		if _, ok := firstSeen[tr.UserID][merchant]; !ok {
+			firstSeen[tr.UserID][merchant] = tr
+		}
+	}
+
+	res := make([]trigger, 0)
+	for userID, merchants := range firstSeen {
+		keys := make([]string, 0, len(merchants))
+		for merchant := range merchants {
+			keys = append(keys, merchant)
+		}
+
+		known, err := s.repo.knownMerchants(ctx, userID, keys, insertedIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for merchant, tr := range merchants {
+			if known[merchant] {
+				continue
+			}
+
+			amount, _ := transactionAmount(tr)
+			res = append(res, trigger{
+				dedupKey:      fmt.Sprintf("merchant:%d:%s", userID, merchant),
+				message:       fmt.Sprintf("%s: first payment to %q, %.2f on %s", rule.Name, tr.Description, amount, tr.TransactionDate.Format(time.DateOnly)),
+				transactionID: &tr.ID,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// lowBalanceTriggers fires at most once a day while the balance stays below the threshold.
+func (s *Service) lowBalanceTriggers(ctx context.Context, rule *Rule, transactions []transaction.Transaction) ([]trigger, error) {
+	touched := false
+	for i := range transactions {
+		if transactions[i].AccountID != nil && *transactions[i].AccountID == *rule.AccountID {
+			touched = true
+			break
+		}
+	}
+	if !touched {
+		return nil, nil
+	}
+
+	balance, err := s.balanceService.CurrentBalance(ctx, *rule.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := ruleThreshold(rule)
+	if balance == nil || *balance >= threshold {
+		return nil, nil
+	}
+
+	return []trigger{{
+		dedupKey: fmt.Sprintf("balance:%s", time.Now().UTC().Format(time.DateOnly)),
+		message:  fmt.Sprintf("%s: balance is %.2f, below %.2f", rule.Name, *balance, threshold),
+	}}, nil
+}
+
+func (s *Service) matchesTransaction(rule *Rule, tr *transaction.Transaction) bool {
+	if rule.UserID != nil && *rule.UserID != tr.UserID {
+		return false
+	}
+	if rule.AccountID != nil && (tr.AccountID == nil || *rule.AccountID != *tr.AccountID) {
+		return false
+	}
+	if rule.CategoryID != nil && !s.categoryService.Store().IsInSubtree(tr.CategoryID, *rule.CategoryID) {
+		return false
+	}
+
+	return true
+}
+
+func recentTransactions(transactions []transaction.Transaction, now time.Time) []transaction.Transaction {
+	cutoff := now.AddDate(0, 0, -lookbackDays)
+	res := make([]transaction.Transaction, 0, len(transactions))
+	for _, tr := range transactions {
+		if !tr.TransactionDate.Before(cutoff) {
+			res = append(res, tr)
+		}
+	}
+
+	return res
+}
+
+func transactionAmount(tr *transaction.Transaction) (float64, bool) {
+	amount, err := strconv.ParseFloat(tr.Amount, 64)
+	if err != nil {
+		logger.ErrorWithFields("failed to parse transaction amount", err, "transaction_id", tr.ID, "amount", tr.Amount)
+		return 0, false
+	}
+
+	return math.Abs(amount), true
+}
+
+// ruleThreshold relies on the threshold having been validated when the rule was saved.
+func ruleThreshold(rule *Rule) float64 {
+	if rule.Threshold == nil {
+		return 0
+	}
+
+	threshold, _ := strconv.ParseFloat(*rule.Threshold, 64)
+	return threshold
+}
+
+func merchantKey(description string) string {
+	return strings.ToLower(strings.TrimSpace(description))
+}
+
+func periodStart(period Period, date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if period == WeekPeriod {
+		weekday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -weekday)
+	}
+
+	return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func periodEnd(period Period, start time.Time) time.Time {
+	if period == WeekPeriod {
+		return start.AddDate(0, 0, 7)
+	}
+
+	return start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,87 @@
+package alert
+
+import "time"
+
+const (
+	alertRuleTable = "alert_rule"
+	alertTable     = "alert"
+)
+
+// lookbackDays limits evaluation to recent transactions so that backfilling history does not flood channels.
+const lookbackDays = 7
+
+// defaultAlertLimit caps the alert history when no limit is requested.
+const defaultAlertLimit = 100
+
+type RuleType string
+
+const (
+	LargeTransactionRuleType RuleType = "LARGE_TRANSACTION"
+	CategorySpendRuleType    RuleType = "CATEGORY_SPEND"
+	NewMerchantRuleType      RuleType = "NEW_MERCHANT"
+	LowBalanceRuleType       RuleType = "LOW_BALANCE"
+	ImportFailedRuleType     RuleType = "IMPORT_FAILED"
+)
+
+type Period string
+
+const (
+	WeekPeriod  Period = "WEEK"
+	MonthPeriod Period = "MONTH"
+)
+
+type ChannelType string
+
+const (
+	EmailChannelType   ChannelType = "EMAIL"
+	WebhookChannelType ChannelType = "WEBHOOK"
+)
+
+// Rule narrows evaluation to the transactions of UserID, AccountID and CategoryID when they are set.
+// Threshold is required for LARGE_TRANSACTION, CATEGORY_SPEND and LOW_BALANCE rules.
+type Rule struct {
+	ID         int64
+	Name       string
+	RuleType   RuleType
+	UserID     *int64
+	AccountID  *int64
+	CategoryID *int64
+	Threshold  *string
+	Period     *Period
+	Channel    ChannelType
+	Target     string
+	Enabled    bool
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+}
+
+type RuleUpdateData struct {
+	ID        int64
+	Name      *string
+	Threshold *string
+	Period    *Period
+	Channel   *ChannelType
+	Target    *string
+	Enabled   *bool
+}
+
+type RuleFilter struct {
+	UserID      *int64
+	OnlyEnabled bool
+}
+
+type Alert struct {
+	ID            int64
+	RuleID        int64
+	Message       string
+	DedupKey      string
+	TransactionID *int64
+	Delivered     bool
+	DeliveryError *string
+	CreatedAt     time.Time
+}
+
+type AlertFilter struct {
+	RuleID *int64
+	Limit  uint64
+}
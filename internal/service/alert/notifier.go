@@ -0,0 +1,129 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Notifier delivers a triggered alert to the target of its rule.
+type Notifier interface {
+	Notify(ctx context.Context, rule *Rule, alert *Alert) error
+}
+
+type SMTPCfg struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+type emailNotifier struct {
+	cfg *SMTPCfg
+}
+
+func newEmailNotifier(cfg *SMTPCfg) *emailNotifier {
+	return &emailNotifier{
+		cfg: cfg,
+	}
+}
+
+// Notify sends a plain text email; authentication is skipped without a username,
+// which is what local SMTP stand-ins such as Mailpit expect.
+func (n *emailNotifier) Notify(_ context.Context, rule *Rule, alert *Alert) error {
+	if n.cfg.Host == "" {
+		return fmt.Errorf("email channel is not configured")
+	}
+
+	var auth smtp.Auth
+	if n.cfg.Username != "" {
+		auth = smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.Host)
+	}
+
+	recipients := strings.Split(rule.Target, ",")
+	for i := range recipients {
+		recipients[i] = strings.TrimSpace(recipients[i])
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(recipients, ", "))
+	fmt.Fprintf(&msg, "Subject: [fin-aggregator] %s\r\n", rule.Name)
+	fmt.Fprintf(&msg, "Date: %s\r\n", alert.CreatedAt.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(alert.Message)
+	msg.WriteString("\r\n")
+
+	addr := net.JoinHostPort(n.cfg.Host, n.cfg.Port)
+	if err := smtp.SendMail(addr, auth, n.cfg.From, recipients, msg.Bytes()); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+type webhookNotifier struct {
+	httpClient *http.Client
+}
+
+func newWebhookNotifier(timeout time.Duration) *webhookNotifier {
+	return &webhookNotifier{
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type webhookPayload struct {
+	AlertID       int64     `json:"alert_id"`
+	RuleID        int64     `json:"rule_id"`
+	RuleName      string    `json:"rule_name"`
+	RuleType      RuleType  `json:"rule_type"`
+	Message       string    `json:"message"`
+	TransactionID *int64    `json:"transaction_id,omitempty"`
+	TriggeredAt   time.Time `json:"triggered_at"`
+}
+
+// Notify posts the alert as JSON to the rule target; any non-2xx response is a failed delivery.
+func (n *webhookNotifier) Notify(ctx context.Context, rule *Rule, alert *Alert) error {
+	body, err := json.Marshal(webhookPayload{
+		AlertID:       alert.ID,
+		RuleID:        rule.ID,
+		RuleName:      rule.Name,
+		RuleType:      rule.RuleType,
+		Message:       alert.Message,
+		TransactionID: alert.TransactionID,
+		TriggeredAt:   alert.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rule.Target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ruleColumns = []string{
+	"id", "name", "rule_type", "user_id", "account_id", "category_id", "threshold", "period", "channel", "target", "enabled", "created_at", "updated_at",
+}
+
+const ruleReturning = "RETURNING id, name, rule_type, user_id, account_id, category_id, threshold, period, channel, target, enabled, created_at, updated_at"
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) getRule(ctx context.Context, id int64) (*Rule, error) {
+	query, args, err := squirrel.
+		Select(ruleColumns...).
+		From(alertRuleTable).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var rule Rule
+	if err = pgxscan.Get(ctx, r.dbPool, &rule, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (r *repository) ruleList(ctx context.Context, filter *RuleFilter) ([]Rule, error) {
+	queryBuilder := squirrel.
+		Select(ruleColumns...).
+		From(alertRuleTable).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.UserID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"user_id": *filter.UserID})
+	}
+	if filter.OnlyEnabled {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"enabled": true})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var rules []Rule
+	if err = pgxscan.Select(ctx, r.dbPool, &rules, query, args...); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *repository) createRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	query, args, err := squirrel.
+		Insert(alertRuleTable).
+		Columns("name", "rule_type", "user_id", "account_id", "category_id", "threshold", "period", "channel", "target", "enabled").
+		Values(rule.Name, rule.RuleType, rule.UserID, rule.AccountID, rule.CategoryID, rule.Threshold, rule.Period, rule.Channel, rule.Target, rule.Enabled).
+		Suffix(ruleReturning).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var created Rule
+	if err = pgxscan.Get(ctx, r.dbPool, &created, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *repository) updateRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	query, args, err := squirrel.
+		Update(alertRuleTable).
+		Set("name", rule.Name).
+		Set("threshold", rule.Threshold).
+		Set("period", rule.Period).
+		Set("channel", rule.Channel).
+		Set("target", rule.Target).
+		Set("enabled", rule.Enabled).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": rule.ID}).
+		Suffix(ruleReturning).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var updated Rule
+	if err = pgxscan.Get(ctx, r.dbPool, &updated, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (r *repository) deleteRule(ctx context.Context, id int64) error {
+	tag, err := r.dbPool.Exec(ctx, "DELETE FROM alert_rule WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// createAlert returns nil when the rule has already fired for the same dedup key.
+func (r *repository) createAlert(ctx context.Context, alert *Alert) (*Alert, error) {
+	query, args, err := squirrel.
+		Insert(alertTable).
+		Columns("rule_id", "message", "dedup_key", "transaction_id").
+		Values(alert.RuleID, alert.Message, alert.DedupKey, alert.TransactionID).
+		Suffix(`ON CONFLICT (rule_id, dedup_key) DO NOTHING
+			RETURNING id, rule_id, message, dedup_key, transaction_id, delivered, delivery_error, created_at`).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var created Alert
+	if err = pgxscan.Get(ctx, r.dbPool, &created, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *repository) markDelivery(ctx context.Context, id int64, deliveryErr *string) error {
+	query, args, err := squirrel.
+		Update(alertTable).
+		Set("delivered", deliveryErr == nil).
+		Set("delivery_error", deliveryErr).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = r.dbPool.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *repository) alertList(ctx context.Context, filter *AlertFilter) ([]Alert, error) {
+	queryBuilder := squirrel.
+		Select("id", "rule_id", "message", "dedup_key", "transaction_id", "delivered", "delivery_error", "created_at").
+		From(alertTable).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(filter.Limit).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.RuleID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"rule_id": *filter.RuleID})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var alerts []Alert
+	if err = pgxscan.Select(ctx, r.dbPool, &alerts, query, args...); err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+// categorySpend sums outcomes of the categories in [from, to), optionally for one user.
+func (r *repository) categorySpend(ctx context.Context, categoryIDs []int64, userID *int64, from, to time.Time) (float64, error) {
+	queryBuilder := squirrel.
+		Select("COALESCE(SUM(ABS(amount)), 0)::float8").
+		From("transaction").
+		Where(squirrel.Eq{"category_id": categoryIDs, "type": "OUTCOME"}).
+		Where(squirrel.GtOrEq{"transaction_date": from}).
+		Where(squirrel.Lt{"transaction_date": to}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if userID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"user_id": *userID})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var amount float64
+	if err = r.dbPool.QueryRow(ctx, query, args...).Scan(&amount); err != nil {
+		return 0, fmt.Errorf("failed to select category spend: %w", err)
+	}
+
+	return amount, nil
+}
+
+// knownMerchants returns which of the merchant keys already appear on transactions other than the given ones.
+func (r *repository) knownMerchants(ctx context.Context, userID int64, merchants []string, excludeIDs []int64) (map[string]bool, error) {
+	query, args, err := squirrel.
+		Select("DISTINCT LOWER(TRIM(description))").
+		From("transaction").
+		Where(squirrel.Eq{"user_id": userID}).
+		Where(squirrel.Eq{"LOWER(TRIM(description))": merchants}).
+		Where(squirrel.NotEq{"id": excludeIDs}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var known []string
+	if err = pgxscan.Select(ctx, r.dbPool, &known, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select known merchants: %w", err)
+	}
+
+	res := make(map[string]bool, len(known))
+	for _, merchant := range known {
+		res[merchant] = true
+	}
+
+	return res, nil
+}
@@ -0,0 +1,328 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/event"
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	repo            *repository
+	notifiers       map[ChannelType]Notifier
+	categoryService *category.Service
+	accountService  *account.Service
+	balanceService  *balance.Service
+}
+
+func NewService(
+	dbPool *pgxpool.Pool,
+	smtpCfg *SMTPCfg,
+	timeout time.Duration,
+	categoryService *category.Service,
+	accountService *account.Service,
+	balanceService *balance.Service,
+) *Service {
+	return &Service{
+		repo: newRepository(dbPool),
+		notifiers: map[ChannelType]Notifier{
+			EmailChannelType:   newEmailNotifier(smtpCfg),
+			WebhookChannelType: newWebhookNotifier(timeout),
+		},
+		categoryService: categoryService,
+		accountService:  accountService,
+		balanceService:  balanceService,
+	}
+}
+
+func (s *Service) CreateRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	rule.Name = strings.TrimSpace(rule.Name)
+	rule.Target = strings.TrimSpace(rule.Target)
+	if rule.RuleType == CategorySpendRuleType && rule.Period == nil {
+		period := MonthPeriod
+		rule.Period = &period
+	}
+
+	if err := s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.createRule(ctx, rule)
+	if err != nil {
+		logger.ErrorWithFields("failed to create alert rule", err, "name", rule.Name)
+		return nil, psql.MapPostgresError("failed to create alert rule", err)
+	}
+
+	return created, nil
+}
+
+func (s *Service) UpdateRule(ctx context.Context, data *RuleUpdateData) (*Rule, error) {
+	rule, err := s.GetRule(ctx, data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Name != nil {
+		rule.Name = strings.TrimSpace(*data.Name)
+	}
+	if data.Threshold != nil {
+		rule.Threshold = data.Threshold
+	}
+	if data.Period != nil {
+		rule.Period = data.Period
+	}
+	if data.Channel != nil {
+		rule.Channel = *data.Channel
+	}
+	if data.Target != nil {
+		rule.Target = strings.TrimSpace(*data.Target)
+	}
+	if data.Enabled != nil {
+		rule.Enabled = *data.Enabled
+	}
+
+	if err = s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.updateRule(ctx, rule)
+	if err != nil {
+		logger.ErrorWithFields("failed to update alert rule", err, "rule_id", data.ID)
+		return nil, psql.MapPostgresError("failed to update alert rule", err)
+	}
+
+	return updated, nil
+}
+
+func (s *Service) GetRule(ctx context.Context, id int64) (*Rule, error) {
+	rule, err := s.repo.getRule(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to get alert rule", err, "rule_id", id)
+		return nil, psql.MapPostgresError("failed to get alert rule", err)
+	}
+
+	return rule, nil
+}
+
+func (s *Service) DeleteRule(ctx context.Context, id int64) error {
+	err := s.repo.deleteRule(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to delete alert rule", err, "rule_id", id)
+		return psql.MapPostgresError("failed to delete alert rule", err)
+	}
+
+	return nil
+}
+
+func (s *Service) RuleList(ctx context.Context, filter *RuleFilter) ([]Rule, error) {
+	rules, err := s.repo.ruleList(ctx, filter)
+	if err != nil {
+		logger.Error("failed to get alert rules", err)
+		return nil, psql.MapPostgresError("failed to get alert rules", err)
+	}
+
+	return rules, nil
+}
+
+func (s *Service) AlertList(ctx context.Context, filter *AlertFilter) ([]Alert, error) {
+	if filter.Limit == 0 {
+		filter.Limit = defaultAlertLimit
+	}
+
+	alerts, err := s.repo.alertList(ctx, filter)
+	if err != nil {
+		logger.Error("failed to get alerts", err)
+		return nil, psql.MapPostgresError("failed to get alerts", err)
+	}
+
+	return alerts, nil
+}
+
+// TestRule sends a sample notification through the rule channel without recording it in the history.
+func (s *Service) TestRule(ctx context.Context, id int64) error {
+	rule, err := s.GetRule(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.notifiers[rule.Channel].Notify(ctx, rule, &Alert{
+		RuleID:    rule.ID,
+		Message:   fmt.Sprintf("%s: test notification", rule.Name),
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		logger.ErrorWithFields("failed to send test alert", err, "rule_id", id, "channel", rule.Channel)
+		return status.Errorf(codes.Unavailable, "failed to send test alert: %v", err)
+	}
+
+	return nil
+}
+
+// HandleTransactionsCreated evaluates enabled rules against the transactions of a transaction.created event.
+func (s *Service) HandleTransactionsCreated(ctx context.Context, e *event.Event) error {
+	transactions, err := transaction.DecodeTransactionsCreated(e)
+	if err != nil {
+		return err
+	}
+
+	rules, err := s.repo.ruleList(ctx, &RuleFilter{OnlyEnabled: true})
+	if err != nil {
+		return fmt.Errorf("failed to get alert rules: %w", err)
+	}
+
+	recent := recentTransactions(transactions, time.Now().UTC())
+
+	for i := range rules {
+		rule := &rules[i]
+
+		var triggers []trigger
+		switch rule.RuleType {
+		case LargeTransactionRuleType:
+			triggers = s.largeTransactionTriggers(rule, recent)
+		case CategorySpendRuleType:
+			triggers, err = s.categorySpendTriggers(ctx, rule, recent)
+		case NewMerchantRuleType:
+			triggers, err = s.newMerchantTriggers(ctx, rule, recent)
+		case LowBalanceRuleType:
+			triggers, err = s.lowBalanceTriggers(ctx, rule, transactions)
+		default:
+			continue
+		}
+		if err != nil {
+			logger.ErrorWithFields("failed to evaluate alert rule", err, "rule_id", rule.ID, "rule_type", rule.RuleType)
+			continue
+		}
+
+		for _, t := range triggers {
+			s.fire(ctx, rule, t)
+		}
+	}
+
+	return nil
+}
+
+// HandleImportFailed notifies IMPORT_FAILED rules of the user about an upload or sync that could not be saved.
+func (s *Service) HandleImportFailed(ctx context.Context, e *event.Event) error {
+	var failure event.ImportFailed
+	if err := json.Unmarshal(e.Payload, &failure); err != nil {
+		return fmt.Errorf("failed to decode %s event %d: %w", e.Type, e.ID, err)
+	}
+
+	rules, err := s.repo.ruleList(ctx, &RuleFilter{OnlyEnabled: true})
+	if err != nil {
+		return fmt.Errorf("failed to get alert rules: %w", err)
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		if rule.RuleType != ImportFailedRuleType || (rule.UserID != nil && *rule.UserID != failure.UserID) {
+			continue
+		}
+
+		s.fire(ctx, rule, trigger{
+			dedupKey: fmt.Sprintf("import:%d", e.ID),
+			message:  fmt.Sprintf("%s: %s import for bank %d failed: %s", rule.Name, failure.Source, failure.BankID, failure.Reason),
+		})
+	}
+
+	return nil
+}
+
+// fire records the alert and delivers it, unless the rule has already fired for the same dedup key.
+func (s *Service) fire(ctx context.Context, rule *Rule, t trigger) {
+	alert, err := s.repo.createAlert(ctx, &Alert{
+		RuleID:        rule.ID,
+		Message:       t.message,
+		DedupKey:      t.dedupKey,
+		TransactionID: t.transactionID,
+	})
+	if err != nil {
+		logger.ErrorWithFields("failed to save alert", err, "rule_id", rule.ID, "dedup_key", t.dedupKey)
+		return
+	}
+	if alert == nil {
+		return
+	}
+
+	var deliveryErr *string
+	if err = s.notifiers[rule.Channel].Notify(ctx, rule, alert); err != nil {
+		logger.ErrorWithFields("failed to deliver alert", err, "alert_id", alert.ID, "channel", rule.Channel)
+		msg := err.Error()
+		deliveryErr = &msg
+	}
+
+	if err = s.repo.markDelivery(ctx, alert.ID, deliveryErr); err != nil {
+		logger.ErrorWithFields("failed to update alert delivery", err, "alert_id", alert.ID)
+	}
+}
+
+func (s *Service) validateRule(ctx context.Context, rule *Rule) error {
+	if rule.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "invalid alert rule: name is required")
+	}
+
+	switch rule.RuleType {
+	case LargeTransactionRuleType, CategorySpendRuleType, LowBalanceRuleType:
+		if rule.Threshold == nil {
+			return status.Errorf(codes.InvalidArgument, "invalid alert rule: threshold is required for %s", rule.RuleType)
+		}
+		if _, err := strconv.ParseFloat(*rule.Threshold, 64); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid alert rule: threshold %q", *rule.Threshold)
+		}
+	case NewMerchantRuleType, ImportFailedRuleType:
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid alert rule: unknown rule type %s", rule.RuleType)
+	}
+
+	if rule.RuleType == CategorySpendRuleType && rule.CategoryID == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid alert rule: category is required for %s", rule.RuleType)
+	}
+	if rule.RuleType == LowBalanceRuleType && rule.AccountID == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid alert rule: account is required for %s", rule.RuleType)
+	}
+
+	if rule.Period != nil && *rule.Period != WeekPeriod && *rule.Period != MonthPeriod {
+		return status.Errorf(codes.InvalidArgument, "invalid alert rule: unknown period %s", *rule.Period)
+	}
+
+	switch rule.Channel {
+	case EmailChannelType:
+		if !strings.Contains(rule.Target, "@") {
+			return status.Errorf(codes.InvalidArgument, "invalid alert rule: email target %q", rule.Target)
+		}
+	case WebhookChannelType:
+		target, err := url.Parse(rule.Target)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return status.Errorf(codes.InvalidArgument, "invalid alert rule: webhook target %q", rule.Target)
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid alert rule: unknown channel %s", rule.Channel)
+	}
+
+	if rule.AccountID != nil {
+		if _, err := s.accountService.GetAccount(ctx, *rule.AccountID); err != nil {
+			return err
+		}
+	}
+	if rule.CategoryID != nil {
+		if _, err := s.categoryService.GetCategoryByID(ctx, *rule.CategoryID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package balance
+
+import "time"
+
+const snapshotTable = "account_balance_snapshot"
+
+// reconcileTolerance absorbs rounding differences between computed and reported balances.
+const reconcileTolerance = 0.01
+
+type SnapshotSource string
+
+const (
+	CSVSnapshotSource    SnapshotSource = "CSV"
+	APISnapshotSource    SnapshotSource = "API"
+	ManualSnapshotSource SnapshotSource = "MANUAL"
+)
+
+// sourcePriority decides which snapshot wins when several sources report a balance for the same day.
+var sourcePriority = map[SnapshotSource]int{
+	ManualSnapshotSource: 3,
+	APISnapshotSource:    2,
+	CSVSnapshotSource:    1,
+}
+
+type Snapshot struct {
+	ID          int64
+	AccountID   int64
+	Balance     string
+	BalanceDate time.Time
+	Source      SnapshotSource
+	Note        *string
+	CreatedAt   time.Time
+}
+
+type SnapshotFilter struct {
+	AccountID int64
+	From      *time.Time
+	To        *time.Time
+}
+
+type ReconciliationStatus string
+
+const (
+	OKReconciliationStatus       ReconciliationStatus = "OK"
+	MismatchReconciliationStatus ReconciliationStatus = "MISMATCH"
+	GapReconciliationStatus      ReconciliationStatus = "GAP"
+)
+
+// ReconciliationPeriod covers transactions after StartDate up to and including EndDate,
+// i.e. the range between two consecutive balance snapshots.
+type ReconciliationPeriod struct {
+	StartDate              time.Time
+	EndDate                time.Time
+	OpeningBalance         float64
+	ExpectedClosingBalance float64
+	ActualClosingBalance   float64
+	Difference             float64
+	TransactionCount       int
+	Status                 ReconciliationStatus
+}
+
+type Reconciliation struct {
+	AccountID     int64
+	SnapshotCount int
+	IsReconciled  bool
+	Periods       []ReconciliationPeriod
+	// FirstIssue is the earliest period that is not OK, nil when everything reconciles.
+	FirstIssue *ReconciliationPeriod
+}
+
+type dailyMovement struct {
+	TransactionDate  time.Time
+	Amount           float64
+	TransactionCount int
+}
@@ -0,0 +1,102 @@
+package balance
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// latestPerDay keeps one snapshot per day, preferring the most trusted source.
+func latestPerDay(snapshots []Snapshot) []Snapshot {
+	byDay := map[string]Snapshot{}
+	for _, s := range snapshots {
+		key := s.BalanceDate.Format(time.DateOnly)
+		current, ok := byDay[key]
+		if !ok || sourcePriority[s.Source] > sourcePriority[current.Source] {
+			byDay[key] = s
+		}
+	}
+
+	res := make([]Snapshot, 0, len(byDay))
+	for _, s := range byDay {
+		res = append(res, s)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].BalanceDate.Before(res[j].BalanceDate)
+	})
+
+	return res
+}
+
+// reconcile walks consecutive snapshots and checks that the opening balance plus
+// the transactions in between add up to the next snapshot.
+func reconcile(accountID int64, snapshots []Snapshot, movements []dailyMovement) (*Reconciliation, error) {
+	res := &Reconciliation{
+		AccountID:     accountID,
+		SnapshotCount: len(snapshots),
+		IsReconciled:  true,
+		Periods:       make([]ReconciliationPeriod, 0),
+	}
+
+	m := 0
+	for i := 1; i < len(snapshots); i++ {
+		prev, cur := snapshots[i-1], snapshots[i]
+
+		opening, err := strconv.ParseFloat(prev.Balance, 64)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := strconv.ParseFloat(cur.Balance, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		for m < len(movements) && !movements[m].TransactionDate.After(prev.BalanceDate) {
+			m++
+		}
+
+		expected := opening
+		count := 0
+		for m < len(movements) && !movements[m].TransactionDate.After(cur.BalanceDate) {
+			expected += movements[m].Amount
+			count += movements[m].TransactionCount
+			m++
+		}
+
+		period := ReconciliationPeriod{
+			StartDate:              prev.BalanceDate,
+			EndDate:                cur.BalanceDate,
+			OpeningBalance:         opening,
+			ExpectedClosingBalance: round2(expected),
+			ActualClosingBalance:   actual,
+			Difference:             round2(actual - expected),
+			TransactionCount:       count,
+			Status:                 OKReconciliationStatus,
+		}
+
+		if math.Abs(period.Difference) > reconcileTolerance {
+			period.Status = MismatchReconciliationStatus
+			if count == 0 {
+				period.Status = GapReconciliationStatus
+			}
+		}
+
+		res.Periods = append(res.Periods, period)
+	}
+
+	for i := range res.Periods {
+		if res.Periods[i].Status != OKReconciliationStatus {
+			res.IsReconciled = false
+			res.FirstIssue = &res.Periods[i]
+			break
+		}
+	}
+
+	return res, nil
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
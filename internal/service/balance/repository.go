@@ -0,0 +1,144 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) saveSnapshots(ctx context.Context, snapshots []Snapshot) ([]Snapshot, error) {
+	builder := squirrel.
+		Insert(snapshotTable).
+		Columns("account_id", "balance", "balance_date", "source", "note").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, s := range snapshots {
+		builder = builder.Values(s.AccountID, s.Balance, s.BalanceDate, s.Source, s.Note)
+	}
+
+	query, args, err := builder.
+		Suffix(`ON CONFLICT (account_id, balance_date, source) DO UPDATE SET
+			balance = EXCLUDED.balance,
+			note = EXCLUDED.note
+			RETURNING id, account_id, balance, balance_date, source, note, created_at`).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var saved []Snapshot
+	if err = pgxscan.Select(ctx, r.dbPool, &saved, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to save balance snapshots: %w", err)
+	}
+
+	return saved, nil
+}
+
+func (r *repository) snapshotList(ctx context.Context, filter *SnapshotFilter) ([]Snapshot, error) {
+	queryBuilder := squirrel.
+		Select("id", "account_id", "balance", "balance_date", "source", "note", "created_at").
+		From(snapshotTable).
+		Where(squirrel.Eq{"account_id": filter.AccountID}).
+		OrderBy("balance_date", "id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.From != nil {
+		queryBuilder = queryBuilder.Where(squirrel.GtOrEq{"balance_date": *filter.From})
+	}
+	if filter.To != nil {
+		queryBuilder = queryBuilder.Where(squirrel.LtOrEq{"balance_date": *filter.To})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var snapshots []Snapshot
+	if err = pgxscan.Select(ctx, r.dbPool, &snapshots, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select balance snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+func (r *repository) snapshotsUntil(ctx context.Context, accountIDs []int64, to time.Time) ([]Snapshot, error) {
+	query, args, err := squirrel.
+		Select("id", "account_id", "balance", "balance_date", "source", "note", "created_at").
+		From(snapshotTable).
+		Where(squirrel.Eq{"account_id": accountIDs}).
+		Where(squirrel.LtOrEq{"balance_date": to}).
+		OrderBy("account_id", "balance_date", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var snapshots []Snapshot
+	if err = pgxscan.Select(ctx, r.dbPool, &snapshots, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select balance snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+func (r *repository) deleteSnapshot(ctx context.Context, id int64) error {
+	tag, err := r.dbPool.Exec(ctx, "DELETE FROM account_balance_snapshot WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// dailyMovements sums signed transaction amounts per day in (from, to].
+// The sign comes from the transaction type because banks disagree on amount sign conventions.
+func (r *repository) dailyMovements(ctx context.Context, accountID int64, from, to time.Time) ([]dailyMovement, error) {
+	query, args, err := squirrel.
+		Select(
+			"transaction_date",
+			`SUM(CASE
+				WHEN type = 'OUTCOME' THEN -ABS(amount)
+				WHEN type = 'INCOME' THEN ABS(amount)
+				ELSE amount
+			END)::float8 AS amount`,
+			"COUNT(*) AS transaction_count",
+		).
+		From("transaction").
+		Where(squirrel.Eq{"account_id": accountID}).
+		Where(squirrel.Gt{"transaction_date": from}).
+		Where(squirrel.LtOrEq{"transaction_date": to}).
+		GroupBy("transaction_date").
+		OrderBy("transaction_date").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var movements []dailyMovement
+	if err = pgxscan.Select(ctx, r.dbPool, &movements, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select daily movements: %w", err)
+	}
+
+	return movements, nil
+}
@@ -0,0 +1,175 @@
+package balance
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	repo           *repository
+	accountService *account.Service
+}
+
+func NewService(dbPool *pgxpool.Pool, accountService *account.Service) *Service {
+	return &Service{
+		repo:           newRepository(dbPool),
+		accountService: accountService,
+	}
+}
+
+func (s *Service) CreateSnapshot(ctx context.Context, snapshot *Snapshot) (*Snapshot, error) {
+	if _, err := s.accountService.GetAccount(ctx, snapshot.AccountID); err != nil {
+		return nil, err
+	}
+
+	if _, err := strconv.ParseFloat(snapshot.Balance, 64); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid balance format: %s", snapshot.Balance)
+	}
+
+	if snapshot.BalanceDate.IsZero() {
+		snapshot.BalanceDate = time.Now()
+	}
+	snapshot.Source = ManualSnapshotSource
+
+	saved, err := s.repo.saveSnapshots(ctx, []Snapshot{*snapshot})
+	if err != nil {
+		logger.ErrorWithFields("failed to save balance snapshot", err, "account_id", snapshot.AccountID)
+		return nil, psql.MapPostgresError("failed to save balance snapshot", err)
+	}
+
+	return &saved[0], nil
+}
+
+// SaveImportedSnapshots stores balances reported by CSV exports or bank APIs.
+func (s *Service) SaveImportedSnapshots(ctx context.Context, snapshots []Snapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	_, err := s.repo.saveSnapshots(ctx, snapshots)
+	if err != nil {
+		logger.ErrorWithFields("failed to save imported balance snapshots", err, "account_id", snapshots[0].AccountID)
+		return psql.MapPostgresError("failed to save balance snapshots", err)
+	}
+
+	return nil
+}
+
+func (s *Service) SnapshotList(ctx context.Context, filter *SnapshotFilter) ([]Snapshot, error) {
+	snapshots, err := s.repo.snapshotList(ctx, filter)
+	if err != nil {
+		logger.ErrorWithFields("failed to get balance snapshots", err, "account_id", filter.AccountID)
+		return nil, psql.MapPostgresError("failed to get balance snapshots", err)
+	}
+
+	return snapshots, nil
+}
+
+// AccountSnapshots returns one snapshot per account and day up to the given date, ordered by date.
+func (s *Service) AccountSnapshots(ctx context.Context, accountIDs []int64, to time.Time) (map[int64][]Snapshot, error) {
+	if len(accountIDs) == 0 {
+		return map[int64][]Snapshot{}, nil
+	}
+
+	snapshots, err := s.repo.snapshotsUntil(ctx, accountIDs, to)
+	if err != nil {
+		logger.Error("failed to get balance snapshots", err)
+		return nil, psql.MapPostgresError("failed to get balance snapshots", err)
+	}
+
+	byAccount := map[int64][]Snapshot{}
+	for _, snapshot := range snapshots {
+		byAccount[snapshot.AccountID] = append(byAccount[snapshot.AccountID], snapshot)
+	}
+
+	for accountID, accountSnapshots := range byAccount {
+		byAccount[accountID] = latestPerDay(accountSnapshots)
+	}
+
+	return byAccount, nil
+}
+
+func (s *Service) DeleteSnapshot(ctx context.Context, id int64) error {
+	err := s.repo.deleteSnapshot(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to delete balance snapshot", err, "snapshot_id", id)
+		return psql.MapPostgresError("failed to delete balance snapshot", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Reconcile(ctx context.Context, filter *SnapshotFilter) (*Reconciliation, error) {
+	if _, err := s.accountService.GetAccount(ctx, filter.AccountID); err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.SnapshotList(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots = latestPerDay(snapshots)
+	if len(snapshots) < 2 {
+		return nil, status.Errorf(codes.FailedPrecondition, "at least two balance snapshots are required to reconcile")
+	}
+
+	from := snapshots[0].BalanceDate
+	to := snapshots[len(snapshots)-1].BalanceDate
+	movements, err := s.repo.dailyMovements(ctx, filter.AccountID, from, to)
+	if err != nil {
+		logger.ErrorWithFields("failed to get daily movements", err, "account_id", filter.AccountID)
+		return nil, psql.MapPostgresError("failed to reconcile account", err)
+	}
+
+	res, err := reconcile(filter.AccountID, snapshots, movements)
+	if err != nil {
+		logger.ErrorWithFields("failed to reconcile account", err, "account_id", filter.AccountID)
+		return nil, status.Errorf(codes.Internal, "failed to reconcile account")
+	}
+
+	return res, nil
+}
+
+// CurrentBalance estimates the account balance as the latest snapshot plus the transactions dated after it.
+// It returns nil when the account has no snapshots.
+func (s *Service) CurrentBalance(ctx context.Context, accountID int64) (*float64, error) {
+	now := time.Now().UTC()
+	snapshots, err := s.AccountSnapshots(ctx, []int64{accountID}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	accountSnapshots := snapshots[accountID]
+	if len(accountSnapshots) == 0 {
+		return nil, nil
+	}
+
+	latest := accountSnapshots[len(accountSnapshots)-1]
+	balance, err := strconv.ParseFloat(latest.Balance, 64)
+	if err != nil {
+		logger.ErrorWithFields("failed to parse balance snapshot", err, "snapshot_id", latest.ID, "balance", latest.Balance)
+		return nil, status.Errorf(codes.Internal, "failed to get current balance")
+	}
+
+	movements, err := s.repo.dailyMovements(ctx, accountID, latest.BalanceDate, now)
+	if err != nil {
+		logger.ErrorWithFields("failed to get daily movements", err, "account_id", accountID)
+		return nil, psql.MapPostgresError("failed to get current balance", err)
+	}
+
+	for _, movement := range movements {
+		balance += movement.Amount
+	}
+	balance = round2(balance)
+
+	return &balance, nil
+}
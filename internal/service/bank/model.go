@@ -6,14 +6,6 @@ const (
 	bankImportMethod = "bank_import_method"
 )
 
-type BankName string
-
-const (
-	AmexBankName    BankName = "American express"
-	RevolutBankName BankName = "Revolut"
-	UnknownBankName BankName = "Unknown"
-)
-
 type ImportMethod string
 
 const (
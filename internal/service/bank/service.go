@@ -21,10 +21,19 @@ func NewService(dbPool *pgxpool.Pool) *Service {
 }
 
 func (s *Service) Initialize(ctx context.Context) error {
+	if err := s.Reload(ctx); err != nil {
+		return fmt.Errorf("service initialization failed: %w", err)
+	}
+
+	return nil
+}
+
+// Reload loads the banks into the store again; on failure the store keeps the previous banks.
+func (s *Service) Reload(ctx context.Context) error {
 	banks, err := s.repo.getBankList(ctx)
 	if err != nil {
 		logger.Error("failed to get bank list", err)
-		return fmt.Errorf("service initialization failed: %w", err)
+		return err
 	}
 
 	bankMap := make(map[int64]Bank, len(banks))
@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// invalidationChannel is notified by triggers on the cached tables, with the changed table as the payload.
+	invalidationChannel = "cache_invalidation"
+	// allTablesPayload asks every instance to reload all caches.
+	allTablesPayload = "*"
+
+	// coalesceWindow collects the notifications of a burst of changes, so each cache reloads once for them.
+	coalesceWindow = 200 * time.Millisecond
+	// reconnectDelay is how long the listener waits before listening again after its connection failed.
+	reconnectDelay = 5 * time.Second
+)
+
+// ReloadFunc loads a cache again from the database; a failed reload should leave the previous data in use.
+type ReloadFunc func(ctx context.Context) error
+
+type cache struct {
+	name   string
+	tables []string
+	reload ReloadFunc
+}
@@ -0,0 +1,22 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) notify(ctx context.Context, payload string) error {
+	_, err := r.dbPool.Exec(ctx, "SELECT pg_notify($1, $2)", invalidationChannel, payload)
+	return err
+}
@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Service keeps the in-memory stores of every instance in line with the database. Triggers on the cached tables
+// notify the cache_invalidation channel with the changed table, including changes made by hand or by another
+// instance; every instance listens on a dedicated connection and reloads the caches loaded from that table.
+type Service struct {
+	dbPool   *pgxpool.Pool
+	repo     *repository
+	cachesMu sync.RWMutex
+	caches   []*cache
+}
+
+func NewService(dbPool *pgxpool.Pool) *Service {
+	return &Service{
+		dbPool: dbPool,
+		repo:   newRepository(dbPool),
+	}
+}
+
+// Register adds a cache loaded from the given tables. The name identifies it in logs and in reload results.
+func (s *Service) Register(name string, reload ReloadFunc, tables ...string) {
+	s.cachesMu.Lock()
+	defer s.cachesMu.Unlock()
+
+	s.caches = append(s.caches, &cache{
+		name:   name,
+		tables: tables,
+		reload: reload,
+	})
+}
+
+// Run listens for invalidations until ctx is done, reconnecting after a failed connection. Notifications sent
+// while no connection listened are lost, so all caches are reloaded after every reconnect.
+func (s *Service) Run(ctx context.Context) {
+	reconnect := false
+	for {
+		err := s.listen(ctx, reconnect)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Error("cache invalidation listener failed", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+		reconnect = true
+	}
+}
+
+func (s *Service) listen(ctx context.Context, reconnect bool) error {
+	poolConn, err := s.dbPool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	// A listening connection must not be handed out by the pool again.
+	conn := poolConn.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err = conn.Exec(ctx, "LISTEN "+invalidationChannel); err != nil {
+		return fmt.Errorf("failed to listen for cache invalidations: %w", err)
+	}
+
+	if reconnect {
+		s.reload(ctx, map[string]bool{allTablesPayload: true})
+	}
+
+	for {
+		tables, err := s.receive(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		s.reload(ctx, tables)
+	}
+}
+
+// receive waits for a notification and collects those arriving within coalesceWindow after it.
+func (s *Service) receive(ctx context.Context, conn *pgx.Conn) (map[string]bool, error) {
+	notification, err := conn.WaitForNotification(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables := map[string]bool{notification.Payload: true}
+
+	windowCtx, cancel := context.WithTimeout(ctx, coalesceWindow)
+	defer cancel()
+
+	for {
+		notification, err = conn.WaitForNotification(windowCtx)
+		if err != nil {
+			if ctx.Err() == nil && windowCtx.Err() != nil {
+				return tables, nil
+			}
+			return nil, err
+		}
+		tables[notification.Payload] = true
+	}
+}
+
+// reload reloads the caches loaded from any of the tables. Failures are logged, the cache keeps its previous data.
+func (s *Service) reload(ctx context.Context, tables map[string]bool) {
+	for _, c := range s.affected(tables) {
+		if err := c.reload(ctx); err != nil {
+			logger.ErrorWithFields("failed to reload cache", err, "cache", c.name)
+		}
+	}
+}
+
+func (s *Service) affected(tables map[string]bool) []*cache {
+	s.cachesMu.RLock()
+	defer s.cachesMu.RUnlock()
+
+	if tables[allTablesPayload] {
+		return s.caches
+	}
+
+	res := make([]*cache, 0, len(s.caches))
+	for _, c := range s.caches {
+		if slices.ContainsFunc(c.tables, func(table string) bool { return tables[table] }) {
+			res = append(res, c)
+		}
+	}
+
+	return res
+}
+
+// ReloadAll reloads every cache of this instance and asks the other instances to do the same. It returns the
+// names of the reloaded caches; the listener of this instance reloads them once more on its own notification.
+func (s *Service) ReloadAll(ctx context.Context) ([]string, error) {
+	caches := s.affected(map[string]bool{allTablesPayload: true})
+
+	names := make([]string, 0, len(caches))
+	var errs []error
+	for _, c := range caches {
+		if err := c.reload(ctx); err != nil {
+			logger.ErrorWithFields("failed to reload cache", err, "cache", c.name)
+			errs = append(errs, fmt.Errorf("%s: %w", c.name, err))
+			continue
+		}
+		names = append(names, c.name)
+	}
+
+	if err := s.repo.notify(ctx, allTablesPayload); err != nil {
+		logger.Error("failed to notify cache invalidation", err)
+		return nil, status.Errorf(codes.Internal, "failed to notify other instances")
+	}
+
+	if len(errs) > 0 {
+		return nil, status.Errorf(codes.Internal, "failed to reload caches: %v", errors.Join(errs...))
+	}
+
+	return names, nil
+}
@@ -0,0 +1,187 @@
+package category
+
+import "sort"
+
+// KeywordMatch is a keyword found in a text. Start and End are byte offsets into the lowercased text.
+type KeywordMatch struct {
+	Keyword CategoryKeyword
+	Start   int
+	End     int
+}
+
+// keywordMatcher finds all keywords in a text in one pass over it (Aho-Corasick), so matching costs the length of
+// the text instead of the text times the number of keywords. It is built once per keyword reload and only read
+// afterwards, so it is safe for concurrent use.
+type keywordMatcher struct {
+	// keywords are lowercased and in matching order, a lower index wins.
+	keywords []CategoryKeyword
+	// classes maps bytes to columns of the transition table; bytes no keyword contains share column 0.
+	classes    [256]int32
+	numClasses int32
+	// next is the transition table of the automaton, numClasses entries per state; state 0 is the root.
+	next []int32
+	// patterns holds the id of the pattern ending in a state, -1 when none does.
+	patterns []int32
+	// outputLinks points to the nearest state on the failure chain where a pattern ends, -1 when there is none.
+	outputLinks []int32
+	// patternKeywords lists the keyword indexes sharing a pattern, in matching order.
+	patternKeywords [][]int
+}
+
+// newKeywordMatcher builds the matcher for keywords already lowercased and sorted in matching order.
+func newKeywordMatcher(keywords []CategoryKeyword) *keywordMatcher {
+	m := &keywordMatcher{keywords: keywords, numClasses: 1}
+	for _, keyword := range keywords {
+		for i := 0; i < len(keyword.Name); i++ {
+			if m.classes[keyword.Name[i]] == 0 {
+				m.classes[keyword.Name[i]] = m.numClasses
+				m.numClasses++
+			}
+		}
+	}
+
+	m.addState()
+
+	patternIDs := map[string]int32{}
+	for i, keyword := range keywords {
+		if keyword.Name == "" {
+			continue
+		}
+
+		patternID, ok := patternIDs[keyword.Name]
+		if !ok {
+			patternID = int32(len(m.patternKeywords))
+			patternIDs[keyword.Name] = patternID
+			m.patternKeywords = append(m.patternKeywords, nil)
+			m.patterns[m.insert(keyword.Name)] = patternID
+		}
+		m.patternKeywords[patternID] = append(m.patternKeywords[patternID], i)
+	}
+
+	m.link()
+
+	return m
+}
+
+func (m *keywordMatcher) addState() int32 {
+	m.next = append(m.next, make([]int32, m.numClasses)...)
+	m.patterns = append(m.patterns, -1)
+	m.outputLinks = append(m.outputLinks, -1)
+
+	return int32(len(m.patterns) - 1)
+}
+
+// insert adds the pattern to the trie and returns the state it ends in. While building, a 0 transition means
+// there is no child, the root is never a child.
+func (m *keywordMatcher) insert(pattern string) int32 {
+	state := int32(0)
+	for i := 0; i < len(pattern); i++ {
+		column := state*m.numClasses + m.classes[pattern[i]]
+		if m.next[column] == 0 {
+			m.next[column] = m.addState()
+		}
+		state = m.next[column]
+	}
+
+	return state
+}
+
+// link computes failure transitions breadth first and folds them into the transition table, so matching takes
+// exactly one transition per byte.
+func (m *keywordMatcher) link() {
+	fail := make([]int32, len(m.patterns))
+	queue := make([]int32, 0, len(m.patterns))
+
+	for class := int32(0); class < m.numClasses; class++ {
+		if child := m.next[class]; child != 0 {
+			queue = append(queue, child)
+		}
+	}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		if m.patterns[fail[state]] >= 0 {
+			m.outputLinks[state] = fail[state]
+		} else {
+			m.outputLinks[state] = m.outputLinks[fail[state]]
+		}
+
+		for class := int32(0); class < m.numClasses; class++ {
+			column := state*m.numClasses + class
+			fallback := m.next[fail[state]*m.numClasses+class]
+			if child := m.next[column]; child != 0 {
+				fail[child] = fallback
+				queue = append(queue, child)
+				continue
+			}
+			m.next[column] = fallback
+		}
+	}
+}
+
+// matchAll calls found for every occurrence of a pattern in the lowercased text.
+func (m *keywordMatcher) matchAll(text string, found func(patternID int32, end int)) {
+	if len(m.patternKeywords) == 0 {
+		return
+	}
+
+	state := int32(0)
+	for i := 0; i < len(text); i++ {
+		state = m.next[state*m.numClasses+m.classes[text[i]]]
+
+		output := state
+		if m.patterns[output] < 0 {
+			output = m.outputLinks[output]
+		}
+		for ; output >= 0; output = m.outputLinks[output] {
+			found(m.patterns[output], i+1)
+		}
+	}
+}
+
+// matches returns every keyword occurrence ordered by position, then by matching order.
+func (m *keywordMatcher) matches(text string) []KeywordMatch {
+	type occurrence struct {
+		index int
+		start int
+	}
+
+	occurrences := make([]occurrence, 0)
+	m.matchAll(text, func(patternID int32, end int) {
+		for _, i := range m.patternKeywords[patternID] {
+			occurrences = append(occurrences, occurrence{index: i, start: end - len(m.keywords[i].Name)})
+		}
+	})
+
+	sort.Slice(occurrences, func(i, j int) bool {
+		if occurrences[i].start != occurrences[j].start {
+			return occurrences[i].start < occurrences[j].start
+		}
+		return occurrences[i].index < occurrences[j].index
+	})
+
+	res := make([]KeywordMatch, len(occurrences))
+	for i, o := range occurrences {
+		keyword := m.keywords[o.index]
+		res[i] = KeywordMatch{Keyword: keyword, Start: o.start, End: o.start + len(keyword.Name)}
+	}
+
+	return res
+}
+
+// best returns the matching keyword first in matching order, wherever it occurs in the text.
+func (m *keywordMatcher) best(text string) (CategoryKeyword, bool) {
+	bestIndex := -1
+	m.matchAll(text, func(patternID int32, _ int) {
+		if i := m.patternKeywords[patternID][0]; bestIndex < 0 || i < bestIndex {
+			bestIndex = i
+		}
+	})
+	if bestIndex < 0 {
+		return CategoryKeyword{}, false
+	}
+
+	return m.keywords[bestIndex], true
+}
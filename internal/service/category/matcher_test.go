@@ -0,0 +1,191 @@
+package category
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const (
+	benchmarkKeywords     = 500
+	benchmarkDescriptions = 100000
+)
+
+func TestKeywordMatcher(t *testing.T) {
+	keywords := sortKeywords([]CategoryKeyword{
+		{ID: 1, CategoryID: 2, Name: "Tesco"},
+		{ID: 2, CategoryID: 3, Name: "Tesco Petrol"},
+		{ID: 3, CategoryID: 4, Name: "petrol"},
+		{ID: 4, CategoryID: 5, Name: "Uber"},
+		{ID: 5, CategoryID: 6, Name: "Uber Eats"},
+		{ID: 6, CategoryID: 7, Name: "eats"},
+		{ID: 7, CategoryID: 8, Name: "tes"},
+		{ID: 8, CategoryID: 9, Name: "amazon"},
+		{ID: 9, CategoryID: 3, Name: "amazon"},
+		{ID: 10, CategoryID: 10, Name: "ab"},
+		{ID: 11, CategoryID: 11, Name: "ba"},
+		{ID: 12, CategoryID: 12, Name: "aaa"},
+		{ID: 13, CategoryID: 13, Name: "aa"},
+	})
+	matcher := newKeywordMatcher(keywords)
+
+	tests := []struct {
+		name     string
+		text     string
+		wantBest int64
+	}{
+		{name: "no keyword", text: "card payment"},
+		{name: "longest overlapping keyword wins", text: "tesco petrol station", wantBest: 2},
+		{name: "prefix keyword", text: "tesco stores", wantBest: 1},
+		{name: "keyword inside a longer word", text: "testing", wantBest: 7},
+		{name: "later and longer keyword wins", text: "eats by uber eats", wantBest: 5},
+		{name: "duplicate keyword goes to the lower category", text: "amazon marketplace", wantBest: 9},
+		{name: "tie on length goes to the alphabetically first", text: "ba ab", wantBest: 10},
+		{name: "overlapping occurrences", text: "aaaa", wantBest: 12},
+		{name: "alternating overlaps", text: "ababab", wantBest: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if best, _ := matcher.best(tt.text); best.ID != tt.wantBest {
+				t.Fatalf("best = %d, want %d", best.ID, tt.wantBest)
+			}
+			assertMatcherAgrees(t, matcher, keywords, tt.text)
+		})
+	}
+}
+
+// TestKeywordMatcherRandom compares the matcher with the linear scan on random keywords and texts over a small
+// alphabet, so keywords often overlap, prefix each other and repeat in different categories.
+func TestKeywordMatcherRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	word := func(maxLen int) string {
+		letters := make([]byte, 1+rnd.Intn(maxLen))
+		for i := range letters {
+			letters[i] = "abc "[rnd.Intn(4)]
+		}
+		return string(letters)
+	}
+
+	for range 200 {
+		keywords := make([]CategoryKeyword, 1+rnd.Intn(20))
+		for i := range keywords {
+			keywords[i] = CategoryKeyword{ID: int64(i + 1), CategoryID: int64(2 + rnd.Intn(5)), Name: word(5)}
+		}
+		keywords = sortKeywords(keywords)
+		matcher := newKeywordMatcher(keywords)
+
+		for range 20 {
+			assertMatcherAgrees(t, matcher, keywords, word(30))
+		}
+	}
+}
+
+func assertMatcherAgrees(t *testing.T, matcher *keywordMatcher, keywords []CategoryKeyword, text string) {
+	t.Helper()
+
+	best, ok := matcher.best(text)
+	wantBest, wantOK := linearBest(keywords, text)
+	if ok != wantOK || best != wantBest {
+		t.Fatalf("best(%q) = %+v, %t, want %+v, %t", text, best, ok, wantBest, wantOK)
+	}
+
+	if matches, want := matcher.matches(text), linearMatches(keywords, text); !reflect.DeepEqual(matches, want) {
+		t.Fatalf("matches(%q) = %+v, want %+v", text, matches, want)
+	}
+}
+
+// linearMatches finds every occurrence of every keyword, ordered by position, then by matching order.
+func linearMatches(keywords []CategoryKeyword, text string) []KeywordMatch {
+	type occurrence struct {
+		index int
+		start int
+	}
+
+	var occurrences []occurrence
+	for i, keyword := range keywords {
+		for start := 0; start+len(keyword.Name) <= len(text); start++ {
+			if strings.HasPrefix(text[start:], keyword.Name) {
+				occurrences = append(occurrences, occurrence{index: i, start: start})
+			}
+		}
+	}
+	sort.Slice(occurrences, func(i, j int) bool {
+		if occurrences[i].start != occurrences[j].start {
+			return occurrences[i].start < occurrences[j].start
+		}
+		return occurrences[i].index < occurrences[j].index
+	})
+
+	res := make([]KeywordMatch, len(occurrences))
+	for i, o := range occurrences {
+		res[i] = KeywordMatch{Keyword: keywords[o.index], Start: o.start, End: o.start + len(keywords[o.index].Name)}
+	}
+
+	return res
+}
+
+// BenchmarkMatch categorises a 100k row import by keyword with the matcher and with the linear scan over all
+// keywords it replaced.
+func BenchmarkMatch(b *testing.B) {
+	keywords, descriptions := benchmarkData()
+	matcher := newKeywordMatcher(keywords)
+
+	b.Run("aho-corasick", func(b *testing.B) {
+		for range b.N {
+			for _, description := range descriptions {
+				matcher.best(description)
+			}
+		}
+	})
+
+	b.Run("linear", func(b *testing.B) {
+		for range b.N {
+			for _, description := range descriptions {
+				linearBest(keywords, description)
+			}
+		}
+	})
+}
+
+// linearBest is the keyword lookup used before the matcher: every keyword is searched in the text in matching order.
+func linearBest(keywords []CategoryKeyword, text string) (CategoryKeyword, bool) {
+	for _, keyword := range keywords {
+		if strings.Contains(text, keyword.Name) {
+			return keyword, true
+		}
+	}
+
+	return CategoryKeyword{}, false
+}
+
+// benchmarkData returns keywords in matching order and lowercased descriptions, about half of which contain a keyword.
+func benchmarkData() ([]CategoryKeyword, []string) {
+	rnd := rand.New(rand.NewSource(1))
+	word := func() string {
+		letters := make([]byte, 4+rnd.Intn(6))
+		for i := range letters {
+			letters[i] = byte('a' + rnd.Intn(26))
+		}
+		return string(letters)
+	}
+
+	keywords := make([]CategoryKeyword, benchmarkKeywords)
+	for i := range keywords {
+		keywords[i] = CategoryKeyword{ID: int64(i + 1), CategoryID: int64(i%40 + 2), Name: word() + " " + word()}
+	}
+	keywords = sortKeywords(keywords)
+
+	descriptions := make([]string, benchmarkDescriptions)
+	for i := range descriptions {
+		description := fmt.Sprintf("card payment %s %s ref %d", word(), word(), rnd.Intn(1000000))
+		if rnd.Intn(2) == 0 {
+			description += " " + keywords[rnd.Intn(len(keywords))].Name
+		}
+		descriptions[i] = description
+	}
+
+	return keywords, descriptions
+}
@@ -1,19 +1,95 @@
 package category
 
-const categoryTable = "category"
+import (
+	"context"
+	"time"
+)
+
+const (
+	categoryTable        = "category"
+	categoryKeywordTable = "category_keyword"
+	providerMappingTable = "provider_category_mapping"
+)
 
 const UncategorizedID = int64(1)
 
-const TransferCategoryName = "Transfer"
+const (
+	maxNameLen             = 30
+	maxKeywordLen          = 30
+	maxExternalCategoryLen = 50
+)
+
+// Kind says what money in a category is. It decides the transaction type of categorised imports and whether
+// transactions count in totals and spending analytics.
+type Kind string
+
+const (
+	ExpenseKind  Kind = "EXPENSE"
+	IncomeKind   Kind = "INCOME"
+	TransferKind Kind = "TRANSFER"
+	ExcludedKind Kind = "EXCLUDED"
+	SavingsKind  Kind = "SAVINGS"
+)
+
+// Counted reports whether transactions of the kind count in income and outcome totals. Transfers between own
+// accounts and excluded categories do not.
+func (k Kind) Counted() bool {
+	return k != TransferKind && k != ExcludedKind
+}
+
+// IsSpending reports whether outcomes of the kind are spending; money put into savings is counted but not spent.
+func (k Kind) IsSpending() bool {
+	return k.Counted() && k != SavingsKind
+}
 
 type Category struct {
 	ID          int64
 	Name        string
 	Description *string
+	// Archived categories are hidden from the category list and their keywords no longer match,
+	// existing transactions keep them.
+	Archived bool
+	// ParentID is nil for top-level categories.
+	ParentID *int64
+	Kind     Kind
+	// UserID is the owner of a user category, nil for global categories every user sees.
+	UserID *int64
+}
+
+// CategoryNode is a category with its subcategories.
+type CategoryNode struct {
+	Category
+	Children []CategoryNode
 }
 
 type CategoryKeyword struct {
 	ID         int64
 	CategoryID int64
 	Name       string
+	// UserID limits the keyword to the transactions of one user, where it overrides a global keyword
+	// with the same name. Nil for global keywords.
+	UserID *int64
+}
+
+// ProviderMapping maps a category reported by a bank, such as a Monzo category or the Category column of an Amex
+// export, to an internal category. External categories are matched ignoring case.
+type ProviderMapping struct {
+	ID               int64
+	BankID           int64
+	ExternalCategory string
+	CategoryID       int64
+	CreatedAt        time.Time
+}
+
+type CategoryUpdateData struct {
+	ID          int64
+	Name        *string
+	Description *string
+	Archived    *bool
+	// ParentID moves the category under another one, 0 makes it a top-level category.
+	ParentID *int64
+	Kind     *Kind
 }
+
+// ChangeHook is called after categories or keywords change, once the category store is reloaded.
+type ChangeHook func(ctx context.Context)
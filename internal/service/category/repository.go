@@ -2,8 +2,10 @@ package category
 
 import (
 	"context"
+	"fmt"
 	"github.com/Masterminds/squirrel"
 	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -39,9 +41,10 @@ func (r *repository) categoryList(ctx context.Context) ([]Category, error) {
 
 func (r *repository) getCategoriesKeywords(ctx context.Context) ([]CategoryKeyword, error) {
 	query, args, err := squirrel.
-		Select("ck.id", "ck.category_id", "ck.name").
+		Select("ck.id", "ck.category_id", "ck.name", "ck.user_id").
 		From("category_keyword ck").
 		Join("category c ON ck.category_id = c.id").
+		Where("NOT c.archived").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -76,3 +79,341 @@ func (r *repository) getCategoryByID(ctx context.Context, categoryID int64) (*Ca
 
 	return &category, nil
 }
+
+func (r *repository) keywordList(ctx context.Context, categoryID *int64) ([]CategoryKeyword, error) {
+	queryBuilder := squirrel.
+		Select("id", "category_id", "name", "user_id").
+		From(categoryKeywordTable).
+		OrderBy("name").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if categoryID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"category_id": *categoryID})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var keywords []CategoryKeyword
+	err = pgxscan.Select(ctx, r.dbPool, &keywords, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return keywords, nil
+}
+
+func (r *repository) createCategory(ctx context.Context, category *Category) (*Category, error) {
+	query, args, err := squirrel.
+		Insert(categoryTable).
+		Columns("name", "description", "parent_id", "kind", "user_id").
+		Values(category.Name, category.Description, category.ParentID, category.Kind, category.UserID).
+		Suffix("RETURNING *").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var created Category
+	err = pgxscan.Get(ctx, r.dbPool, &created, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *repository) updateCategory(ctx context.Context, category *Category) (*Category, error) {
+	query, args, err := squirrel.
+		Update(categoryTable).
+		Set("name", category.Name).
+		Set("description", category.Description).
+		Set("archived", category.Archived).
+		Set("parent_id", category.ParentID).
+		Set("kind", category.Kind).
+		Where(squirrel.Eq{"id": category.ID}).
+		Suffix("RETURNING *").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Category
+	err = pgxscan.Get(ctx, r.dbPool, &updated, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// categoryReferences counts the alert rules, savings goals and categorisation rules that point at the category.
+func (r *repository) categoryReferences(ctx context.Context, id int64) (int64, error) {
+	var count int64
+	err := r.dbPool.QueryRow(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM alert_rule WHERE category_id = $1) +
+			(SELECT COUNT(*) FROM savings_goal WHERE category_id = $1) +
+			(SELECT COUNT(*) FROM categorization_rule WHERE (actions->>'category_id')::bigint = $1)`,
+		id,
+	).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// deleteCategory moves the transactions of the category to another one and deletes it with its keywords,
+// provider mappings and category suggestions.
+func (r *repository) deleteCategory(ctx context.Context, id, reassignToID int64) (int64, error) {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	moved, err := reassignTransactions(ctx, tx, id, reassignToID)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM category_keyword WHERE category_id = $1", id); err != nil {
+		return 0, fmt.Errorf("failed to delete category keywords: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM provider_category_mapping WHERE category_id = $1", id); err != nil {
+		return 0, fmt.Errorf("failed to delete provider category mappings: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM category_suggestion WHERE category_id = $1", id); err != nil {
+		return 0, fmt.Errorf("failed to delete category suggestions: %w", err)
+	}
+
+	// Subcategories move up to the parent of the deleted category.
+	_, err = tx.Exec(ctx, "UPDATE category SET parent_id = (SELECT parent_id FROM category WHERE id = $1) WHERE parent_id = $1", id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to move subcategories: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, "DELETE FROM category WHERE id = $1", id)
+	if err != nil {
+		return 0, err
+	}
+	if tag.RowsAffected() == 0 {
+		return 0, pgx.ErrNoRows
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return moved, nil
+}
+
+// mergeCategory moves transactions, keywords, subcategories and every reference of the source category to the target and deletes the source.
+func (r *repository) mergeCategory(ctx context.Context, sourceID, targetID int64) (int64, error) {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	moved, err := reassignTransactions(ctx, tx, sourceID, targetID)
+	if err != nil {
+		return 0, err
+	}
+
+	statements := []string{
+		`INSERT INTO category_keyword (category_id, name, user_id)
+			SELECT $2, name, user_id FROM category_keyword WHERE category_id = $1
+			ON CONFLICT (category_id, name, COALESCE(user_id, 0)) DO NOTHING`,
+		"DELETE FROM category_keyword WHERE category_id = $1",
+		"UPDATE provider_category_mapping SET category_id = $2 WHERE category_id = $1",
+		// A pending suggestion of the source for a pattern the target already has pending is added to that one.
+		`UPDATE category_suggestion t SET occurrences = t.occurrences + s.occurrences, updated_at = CURRENT_TIMESTAMP
+			FROM category_suggestion s
+			WHERE s.category_id = $1 AND s.status = 'PENDING' AND t.category_id = $2 AND t.status = 'PENDING' AND t.pattern = s.pattern`,
+		`DELETE FROM category_suggestion s
+			WHERE s.category_id = $1 AND s.status = 'PENDING' AND EXISTS (
+				SELECT 1 FROM category_suggestion t WHERE t.category_id = $2 AND t.status = 'PENDING' AND t.pattern = s.pattern
+			)`,
+		"UPDATE category_suggestion SET category_id = $2 WHERE category_id = $1",
+		"UPDATE alert_rule SET category_id = $2 WHERE category_id = $1",
+		"UPDATE savings_goal SET category_id = $2 WHERE category_id = $1",
+		`UPDATE categorization_rule SET actions = jsonb_set(actions, '{category_id}', to_jsonb($2::bigint)), updated_at = CURRENT_TIMESTAMP
+			WHERE (actions->>'category_id')::bigint = $1`,
+	}
+	for _, statement := range statements {
+		if _, err = tx.Exec(ctx, statement, sourceID, targetID); err != nil {
+			return 0, fmt.Errorf("failed to move category references: %w", err)
+		}
+	}
+
+	// Subcategories move up to the parent of the source, the target stays a leaf.
+	_, err = tx.Exec(ctx, "UPDATE category SET parent_id = (SELECT parent_id FROM category WHERE id = $1) WHERE parent_id = $1", sourceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to move subcategories: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, "DELETE FROM category WHERE id = $1", sourceID)
+	if err != nil {
+		return 0, err
+	}
+	if tag.RowsAffected() == 0 {
+		return 0, pgx.ErrNoRows
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return moved, nil
+}
+
+func reassignTransactions(ctx context.Context, tx pgx.Tx, fromID, toID int64) (int64, error) {
+	tag, err := tx.Exec(ctx, "UPDATE transaction SET category_id = $2 WHERE category_id = $1", fromID, toID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reassign transactions: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *repository) createKeyword(ctx context.Context, keyword *CategoryKeyword) (*CategoryKeyword, error) {
+	query, args, err := squirrel.
+		Insert(categoryKeywordTable).
+		Columns("category_id", "name", "user_id").
+		Values(keyword.CategoryID, keyword.Name, keyword.UserID).
+		Suffix("RETURNING id, category_id, name, user_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var created CategoryKeyword
+	err = pgxscan.Get(ctx, r.dbPool, &created, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *repository) deleteKeyword(ctx context.Context, id int64) error {
+	tag, err := r.dbPool.Exec(ctx, "DELETE FROM category_keyword WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// activeProviderMappings returns the mappings to categories that are not archived.
+func (r *repository) activeProviderMappings(ctx context.Context) ([]ProviderMapping, error) {
+	query, args, err := squirrel.
+		Select("m.id", "m.bank_id", "m.external_category", "m.category_id", "m.created_at").
+		From("provider_category_mapping m").
+		Join("category c ON m.category_id = c.id").
+		Where("NOT c.archived").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var mappings []ProviderMapping
+	err = pgxscan.Select(ctx, r.dbPool, &mappings, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappings, nil
+}
+
+func (r *repository) providerMappingList(ctx context.Context, bankID *int64) ([]ProviderMapping, error) {
+	queryBuilder := squirrel.
+		Select("id", "bank_id", "external_category", "category_id", "created_at").
+		From(providerMappingTable).
+		OrderBy("bank_id", "lower(external_category)").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if bankID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"bank_id": *bankID})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var mappings []ProviderMapping
+	err = pgxscan.Select(ctx, r.dbPool, &mappings, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappings, nil
+}
+
+func (r *repository) createProviderMapping(ctx context.Context, mapping *ProviderMapping) (*ProviderMapping, error) {
+	query, args, err := squirrel.
+		Insert(providerMappingTable).
+		Columns("bank_id", "external_category", "category_id").
+		Values(mapping.BankID, mapping.ExternalCategory, mapping.CategoryID).
+		Suffix("RETURNING id, bank_id, external_category, category_id, created_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var created ProviderMapping
+	err = pgxscan.Get(ctx, r.dbPool, &created, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *repository) updateProviderMapping(ctx context.Context, id, categoryID int64) (*ProviderMapping, error) {
+	query, args, err := squirrel.
+		Update(providerMappingTable).
+		Set("category_id", categoryID).
+		Where(squirrel.Eq{"id": id}).
+		Suffix("RETURNING id, bank_id, external_category, category_id, created_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var updated ProviderMapping
+	err = pgxscan.Get(ctx, r.dbPool, &updated, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (r *repository) deleteProviderMapping(ctx context.Context, id int64) error {
+	tag, err := r.dbPool.Exec(ctx, "DELETE FROM provider_category_mapping WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
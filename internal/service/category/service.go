@@ -3,14 +3,22 @@ package category
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
+
 	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
 	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Service struct {
 	repo  *repository
 	store *Store
+
+	hooksMu sync.RWMutex
+	hooks   []ChangeHook
 }
 
 func NewService(dbPool *pgxpool.Pool) *Service {
@@ -21,37 +29,144 @@ func NewService(dbPool *pgxpool.Pool) *Service {
 }
 
 func (s *Service) Initialize(ctx context.Context) error {
+	if err := s.reload(ctx); err != nil {
+		return fmt.Errorf("service initialization failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) reload(ctx context.Context) error {
 	keywords, err := s.repo.getCategoriesKeywords(ctx)
 	if err != nil {
 		logger.Error("failed to get category's keywords", err)
-		return fmt.Errorf("service initialization failed: %w", err)
+		return err
 	}
 
-	s.store.ReloadKeywordCategoryIDMap(keywords)
+	s.store.ReloadKeywords(keywords)
 
 	categories, err := s.repo.categoryList(ctx)
 	if err != nil {
 		logger.Error("failed to get categories", err)
-		return fmt.Errorf("service initialization failed: %w", err)
+		return err
 	}
 
 	s.store.ReloadCategoriesMap(categories)
 
+	mappings, err := s.repo.activeProviderMappings(ctx)
+	if err != nil {
+		logger.Error("failed to get provider category mappings", err)
+		return err
+	}
+
+	s.store.ReloadProviderMappings(mappings)
+
 	return nil
 }
 
+// OnChange registers a hook called after every category or keyword change.
+func (s *Service) OnChange(hook ChangeHook) {
+	s.hooksMu.Lock()
+	defer s.hooksMu.Unlock()
+
+	s.hooks = append(s.hooks, hook)
+}
+
+// Reload refreshes the store from the database and notifies the hooks, for changes made outside this service;
+// a failed reload leaves the previous categories and keywords in use.
+func (s *Service) Reload(ctx context.Context) error {
+	if err := s.reload(ctx); err != nil {
+		return err
+	}
+
+	s.notifyHooks(ctx)
+
+	return nil
+}
+
+// reloadAfterChange refreshes the store once a change is committed and notifies the hooks;
+// a failed reload leaves the previous categories and keywords in use.
+func (s *Service) reloadAfterChange(ctx context.Context) {
+	if err := s.reload(ctx); err != nil {
+		logger.Error("failed to reload category store", err)
+	}
+
+	s.notifyHooks(ctx)
+}
+
+func (s *Service) notifyHooks(ctx context.Context) {
+	s.hooksMu.RLock()
+	hooks := s.hooks
+	s.hooksMu.RUnlock()
+
+	for _, hook := range hooks {
+		hook(ctx)
+	}
+}
+
 func (s *Service) Store() *Store {
 	return s.store
 }
 
-func (s *Service) CategoryList(ctx context.Context) ([]Category, error) {
+// CategoryList returns the active categories the user sees, and archived ones too when includeArchived is set.
+// Without a user only global categories are returned.
+func (s *Service) CategoryList(ctx context.Context, includeArchived bool, userID *int64) ([]Category, error) {
 	categories, err := s.repo.categoryList(ctx)
 	if err != nil {
 		logger.Error("failed to get categories", err)
 		return nil, psql.MapPostgresError("failed to get categories", err)
 	}
 
-	return categories, nil
+	res := make([]Category, 0, len(categories))
+	for _, category := range categories {
+		if category.Archived && !includeArchived {
+			continue
+		}
+		if category.UserID != nil && (userID == nil || *category.UserID != *userID) {
+			continue
+		}
+		res = append(res, category)
+	}
+
+	return res, nil
+}
+
+// CategoryTree returns categories nested under their parents, ordered by name on every level.
+// A category whose parent is not listed, e.g. archived, is returned at the top level.
+func (s *Service) CategoryTree(ctx context.Context, includeArchived bool, userID *int64) ([]CategoryNode, error) {
+	categories, err := s.CategoryList(ctx, includeArchived, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make(map[int64]bool, len(categories))
+	for _, category := range categories {
+		listed[category.ID] = true
+	}
+
+	children := map[int64][]Category{}
+	roots := make([]Category, 0)
+	for _, category := range categories {
+		if category.ParentID != nil && listed[*category.ParentID] {
+			children[*category.ParentID] = append(children[*category.ParentID], category)
+			continue
+		}
+		roots = append(roots, category)
+	}
+
+	return buildCategoryNodes(roots, children), nil
+}
+
+func buildCategoryNodes(categories []Category, children map[int64][]Category) []CategoryNode {
+	nodes := make([]CategoryNode, len(categories))
+	for i, category := range categories {
+		nodes[i] = CategoryNode{
+			Category: category,
+			Children: buildCategoryNodes(children[category.ID], children),
+		}
+	}
+
+	return nodes
 }
 
 func (s *Service) GetCategoryByID(ctx context.Context, id int64) (*Category, error) {
@@ -71,23 +186,409 @@ func (s *Service) GetCategoryByID(ctx context.Context, id int64) (*Category, err
 	return category, nil
 }
 
-func (s *Service) GetKeywordCategoryIDMap(ctx context.Context) (map[string]int64, error) {
-	keywordCategoryMap := s.store.GetKeywordCategoryIDMap()
-	if keywordCategoryMap != nil {
-		return keywordCategoryMap, nil
+func (s *Service) CreateCategory(ctx context.Context, category *Category) (*Category, error) {
+	category.Name = strings.TrimSpace(category.Name)
+	if err := s.validateName(ctx, 0, category.Name, category.UserID); err != nil {
+		return nil, err
+	}
+	if category.ParentID != nil {
+		if err := s.validateParent(ctx, 0, *category.ParentID, category.UserID); err != nil {
+			return nil, err
+		}
+	}
+	// A subcategory is the same kind of money as its parent unless told otherwise.
+	if category.Kind == "" {
+		category.Kind = ExpenseKind
+		if category.ParentID != nil {
+			category.Kind = s.store.GetKind(*category.ParentID)
+		}
+	}
+	if err := validateKind(category.Kind); err != nil {
+		return nil, err
 	}
 
-	logger.Error("failed to get keyword category's keywords from cache", nil)
+	created, err := s.repo.createCategory(ctx, category)
+	if err != nil {
+		logger.ErrorWithFields("failed to create category", err, "name", category.Name)
+		return nil, psql.MapPostgresError("failed to create category", err)
+	}
 
-	keywords, err := s.repo.getCategoriesKeywords(ctx)
+	s.reloadAfterChange(ctx)
+
+	return created, nil
+}
+
+// UpdateCategory renames, describes, moves or archives a category. Archiving keeps the category on existing
+// transactions but stops its keywords from matching new ones.
+func (s *Service) UpdateCategory(ctx context.Context, data *CategoryUpdateData) (*Category, error) {
+	category, err := s.repo.getCategoryByID(ctx, data.ID)
 	if err != nil {
-		logger.Error("failed to get category's keywords", err)
+		logger.ErrorWithFields("failed to get category", err, "category_id", data.ID)
+		return nil, psql.MapPostgresError("failed to get category", err)
+	}
+
+	if data.Name != nil {
+		name := strings.TrimSpace(*data.Name)
+		if err = s.validateName(ctx, category.ID, name, category.UserID); err != nil {
+			return nil, err
+		}
+		category.Name = name
+	}
+	if data.Description != nil {
+		category.Description = data.Description
+	}
+	if data.Archived != nil {
+		if *data.Archived && category.ID == UncategorizedID {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid category: uncategorized category can not be archived")
+		}
+		category.Archived = *data.Archived
+	}
+	if data.ParentID != nil {
+		category.ParentID = nil
+		if *data.ParentID != 0 {
+			if err = s.validateParent(ctx, category.ID, *data.ParentID, category.UserID); err != nil {
+				return nil, err
+			}
+			category.ParentID = data.ParentID
+		}
+	}
+	if data.Kind != nil {
+		if err = validateKind(*data.Kind); err != nil {
+			return nil, err
+		}
+		category.Kind = *data.Kind
+	}
+
+	updated, err := s.repo.updateCategory(ctx, category)
+	if err != nil {
+		logger.ErrorWithFields("failed to update category", err, "category_id", data.ID)
+		return nil, psql.MapPostgresError("failed to update category", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return updated, nil
+}
+
+// DeleteCategory deletes a category with its keywords and suggestions and moves its transactions to
+// reassignToID, or to the uncategorized category when it is nil. Subcategories move up to the parent of the
+// deleted category. Categories still used by rules, alerts or goals can not be deleted, they have to be
+// merged instead. It returns the number of reassigned transactions.
+func (s *Service) DeleteCategory(ctx context.Context, id int64, reassignToID *int64) (int64, error) {
+	targetID := UncategorizedID
+	if reassignToID != nil {
+		targetID = *reassignToID
+	}
+
+	if err := s.validateReassignment(ctx, id, targetID); err != nil {
+		return 0, err
+	}
+
+	references, err := s.repo.categoryReferences(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to get category references", err, "category_id", id)
+		return 0, psql.MapPostgresError("failed to delete category", err)
+	}
+	if references > 0 {
+		return 0, status.Errorf(codes.FailedPrecondition,
+			"category is used by %d rules, alerts or goals, merge it into another category instead", references)
+	}
+
+	moved, err := s.repo.deleteCategory(ctx, id, targetID)
+	if err != nil {
+		logger.ErrorWithFields("failed to delete category", err, "category_id", id)
+		return 0, psql.MapPostgresError("failed to delete category", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return moved, nil
+}
+
+// MergeCategory moves transactions, keywords, rules, alerts, goals and suggestions of the source category to
+// the target and deletes the source. Subcategories move up to the parent of the source, so the target stays
+// a leaf. It returns the number of reassigned transactions.
+func (s *Service) MergeCategory(ctx context.Context, sourceID, targetID int64) (int64, error) {
+	if err := s.validateReassignment(ctx, sourceID, targetID); err != nil {
+		return 0, err
+	}
+	if s.store.IsInSubtree(targetID, sourceID) {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid target category: category %d is a subcategory of %d", targetID, sourceID)
+	}
+
+	moved, err := s.repo.mergeCategory(ctx, sourceID, targetID)
+	if err != nil {
+		logger.ErrorWithFields("failed to merge category", err, "category_id", sourceID, "target_category_id", targetID)
+		return 0, psql.MapPostgresError("failed to merge category", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return moved, nil
+}
+
+func (s *Service) KeywordList(ctx context.Context, categoryID *int64) ([]CategoryKeyword, error) {
+	keywords, err := s.repo.keywordList(ctx, categoryID)
+	if err != nil {
+		logger.Error("failed to get category keywords", err)
 		return nil, psql.MapPostgresError("failed to get category keywords", err)
 	}
 
-	for _, keyword := range keywords {
-		keywordCategoryMap[keyword.Name] = keyword.CategoryID
+	return keywords, nil
+}
+
+// AddKeyword adds a keyword to a category. A keyword belongs to one category per scope only, regardless of case,
+// so matching never depends on which of two categories was loaded first. The keyword of a user category is scoped
+// to its owner; a user keyword for a global category overrides the global keyword with the same name.
+func (s *Service) AddKeyword(ctx context.Context, keyword *CategoryKeyword) (*CategoryKeyword, error) {
+	keyword.Name = strings.TrimSpace(keyword.Name)
+	if keyword.Name == "" || len(keyword.Name) > maxKeywordLen {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid keyword: name must be 1-%d characters", maxKeywordLen)
+	}
+
+	category, err := s.repo.getCategoryByID(ctx, keyword.CategoryID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get category", err, "category_id", keyword.CategoryID)
+		return nil, psql.MapPostgresError("failed to get category", err)
+	}
+	if category.UserID != nil {
+		if keyword.UserID != nil && *keyword.UserID != *category.UserID {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid keyword: category %d belongs to another user", category.ID)
+		}
+		keyword.UserID = category.UserID
+	}
+
+	keywords, err := s.repo.keywordList(ctx, nil)
+	if err != nil {
+		logger.Error("failed to get category keywords", err)
+		return nil, psql.MapPostgresError("failed to add category keyword", err)
+	}
+	for _, existing := range keywords {
+		if sameOwner(existing.UserID, keyword.UserID) && strings.EqualFold(existing.Name, keyword.Name) {
+			return nil, status.Errorf(codes.AlreadyExists,
+				"keyword %q already belongs to category %d", existing.Name, existing.CategoryID)
+		}
+	}
+
+	created, err := s.repo.createKeyword(ctx, keyword)
+	if err != nil {
+		logger.ErrorWithFields("failed to create category keyword", err, "category_id", keyword.CategoryID)
+		return nil, psql.MapPostgresError("failed to add category keyword", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return created, nil
+}
+
+func (s *Service) DeleteKeyword(ctx context.Context, id int64) error {
+	if err := s.repo.deleteKeyword(ctx, id); err != nil {
+		logger.ErrorWithFields("failed to delete category keyword", err, "keyword_id", id)
+		return psql.MapPostgresError("failed to delete category keyword", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return nil
+}
+
+func (s *Service) ProviderMappingList(ctx context.Context, bankID *int64) ([]ProviderMapping, error) {
+	mappings, err := s.repo.providerMappingList(ctx, bankID)
+	if err != nil {
+		logger.Error("failed to get provider category mappings", err)
+		return nil, psql.MapPostgresError("failed to get provider category mappings", err)
+	}
+
+	return mappings, nil
+}
+
+// CreateProviderMapping maps a category reported by a bank to a leaf category. An external category maps to one
+// category per bank, regardless of case.
+func (s *Service) CreateProviderMapping(ctx context.Context, mapping *ProviderMapping) (*ProviderMapping, error) {
+	mapping.ExternalCategory = strings.TrimSpace(mapping.ExternalCategory)
+	if mapping.ExternalCategory == "" || len(mapping.ExternalCategory) > maxExternalCategoryLen {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid external category: must be 1-%d characters", maxExternalCategoryLen)
+	}
+	if err := s.validateMappingCategory(ctx, mapping.CategoryID); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.createProviderMapping(ctx, mapping)
+	if err != nil {
+		logger.ErrorWithFields("failed to create provider category mapping", err,
+			"bank_id", mapping.BankID,
+			"external_category", mapping.ExternalCategory,
+		)
+		return nil, psql.MapPostgresError("failed to create provider category mapping", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return created, nil
+}
+
+// UpdateProviderMapping points an existing mapping to another category.
+func (s *Service) UpdateProviderMapping(ctx context.Context, id, categoryID int64) (*ProviderMapping, error) {
+	if err := s.validateMappingCategory(ctx, categoryID); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.updateProviderMapping(ctx, id, categoryID)
+	if err != nil {
+		logger.ErrorWithFields("failed to update provider category mapping", err, "mapping_id", id)
+		return nil, psql.MapPostgresError("failed to update provider category mapping", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return updated, nil
+}
+
+func (s *Service) DeleteProviderMapping(ctx context.Context, id int64) error {
+	if err := s.repo.deleteProviderMapping(ctx, id); err != nil {
+		logger.ErrorWithFields("failed to delete provider category mapping", err, "mapping_id", id)
+		return psql.MapPostgresError("failed to delete provider category mapping", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return nil
+}
+
+func (s *Service) validateMappingCategory(ctx context.Context, categoryID int64) error {
+	category, err := s.repo.getCategoryByID(ctx, categoryID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get category", err, "category_id", categoryID)
+		return psql.MapPostgresError("failed to get category", err)
+	}
+	if category.Archived {
+		return status.Errorf(codes.InvalidArgument, "invalid category: category %d is archived", categoryID)
+	}
+	// Mappings apply to every user of the bank.
+	if category.UserID != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid category: category %d belongs to a user, mappings need a global category", categoryID)
+	}
+
+	return s.ValidateLeaf(categoryID)
+}
+
+// validateName checks the name length and that no other category the owner sees has the same name, regardless of
+// case. A global name must not be taken by any user, as every user sees global categories.
+func (s *Service) validateName(ctx context.Context, id int64, name string, userID *int64) error {
+	if name == "" || len(name) > maxNameLen {
+		return status.Errorf(codes.InvalidArgument, "invalid category: name must be 1-%d characters", maxNameLen)
+	}
+
+	categories, err := s.repo.categoryList(ctx)
+	if err != nil {
+		logger.Error("failed to get categories", err)
+		return psql.MapPostgresError("failed to get categories", err)
+	}
+
+	for _, category := range categories {
+		if category.UserID != nil && userID != nil && *category.UserID != *userID {
+			continue
+		}
+		if category.ID != id && strings.EqualFold(category.Name, name) {
+			return status.Errorf(codes.AlreadyExists, "category %q already exists", category.Name)
+		}
+	}
+
+	return nil
+}
+
+func validateKind(kind Kind) error {
+	switch kind {
+	case ExpenseKind, IncomeKind, TransferKind, ExcludedKind, SavingsKind:
+		return nil
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid category: unknown kind %s", kind)
+	}
+}
+
+// ValidateLeaf checks that transactions can be assigned to the category: amounts of parent categories
+// are rolled up from their subcategories, so only leaf categories are assignable.
+func (s *Service) ValidateLeaf(id int64) error {
+	if !s.store.IsLeaf(id) {
+		return status.Errorf(codes.InvalidArgument, "invalid category: category %d has subcategories, choose one of them", id)
+	}
+
+	return nil
+}
+
+// validateParent checks that the category with the given id, 0 for a new one, can be moved under parentID
+// without creating a cycle.
+func (s *Service) validateParent(ctx context.Context, id, parentID int64, userID *int64) error {
+	if id == UncategorizedID || parentID == UncategorizedID {
+		return status.Errorf(codes.InvalidArgument, "invalid parent category: uncategorized category must stay a top-level leaf")
+	}
+	if id != 0 && s.store.IsInSubtree(parentID, id) {
+		return status.Errorf(codes.InvalidArgument, "invalid parent category: category %d can not be moved under itself or its subcategory", id)
+	}
+
+	parent, err := s.repo.getCategoryByID(ctx, parentID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get parent category", err, "category_id", parentID)
+		return psql.MapPostgresError("failed to get parent category", err)
+	}
+	if parent.Archived {
+		return status.Errorf(codes.InvalidArgument, "invalid parent category: category %d is archived", parentID)
+	}
+	// A user subcategory would turn a global category into a parent for every user.
+	if !sameOwner(parent.UserID, userID) {
+		return status.Errorf(codes.InvalidArgument, "invalid parent category: category %d has another owner", parentID)
+	}
+
+	return nil
+}
+
+func (s *Service) validateReassignment(ctx context.Context, sourceID, targetID int64) error {
+	if sourceID == UncategorizedID {
+		return status.Errorf(codes.InvalidArgument, "invalid category: uncategorized category can not be removed")
+	}
+	if sourceID == targetID {
+		return status.Errorf(codes.InvalidArgument, "invalid target category: must differ from the category")
+	}
+
+	source, err := s.repo.getCategoryByID(ctx, sourceID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get category", err, "category_id", sourceID)
+		return psql.MapPostgresError("failed to get category", err)
+	}
+
+	target, err := s.repo.getCategoryByID(ctx, targetID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get target category", err, "category_id", targetID)
+		return psql.MapPostgresError("failed to get target category", err)
+	}
+	if target.Archived {
+		return status.Errorf(codes.InvalidArgument, "invalid target category: category %d is archived", targetID)
+	}
+	// Transactions only belong to leaf categories.
+	if !s.store.IsLeaf(targetID) {
+		return status.Errorf(codes.InvalidArgument, "invalid target category: category %d has subcategories", targetID)
+	}
+	// Transactions of a global category may belong to any user, so they can only move to a global category.
+	if target.UserID != nil && !sameOwner(source.UserID, target.UserID) {
+		return status.Errorf(codes.InvalidArgument, "invalid target category: category %d is not visible to the owner of category %d", targetID, sourceID)
+	}
+
+	return nil
+}
+
+// ValidateVisible checks that the user sees the category.
+func (s *Service) ValidateVisible(id, userID int64) error {
+	if !s.store.IsVisible(id, userID) {
+		return status.Errorf(codes.InvalidArgument, "invalid category: category %d belongs to another user", id)
+	}
+
+	return nil
+}
+
+func sameOwner(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
 	}
 
-	return keywordCategoryMap, nil
+	return *a == *b
 }
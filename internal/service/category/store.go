@@ -1,29 +1,66 @@
 package category
 
-import "sync"
+import (
+	"sort"
+	"strings"
+	"sync"
+)
 
 type Store struct {
-	mu                sync.RWMutex
-	keywordCategoryID map[string]int64
-	categories        map[int64]Category
+	mu       sync.RWMutex
+	keywords []CategoryKeyword
+	// matcher matches global keywords, userMatchers the effective keywords of users with keywords of their own.
+	matcher      *keywordMatcher
+	userMatchers map[int64]*keywordMatcher
+	categories   map[int64]Category
+	children     map[int64][]int64
+	// providerMappings maps bank ids to lowercased external categories and the categories they map to.
+	providerMappings map[int64]map[string]int64
 }
 
 func NewStore() *Store {
-	return &Store{
-		keywordCategoryID: map[string]int64{},
-	}
+	return &Store{}
 }
 
-func (s *Store) ReloadKeywordCategoryIDMap(keywords []CategoryKeyword) {
+func (s *Store) ReloadKeywords(keywords []CategoryKeyword) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	keywordCategoryMap := map[string]int64{}
-	for _, keyword := range keywords {
-		keywordCategoryMap[keyword.Name] = keyword.CategoryID
+	s.keywords = sortKeywords(keywords)
+
+	global := make([]CategoryKeyword, 0, len(s.keywords))
+	userKeywords := map[int64][]CategoryKeyword{}
+	for _, keyword := range s.keywords {
+		if keyword.UserID == nil {
+			global = append(global, keyword)
+			continue
+		}
+		userKeywords[*keyword.UserID] = append(userKeywords[*keyword.UserID], keyword)
+	}
+
+	s.matcher = newKeywordMatcher(global)
+	s.userMatchers = make(map[int64]*keywordMatcher, len(userKeywords))
+	for userID, keywords := range userKeywords {
+		s.userMatchers[userID] = newKeywordMatcher(effectiveKeywords(global, keywords))
+	}
+}
+
+// effectiveKeywords merges the keywords of a user into the global ones, dropping global keywords the user
+// overrides. The result is in matching order.
+func effectiveKeywords(global, own []CategoryKeyword) []CategoryKeyword {
+	overridden := make(map[string]bool, len(own))
+	for _, keyword := range own {
+		overridden[keyword.Name] = true
 	}
 
-	s.keywordCategoryID = keywordCategoryMap
+	res := append(make([]CategoryKeyword, 0, len(global)+len(own)), own...)
+	for _, keyword := range global {
+		if !overridden[keyword.Name] {
+			res = append(res, keyword)
+		}
+	}
+
+	return sortKeywords(res)
 }
 
 func (s *Store) ReloadCategoriesMap(categories []Category) {
@@ -31,25 +68,215 @@ func (s *Store) ReloadCategoriesMap(categories []Category) {
 	defer s.mu.Unlock()
 
 	categoriesMap := make(map[int64]Category, len(categories))
+	children := make(map[int64][]int64)
 	for _, category := range categories {
 		categoriesMap[category.ID] = category
+		if category.ParentID != nil {
+			children[*category.ParentID] = append(children[*category.ParentID], category.ID)
+		}
 	}
 
 	s.categories = categoriesMap
+	s.children = children
 }
 
-func (s *Store) GetKeywordCategoryIDMap() map[string]int64 {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *Store) GetCategory(id int64) *Category {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	return s.keywordCategoryID
+	category, ok := s.categories[id]
+	if !ok {
+		return nil
+	}
+
+	return &category
 }
 
-func (s *Store) GetCategory(id int64) *Category {
+func (s *Store) ReloadProviderMappings(mappings []ProviderMapping) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	category := s.categories[id]
+	providerMappings := make(map[int64]map[string]int64)
+	for _, mapping := range mappings {
+		if providerMappings[mapping.BankID] == nil {
+			providerMappings[mapping.BankID] = map[string]int64{}
+		}
+		providerMappings[mapping.BankID][normalizeExternalCategory(mapping.ExternalCategory)] = mapping.CategoryID
+	}
 
-	return &category
+	s.providerMappings = providerMappings
+}
+
+// GetProviderCategory returns the category a category reported by the bank maps to.
+func (s *Store) GetProviderCategory(bankID int64, externalCategory string) (int64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	categoryID, ok := s.providerMappings[bankID][normalizeExternalCategory(externalCategory)]
+	return categoryID, ok
+}
+
+// IsVisible reports whether the user sees the category: global categories are visible to everyone,
+// user categories to their owner only.
+func (s *Store) IsVisible(id, userID int64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	category, ok := s.categories[id]
+	return ok && (category.UserID == nil || *category.UserID == userID)
+}
+
+// GetKind returns the kind of the category, expense for unknown categories.
+func (s *Store) GetKind(id int64) Kind {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	category, ok := s.categories[id]
+	if !ok || category.Kind == "" {
+		return ExpenseKind
+	}
+
+	return category.Kind
+}
+
+// IsLeaf reports whether the category has no subcategories.
+func (s *Store) IsLeaf(id int64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.children[id]) == 0
+}
+
+// GetSubtreeIDs returns the category and all of its descendants.
+func (s *Store) GetSubtreeIDs(id int64) []int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := []int64{id}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, s.children[ids[i]]...)
+	}
+
+	return ids
+}
+
+// IsInSubtree reports whether the category is the root category or one of its descendants.
+func (s *Store) IsInSubtree(id, rootID int64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, ancestorID := range s.path(id) {
+		if ancestorID == rootID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RollUp returns the category that amounts of the category are totalled under when drilling down into parentID:
+// the direct child of parentID on the path to the category, or parentID itself for its own transactions.
+// Without parentID amounts roll up to top-level categories. ok is false for categories outside of parentID.
+func (s *Store) RollUp(id int64, parentID *int64) (int64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	path := s.path(id)
+	if parentID == nil {
+		return path[len(path)-1], true
+	}
+
+	for i, ancestorID := range path {
+		if ancestorID != *parentID {
+			continue
+		}
+		if i == 0 {
+			return id, true
+		}
+		return path[i-1], true
+	}
+
+	return 0, false
+}
+
+// path returns the category followed by its ancestors up to the top-level category.
+// Callers must hold the lock.
+func (s *Store) path(id int64) []int64 {
+	path := []int64{id}
+	for len(path) <= len(s.categories) {
+		category, ok := s.categories[path[len(path)-1]]
+		if !ok || category.ParentID == nil {
+			break
+		}
+		path = append(path, *category.ParentID)
+	}
+
+	return path
+}
+
+// GetKeywords returns lowercased keywords in matching order: longer keywords first, so the most specific one wins.
+func (s *Store) GetKeywords() []CategoryKeyword {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.keywords
+}
+
+// MatchKeyword returns the keyword that decides the category of a text of the user: of all keywords effective
+// for the user that the text contains, ignoring case, the first in matching order.
+func (s *Store) MatchKeyword(userID int64, text string) (CategoryKeyword, bool) {
+	matcher := s.keywordMatcher(userID)
+
+	if matcher == nil {
+		return CategoryKeyword{}, false
+	}
+
+	return matcher.best(strings.ToLower(text))
+}
+
+// MatchKeywords returns every occurrence of a keyword effective for the user in the text, ignoring case, ordered
+// by position and then by matching order. Positions are byte offsets into the lowercased text.
+func (s *Store) MatchKeywords(userID int64, text string) []KeywordMatch {
+	matcher := s.keywordMatcher(userID)
+
+	if matcher == nil {
+		return nil
+	}
+
+	return matcher.matches(strings.ToLower(text))
+}
+
+func (s *Store) keywordMatcher(userID int64) *keywordMatcher {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if matcher, ok := s.userMatchers[userID]; ok {
+		return matcher
+	}
+
+	return s.matcher
+}
+
+func sortKeywords(keywords []CategoryKeyword) []CategoryKeyword {
+	sorted := make([]CategoryKeyword, len(keywords))
+	for i, keyword := range keywords {
+		keyword.Name = strings.ToLower(keyword.Name)
+		sorted[i] = keyword
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if len(sorted[i].Name) != len(sorted[j].Name) {
+			return len(sorted[i].Name) > len(sorted[j].Name)
+		}
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].CategoryID < sorted[j].CategoryID
+	})
+
+	return sorted
+}
+
+func normalizeExternalCategory(externalCategory string) string {
+	return strings.ToLower(strings.TrimSpace(externalCategory))
 }
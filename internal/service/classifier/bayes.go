@@ -0,0 +1,165 @@
+package classifier
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// features turns a transaction into the tokens the model counts: description words, merchant, amount magnitude
+// and sign, bank and weekday. Words with digits are dropped, they are mostly references and card numbers.
+func features(description string, merchant *string, amount string, bankID int64, date time.Time) []string {
+	res := make([]string, 0, 16)
+
+	words := strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if len(word) < 2 || strings.ContainsFunc(word, unicode.IsDigit) {
+			continue
+		}
+		res = append(res, wordFeaturePrefix+word)
+	}
+
+	if merchant != nil {
+		if name := strings.ToLower(strings.TrimSpace(*merchant)); name != "" {
+			res = append(res, merchantFeaturePrefix+name)
+		}
+	}
+
+	if value, err := strconv.ParseFloat(strings.TrimSpace(amount), 64); err == nil {
+		sign := "+"
+		if value < 0 {
+			sign = "-"
+		}
+		bucket := int(math.Log2(math.Abs(value) + 1))
+		res = append(res, "s:"+sign, "a:"+strconv.Itoa(bucket))
+	}
+
+	res = append(res, "b:"+strconv.FormatInt(bankID, 10))
+
+	if !date.IsZero() {
+		res = append(res, "d:"+strings.ToLower(date.Weekday().String()[:3]))
+	}
+
+	return res
+}
+
+// isTextFeature reports whether the feature is a description word or the merchant.
+func isTextFeature(feature string) bool {
+	return strings.HasPrefix(feature, wordFeaturePrefix) || strings.HasPrefix(feature, merchantFeaturePrefix)
+}
+
+func sampleFeatures(s *Sample) []string {
+	return features(s.Description, s.Merchant, s.Amount, s.BankID, s.TransactionDate)
+}
+
+// train counts features per category. Categories with fewer than minClassSamples samples are left out.
+func train(samples []Sample) *model {
+	classDocs := map[int64]int{}
+	for i := range samples {
+		classDocs[samples[i].CategoryID]++
+	}
+
+	m := &model{
+		ClassDocs:     map[int64]int{},
+		ClassFeatures: map[int64]int{},
+		FeatureCounts: map[int64]map[string]int{},
+	}
+	vocabulary := map[string]struct{}{}
+
+	for i := range samples {
+		categoryID := samples[i].CategoryID
+		if classDocs[categoryID] < minClassSamples {
+			continue
+		}
+
+		counts, ok := m.FeatureCounts[categoryID]
+		if !ok {
+			counts = map[string]int{}
+			m.FeatureCounts[categoryID] = counts
+		}
+
+		for _, feature := range sampleFeatures(&samples[i]) {
+			counts[feature]++
+			m.ClassFeatures[categoryID]++
+			vocabulary[feature] = struct{}{}
+		}
+		m.ClassDocs[categoryID]++
+		m.Docs++
+	}
+	m.Vocabulary = len(vocabulary)
+
+	return m
+}
+
+// predict returns the posterior probability of every allowed category, most likely first.
+// Features the model has never seen carry no information and are ignored. Without a known description word or
+// merchant nothing is predicted: the prior, bank, weekday and amount alone would still reach high confidence.
+func (m *model) predict(featureList []string, allowed func(categoryID int64) bool) []Prediction {
+	if m == nil || m.Docs == 0 {
+		return nil
+	}
+
+	known := make([]string, 0, len(featureList))
+	knownText := false
+	for _, feature := range featureList {
+		for _, counts := range m.FeatureCounts {
+			if _, ok := counts[feature]; ok {
+				known = append(known, feature)
+				knownText = knownText || isTextFeature(feature)
+				break
+			}
+		}
+	}
+	if !knownText {
+		return nil
+	}
+
+	predictions := make([]Prediction, 0, len(m.ClassDocs))
+	scores := make([]float64, 0, len(m.ClassDocs))
+	for categoryID, docs := range m.ClassDocs {
+		if !allowed(categoryID) {
+			continue
+		}
+
+		score := math.Log(float64(docs) / float64(m.Docs))
+		denominator := float64(m.ClassFeatures[categoryID]) + smoothing*float64(m.Vocabulary)
+		for _, feature := range known {
+			score += math.Log((float64(m.FeatureCounts[categoryID][feature]) + smoothing) / denominator)
+		}
+
+		predictions = append(predictions, Prediction{CategoryID: categoryID})
+		scores = append(scores, score)
+	}
+	if len(predictions) == 0 {
+		return nil
+	}
+
+	// Softmax over log scores, shifted by the maximum to stay within float range.
+	maxScore := scores[0]
+	for _, score := range scores {
+		maxScore = math.Max(maxScore, score)
+	}
+
+	var total float64
+	for i, score := range scores {
+		predictions[i].Confidence = math.Exp(score - maxScore)
+		total += predictions[i].Confidence
+	}
+	for i := range predictions {
+		predictions[i].Confidence /= total
+	}
+
+	sort.Slice(predictions, func(i, j int) bool {
+		if predictions[i].Confidence != predictions[j].Confidence {
+			return predictions[i].Confidence > predictions[j].Confidence
+		}
+		return predictions[i].CategoryID < predictions[j].CategoryID
+	})
+
+	return predictions
+}
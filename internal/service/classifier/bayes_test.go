@@ -0,0 +1,44 @@
+package classifier
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPredictRequiresKnownText(t *testing.T) {
+	monday := time.Date(2025, time.October, 6, 8, 0, 0, 0, time.UTC)
+	samples := make([]Sample, 0, 40)
+	for range 20 {
+		samples = append(samples,
+			Sample{CategoryID: 2, BankID: 1, Description: "Coffee shop", Amount: "-3.50", TransactionDate: monday},
+			Sample{CategoryID: 3, BankID: 2, Description: "Salary Acme", Amount: "2000.00", TransactionDate: monday.AddDate(0, 0, 4)},
+		)
+	}
+	m := train(samples)
+	all := func(int64) bool { return true }
+
+	tests := []struct {
+		name         string
+		description  string
+		wantCategory int64
+	}{
+		{name: "known description word", description: "Coffee to go", wantCategory: 2},
+		// Bank, weekday, sign and amount all point to category 2, but nothing says what was bought.
+		{name: "metadata only", description: "Zqx Vendor"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			predictions := m.predict(features(tt.description, nil, "-3.40", 1, monday), all)
+			if tt.wantCategory == 0 {
+				if len(predictions) != 0 {
+					t.Fatalf("predictions = %v, want none", predictions)
+				}
+				return
+			}
+
+			if len(predictions) == 0 || predictions[0].CategoryID != tt.wantCategory || predictions[0].Confidence < MinConfidence {
+				t.Fatalf("predictions = %v, want category %d with confidence >= %v", predictions, tt.wantCategory, MinConfidence)
+			}
+		})
+	}
+}
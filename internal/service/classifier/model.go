@@ -0,0 +1,57 @@
+package classifier
+
+import "time"
+
+const modelTable = "categorization_model"
+
+const (
+	// MinConfidence is the posterior probability a prediction needs to categorise an imported transaction.
+	MinConfidence = 0.8
+	// minClassSamples drops categories with too few transactions to learn from.
+	minClassSamples = 3
+	// trainingLimit caps training to the most recent categorised transactions.
+	trainingLimit = 50000
+	// trainInterval is how often the model is retrained from the latest history.
+	trainInterval = 24 * time.Hour
+	// trainerLock is the advisory lock held by the instance running scheduled training.
+	trainerLock = "categorization_model_trainer"
+	// defaultPredictionLimit is how many categories are predicted for a transaction by default.
+	defaultPredictionLimit = 3
+	// smoothing is the Laplace smoothing added to every feature count.
+	smoothing = 1.0
+
+	wordFeaturePrefix     = "w:"
+	merchantFeaturePrefix = "m:"
+)
+
+// Prediction is a category with the posterior probability the model assigns to it.
+type Prediction struct {
+	CategoryID int64
+	Confidence float64
+}
+
+// ModelInfo describes the model in use.
+type ModelInfo struct {
+	SampleCount   int32
+	CategoryCount int32
+	TrainedAt     time.Time
+}
+
+// Sample is a categorised transaction the model is trained on.
+type Sample struct {
+	CategoryID      int64
+	BankID          int64
+	Description     string
+	Merchant        *string
+	Amount          string
+	TransactionDate time.Time
+}
+
+// model is a multinomial naive Bayes model over transaction features.
+type model struct {
+	ClassDocs     map[int64]int            `json:"class_docs"`
+	ClassFeatures map[int64]int            `json:"class_features"`
+	FeatureCounts map[int64]map[string]int `json:"feature_counts"`
+	Vocabulary    int                      `json:"vocabulary"`
+	Docs          int                      `json:"docs"`
+}
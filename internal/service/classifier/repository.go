@@ -0,0 +1,107 @@
+package classifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+// trainingSamples returns the most recent categorised transactions. Transactions the model categorised itself are
+// left out, so it does not learn from its own mistakes.
+func (r *repository) trainingSamples(ctx context.Context, uncategorizedID int64, limit uint64) ([]Sample, error) {
+	query, args, err := squirrel.
+		Select("category_id", "bank_id", "description", "merchant", "amount::text AS amount", "transaction_date").
+		From("transaction").
+		Where(squirrel.NotEq{"category_id": uncategorizedID}).
+		Where("category_source IS DISTINCT FROM ?", transaction.ClassifierCategorySource).
+		OrderBy("transaction_date DESC", "id DESC").
+		Limit(limit).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var samples []Sample
+	if err = pgxscan.Select(ctx, r.dbPool, &samples, query, args...); err != nil {
+		return nil, err
+	}
+
+	return samples, nil
+}
+
+// saveModel stores the model as the only one kept.
+func (r *repository) saveModel(ctx context.Context, m *model, info *ModelInfo) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to marshal categorization model: %w", err)
+	}
+
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, "DELETE FROM categorization_model"); err != nil {
+		return fmt.Errorf("failed to delete previous categorization model: %w", err)
+	}
+
+	query, args, err := squirrel.
+		Insert(modelTable).
+		Columns("model", "sample_count", "category_count", "trained_at").
+		Values(string(data), info.SampleCount, info.CategoryCount, info.TrainedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *repository) latestModel(ctx context.Context) (*model, *ModelInfo, error) {
+	query, args, err := squirrel.
+		Select("model", "sample_count", "category_count", "trained_at").
+		From(modelTable).
+		OrderBy("trained_at DESC").
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var data []byte
+	var info ModelInfo
+	err = r.dbPool.QueryRow(ctx, query, args...).Scan(&data, &info.SampleCount, &info.CategoryCount, &info.TrainedAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var m model
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal categorization model: %w", err)
+	}
+
+	return &m, &info, nil
+}
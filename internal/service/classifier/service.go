@@ -0,0 +1,184 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	repo               *repository
+	store              *Store
+	categoryService    *category.Service
+	transactionService *transaction.Service
+}
+
+func NewService(dbPool *pgxpool.Pool, categoryService *category.Service, transactionService *transaction.Service) *Service {
+	return &Service{
+		repo:               newRepository(dbPool),
+		store:              NewStore(),
+		categoryService:    categoryService,
+		transactionService: transactionService,
+	}
+}
+
+// Initialize loads the last trained model. Without one transactions are left to rules and keywords until training.
+func (s *Service) Initialize(ctx context.Context) error {
+	if err := s.Reload(ctx); err != nil {
+		logger.Error("failed to load categorization model", err)
+		return fmt.Errorf("service initialization failed: %w", err)
+	}
+
+	return nil
+}
+
+// Reload puts the last stored model in use, such as one trained by another instance; a failed reload leaves the
+// previous model in use.
+func (s *Service) Reload(ctx context.Context) error {
+	m, info, err := s.repo.latestModel(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.store.reload(m, info)
+
+	return nil
+}
+
+func (s *Service) Store() *Store {
+	return s.store
+}
+
+// Train fits a new model on the categorised history and puts it in use.
+func (s *Service) Train(ctx context.Context) (*ModelInfo, error) {
+	samples, err := s.repo.trainingSamples(ctx, category.UncategorizedID, trainingLimit)
+	if err != nil {
+		logger.Error("failed to get categorization training samples", err)
+		return nil, psql.MapPostgresError("failed to train categorization model", err)
+	}
+
+	m := train(samples)
+	if len(m.ClassDocs) < 2 {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"not enough categorised transactions to train: at least two categories with %d transactions are required", minClassSamples)
+	}
+
+	info := &ModelInfo{
+		SampleCount:   int32(m.Docs),
+		CategoryCount: int32(len(m.ClassDocs)),
+		TrainedAt:     time.Now().UTC(),
+	}
+
+	if err = s.repo.saveModel(ctx, m, info); err != nil {
+		logger.Error("failed to save categorization model", err)
+		return nil, psql.MapPostgresError("failed to train categorization model", err)
+	}
+
+	s.store.reload(m, info)
+
+	logger.Info("categorization model trained", "samples", strconv.Itoa(m.Docs))
+
+	return info, nil
+}
+
+// RunTrainer retrains the model from the latest history until the context is cancelled.
+func (s *Service) RunTrainer(ctx context.Context) {
+	ticker := time.NewTicker(trainInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.scheduledTrain(ctx)
+		}
+	}
+}
+
+// scheduledTrain trains the model unless another instance is training it or trained it since the last half interval.
+func (s *Service) scheduledTrain(ctx context.Context) {
+	unlock, locked, err := psql.TryAdvisoryLock(ctx, s.repo.dbPool, trainerLock)
+	if err != nil {
+		logger.Error("failed to lock categorization model training", err)
+		return
+	}
+	if !locked {
+		return
+	}
+	defer unlock()
+
+	if info := s.store.GetInfo(); info != nil && time.Since(info.TrainedAt) < trainInterval/2 {
+		return
+	}
+
+	if _, err = s.Train(ctx); err != nil {
+		logger.Error("scheduled categorization model training failed", err)
+	}
+}
+
+// Predict returns up to limit categories for the transaction with their confidence, most likely first.
+// Only active leaf categories are predicted.
+func (s *Service) Predict(tr *transaction.Transaction, limit int) []Prediction {
+	store := s.categoryService.Store()
+	allowed := func(categoryID int64) bool {
+		c := store.GetCategory(categoryID)
+		return c != nil && !c.Archived && categoryID != category.UncategorizedID && store.IsLeaf(categoryID) &&
+			store.IsVisible(categoryID, tr.UserID)
+	}
+
+	predictions := s.store.getModel().predict(features(tr.Description, tr.Merchant, tr.Amount, tr.BankID, tr.TransactionDate), allowed)
+	if limit > 0 && len(predictions) > limit {
+		predictions = predictions[:limit]
+	}
+
+	return predictions
+}
+
+// Classify returns the most likely category when the model is at least MinConfidence sure of it.
+func (s *Service) Classify(tr *transaction.Transaction) *Prediction {
+	predictions := s.Predict(tr, 1)
+	if len(predictions) == 0 || predictions[0].Confidence < MinConfidence {
+		return nil
+	}
+
+	return &predictions[0]
+}
+
+func (s *Service) PredictTransaction(ctx context.Context, id int64, limit int) ([]Prediction, error) {
+	if s.store.GetInfo() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "categorization model is not trained yet")
+	}
+	if limit <= 0 {
+		limit = defaultPredictionLimit
+	}
+
+	tr, err := s.transactionService.GetTransaction(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Predict(&transaction.Transaction{
+		UserID:          tr.UserID,
+		AccountID:       tr.AccountID,
+		BankID:          tr.BankID,
+		Description:     tr.Description,
+		Merchant:        tr.Merchant,
+		Amount:          tr.Amount,
+		TransactionDate: tr.TransactionDate,
+	}, limit), nil
+}
@@ -0,0 +1,37 @@
+package classifier
+
+import "sync"
+
+// Store keeps the model in use.
+type Store struct {
+	mu    sync.RWMutex
+	model *model
+	info  *ModelInfo
+}
+
+func NewStore() *Store {
+	return &Store{}
+}
+
+func (s *Store) reload(m *model, info *ModelInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.model = m
+	s.info = info
+}
+
+func (s *Store) getModel() *model {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.model
+}
+
+// GetInfo returns nil until a model is trained.
+func (s *Store) GetInfo() *ModelInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.info
+}
@@ -0,0 +1,92 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const (
+	outboxTable      = "event_outbox"
+	subscriberTable  = "event_subscriber"
+	consumptionTable = "event_consumption"
+	// dispatchLockPrefix followed by the subscriber name is the advisory lock held while dispatching to it.
+	dispatchLockPrefix = "event_dispatch:"
+)
+
+const (
+	// maxAttempts is the number of times a subscriber is given an event before it is dead-lettered.
+	maxAttempts = 10
+	// baseBackoff doubles after every failed attempt up to maxBackoff.
+	baseBackoff = 10 * time.Second
+	maxBackoff  = time.Hour
+
+	pollInterval  = time.Second
+	dispatchBatch = 100
+	// retention is how long events are kept in the outbox after they were written.
+	retention       = 7 * 24 * time.Hour
+	cleanupInterval = time.Hour
+)
+
+type Type string
+
+const (
+	TransactionsCreatedType      Type = "transaction.created"
+	TransactionUpdatedType       Type = "transaction.updated"
+	TransactionRecategorizedType Type = "transaction.recategorized"
+	ImportCompletedType          Type = "import.completed"
+	ImportFailedType             Type = "import.failed"
+)
+
+type ConsumptionStatus string
+
+const (
+	PendingConsumptionStatus ConsumptionStatus = "PENDING"
+	DoneConsumptionStatus    ConsumptionStatus = "DONE"
+	DeadConsumptionStatus    ConsumptionStatus = "DEAD"
+)
+
+// Event is a domain event read from the outbox. Payload is the JSON encoded event data.
+type Event struct {
+	ID        int64
+	Type      Type
+	Payload   json.RawMessage
+	CreatedAt time.Time
+	// Attempts is the number of earlier failed attempts of the subscriber the event is dispatched to.
+	Attempts int32 `db:"attempts"`
+}
+
+// Handler consumes an event. Returning an error retries the event later with backoff,
+// so handlers must tolerate seeing the same event more than once.
+type Handler func(ctx context.Context, event *Event) error
+
+type subscriber struct {
+	name    string
+	types   []string
+	handler Handler
+}
+
+// ImportCompleted is the payload of import.completed events.
+type ImportCompleted struct {
+	Source      ImportSource `json:"source"`
+	BankID      int64        `json:"bank_id"`
+	UserID      int64        `json:"user_id"`
+	AccountID   *int64       `json:"account_id,omitempty"`
+	RecordCount int          `json:"record_count"`
+	ErrorCount  int          `json:"error_count"`
+}
+
+// ImportFailed is the payload of import.failed events.
+type ImportFailed struct {
+	Source ImportSource `json:"source"`
+	BankID int64        `json:"bank_id"`
+	UserID int64        `json:"user_id"`
+	Reason string       `json:"reason"`
+}
+
+type ImportSource string
+
+const (
+	CSVImportSource   ImportSource = "CSV"
+	MonzoImportSource ImportSource = "MONZO"
+)
@@ -0,0 +1,122 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// execer is satisfied by both the pool and pgx.Tx, so events can be written inside the caller's transaction.
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) insertEvent(ctx context.Context, db execer, eventType Type, payload []byte) error {
+	query, args, err := squirrel.
+		Insert(outboxTable).
+		Columns("type", "payload").
+		Values(eventType, string(payload)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = db.Exec(ctx, query, args...)
+	return err
+}
+
+// registerSubscriber records when a subscriber first started, it only receives events written after that.
+func (r *repository) registerSubscriber(ctx context.Context, name string) error {
+	query, args, err := squirrel.
+		Insert(subscriberTable).
+		Columns("name").
+		Values(name).
+		Suffix("ON CONFLICT (name) DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = r.dbPool.Exec(ctx, query, args...)
+	return err
+}
+
+// dueEvents returns events of the given types the subscriber has not consumed yet or has to retry now, oldest first.
+func (r *repository) dueEvents(ctx context.Context, name string, types []string, limit uint64) ([]Event, error) {
+	query, args, err := squirrel.
+		Select("e.id", "e.type", "e.payload", "e.created_at", "COALESCE(c.attempts, 0) AS attempts").
+		From(outboxTable+" e").
+		Join(subscriberTable+" s ON s.name = ?", name).
+		LeftJoin(consumptionTable+" c ON c.event_id = e.id AND c.subscriber = s.name").
+		Where("e.created_at >= s.created_at").
+		Where("e.type = ANY(?)", types).
+		Where(squirrel.Or{
+			squirrel.Expr("c.event_id IS NULL"),
+			squirrel.And{
+				squirrel.Eq{"c.status": PendingConsumptionStatus},
+				squirrel.Expr("c.next_attempt_at <= CURRENT_TIMESTAMP"),
+			},
+		}).
+		OrderBy("e.id").
+		Limit(limit).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var events []Event
+	if err = pgxscan.Select(ctx, r.dbPool, &events, query, args...); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *repository) saveConsumption(ctx context.Context, eventID int64, name string, status ConsumptionStatus, attempts int32, nextAttemptAt time.Time, lastError *string) error {
+	query, args, err := squirrel.
+		Insert(consumptionTable).
+		Columns("event_id", "subscriber", "status", "attempts", "next_attempt_at", "last_error").
+		Values(eventID, name, status, attempts, nextAttemptAt, lastError).
+		Suffix(`ON CONFLICT (event_id, subscriber) DO UPDATE SET
+			status = EXCLUDED.status,
+			attempts = EXCLUDED.attempts,
+			next_attempt_at = EXCLUDED.next_attempt_at,
+			last_error = EXCLUDED.last_error,
+			updated_at = CURRENT_TIMESTAMP`).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = r.dbPool.Exec(ctx, query, args...)
+	return err
+}
+
+// deleteExpiredEvents removes events written before the given time together with their consumption records.
+func (r *repository) deleteExpiredEvents(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.dbPool.Exec(ctx, "DELETE FROM event_outbox WHERE created_at < $1", before)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,206 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/retry"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Service is the internal event bus. Events are written to the outbox table, in the same database
+// transaction as the data change when there is one, and dispatched to in-process subscribers by Run.
+// Every subscriber keeps its own consumption state, so events survive restarts and a failing
+// subscriber neither blocks nor repeats work for the others.
+type Service struct {
+	repo          *repository
+	subscribersMu sync.RWMutex
+	subscribers   []*subscriber
+	wake          chan struct{}
+}
+
+func NewService(dbPool *pgxpool.Pool) *Service {
+	return &Service{
+		repo: newRepository(dbPool),
+		wake: make(chan struct{}, 1),
+	}
+}
+
+// Subscribe registers a handler for the given event types. The name identifies the subscriber's
+// consumption state across restarts, so it must be stable and unique.
+func (s *Service) Subscribe(name string, handler Handler, types ...Type) {
+	eventTypes := make([]string, len(types))
+	for i, eventType := range types {
+		eventTypes[i] = string(eventType)
+	}
+
+	s.subscribersMu.Lock()
+	defer s.subscribersMu.Unlock()
+
+	s.subscribers = append(s.subscribers, &subscriber{
+		name:    name,
+		types:   eventTypes,
+		handler: handler,
+	})
+}
+
+// Append writes an event inside the caller's transaction, so it is only visible once the data change commits.
+// Call Notify after the commit to dispatch it without waiting for the next poll.
+func (s *Service) Append(ctx context.Context, tx pgx.Tx, eventType Type, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+
+	if err = s.repo.insertEvent(ctx, tx, eventType, payload); err != nil {
+		return fmt.Errorf("failed to write %s event: %w", eventType, err)
+	}
+
+	return nil
+}
+
+// Publish writes an event that is not tied to a data change, such as an import outcome.
+// Failures are logged and do not affect the caller.
+func (s *Service) Publish(ctx context.Context, eventType Type, data any) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		logger.ErrorWithFields("failed to marshal event", err, "event_type", eventType)
+		return
+	}
+
+	if err = s.repo.insertEvent(ctx, s.repo.dbPool, eventType, payload); err != nil {
+		logger.ErrorWithFields("failed to write event", err, "event_type", eventType)
+		return
+	}
+
+	s.Notify()
+}
+
+// Notify wakes the dispatcher.
+func (s *Service) Notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run dispatches outbox events to subscribers until the context is cancelled.
+// Subscribers must be registered before Run is called.
+func (s *Service) Run(ctx context.Context) {
+	s.subscribersMu.RLock()
+	subscribers := s.subscribers
+	s.subscribersMu.RUnlock()
+
+	for _, sub := range subscribers {
+		if err := s.repo.registerSubscriber(ctx, sub.name); err != nil {
+			logger.ErrorWithFields("failed to register event subscriber", err, "subscriber", sub.name)
+		}
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var lastCleanup time.Time
+	for {
+		for _, sub := range subscribers {
+			s.dispatch(ctx, sub)
+		}
+
+		if time.Since(lastCleanup) >= cleanupInterval {
+			s.cleanup(ctx)
+			lastCleanup = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.wake:
+		}
+	}
+}
+
+// dispatch handles the due events of a subscriber. With several instances of the service running, the subscriber's
+// lock makes sure only one of them handles its events at a time.
+func (s *Service) dispatch(ctx context.Context, sub *subscriber) {
+	unlock, locked, err := psql.TryAdvisoryLock(ctx, s.repo.dbPool, dispatchLockPrefix+sub.name)
+	if err != nil {
+		logger.ErrorWithFields("failed to lock event subscriber", err, "subscriber", sub.name)
+		return
+	}
+	if !locked {
+		return
+	}
+	defer unlock()
+
+	events, err := s.repo.dueEvents(ctx, sub.name, sub.types, dispatchBatch)
+	if err != nil {
+		logger.ErrorWithFields("failed to get due events", err, "subscriber", sub.name)
+		return
+	}
+
+	for i := range events {
+		e := &events[i]
+
+		status, attempts, nextAttemptAt, lastError := s.handle(ctx, sub, e)
+		if status == DeadConsumptionStatus {
+			logger.ErrorWithFields("event dead-lettered", nil, "subscriber", sub.name, "event_id", e.ID, "event_type", e.Type)
+		}
+
+		err = s.repo.saveConsumption(ctx, e.ID, sub.name, status, attempts, nextAttemptAt, lastError)
+		if err != nil {
+			// The event stays due and is handled again on a later poll.
+			logger.ErrorWithFields("failed to save event consumption", err, "subscriber", sub.name, "event_id", e.ID)
+		}
+	}
+}
+
+// handle runs the subscriber handler and returns the resulting consumption state of the event.
+func (s *Service) handle(ctx context.Context, sub *subscriber, e *Event) (ConsumptionStatus, int32, time.Time, *string) {
+	now := time.Now().UTC()
+
+	err := s.call(ctx, sub, e)
+	if err == nil {
+		return DoneConsumptionStatus, e.Attempts + 1, now, nil
+	}
+
+	attempts := e.Attempts + 1
+	msg := err.Error()
+	logger.ErrorWithFields("event handler failed", err, "subscriber", sub.name, "event_id", e.ID, "attempts", attempts)
+
+	if attempts >= maxAttempts {
+		return DeadConsumptionStatus, attempts, now, &msg
+	}
+
+	return PendingConsumptionStatus, attempts, now.Add(retry.Backoff(attempts, baseBackoff, maxBackoff)), &msg
+}
+
+// call runs the handler, turning a panic into an error so one bad event cannot stop the dispatcher.
+func (s *Service) call(ctx context.Context, sub *subscriber, e *Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+	}()
+
+	return sub.handler(ctx, e)
+}
+
+func (s *Service) cleanup(ctx context.Context) {
+	deleted, err := s.repo.deleteExpiredEvents(ctx, time.Now().UTC().Add(-retention))
+	if err != nil {
+		logger.Error("failed to delete expired events", err)
+		return
+	}
+
+	if deleted > 0 {
+		logger.Info("expired events deleted", "count", strconv.FormatInt(deleted, 10))
+	}
+}
@@ -0,0 +1,56 @@
+package goal
+
+import "time"
+
+const savingsGoalTable = "savings_goal"
+
+type GoalStatus string
+
+const (
+	AchievedGoalStatus GoalStatus = "ACHIEVED"
+	OnTrackGoalStatus  GoalStatus = "ON_TRACK"
+	BehindGoalStatus   GoalStatus = "BEHIND"
+	OverdueGoalStatus  GoalStatus = "OVERDUE"
+)
+
+// SavingsGoal tracks progress either through the balance of a linked account
+// or through transactions in a linked category, e.g. transfers to savings.
+type SavingsGoal struct {
+	ID           int64
+	Name         string
+	TargetAmount string
+	TargetDate   time.Time
+	StartDate    time.Time
+	AccountID    *int64
+	CategoryID   *int64
+	OwnerUserID  *int64
+	CreatedAt    time.Time
+	UpdatedAt    *time.Time
+}
+
+type SavingsGoalUpdateData struct {
+	ID           int64
+	Name         *string
+	TargetAmount *string
+	TargetDate   *time.Time
+	AccountID    *int64
+	CategoryID   *int64
+	OwnerUserID  *int64
+}
+
+type SavingsGoalFilter struct {
+	UserID *int64
+}
+
+type Progress struct {
+	Goal            SavingsGoal
+	SavedAmount     float64
+	RemainingAmount float64
+	PercentComplete float64
+	MonthsRemaining int32
+	// RequiredMonthly is what has to be put aside each month from now on to reach the target by the target date.
+	RequiredMonthly float64
+	AverageMonthly  float64
+	ProjectedAmount float64
+	Status          GoalStatus
+}
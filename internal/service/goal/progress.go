@@ -0,0 +1,61 @@
+package goal
+
+import (
+	"math"
+	"time"
+)
+
+const averageDaysInMonth = 365.25 / 12
+
+// monthsBetween returns the number of months from from to to, rounded up; zero if to is not after from.
+func monthsBetween(from, to time.Time) int32 {
+	if !to.After(from) {
+		return 0
+	}
+
+	return int32(math.Ceil(to.Sub(from).Hours() / 24 / averageDaysInMonth))
+}
+
+// buildProgress projects the saving pace since the goal start onto the remaining months.
+func buildProgress(goal *SavingsGoal, target, saved, contributed float64, now time.Time) *Progress {
+	progress := &Progress{
+		Goal:        *goal,
+		SavedAmount: round2(saved),
+	}
+
+	remaining := math.Max(target-saved, 0)
+	progress.RemainingAmount = round2(remaining)
+	if target > 0 {
+		progress.PercentComplete = round2(math.Min(saved/target, 1) * 100)
+	}
+
+	elapsed := monthsBetween(goal.StartDate, now)
+	if elapsed == 0 {
+		elapsed = 1
+	}
+	average := contributed / float64(elapsed)
+	progress.AverageMonthly = round2(average)
+
+	progress.MonthsRemaining = monthsBetween(now, goal.TargetDate)
+	progress.ProjectedAmount = round2(saved + math.Max(average, 0)*float64(progress.MonthsRemaining))
+
+	switch {
+	case remaining == 0:
+		progress.Status = AchievedGoalStatus
+	case progress.MonthsRemaining == 0:
+		progress.Status = OverdueGoalStatus
+		progress.RequiredMonthly = round2(remaining)
+	default:
+		progress.RequiredMonthly = round2(remaining / float64(progress.MonthsRemaining))
+		progress.Status = BehindGoalStatus
+		if progress.ProjectedAmount >= target {
+			progress.Status = OnTrackGoalStatus
+		}
+	}
+
+	return progress
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
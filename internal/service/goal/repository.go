@@ -0,0 +1,169 @@
+package goal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var savingsGoalColumns = []string{
+	"id", "name", "target_amount", "target_date", "start_date", "account_id", "category_id", "owner_user_id", "created_at", "updated_at",
+}
+
+const savingsGoalReturning = "RETURNING id, name, target_amount, target_date, start_date, account_id, category_id, owner_user_id, created_at, updated_at"
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) getGoal(ctx context.Context, id int64) (*SavingsGoal, error) {
+	query, args, err := squirrel.
+		Select(savingsGoalColumns...).
+		From(savingsGoalTable).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var goal SavingsGoal
+	if err = pgxscan.Get(ctx, r.dbPool, &goal, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &goal, nil
+}
+
+func (r *repository) goalList(ctx context.Context, filter *SavingsGoalFilter) ([]SavingsGoal, error) {
+	queryBuilder := squirrel.
+		Select(savingsGoalColumns...).
+		From(savingsGoalTable).
+		OrderBy("target_date", "name").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.UserID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"owner_user_id": *filter.UserID})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var goals []SavingsGoal
+	if err = pgxscan.Select(ctx, r.dbPool, &goals, query, args...); err != nil {
+		return nil, err
+	}
+
+	return goals, nil
+}
+
+func (r *repository) createGoal(ctx context.Context, goal *SavingsGoal) (*SavingsGoal, error) {
+	query, args, err := squirrel.
+		Insert(savingsGoalTable).
+		Columns("name", "target_amount", "target_date", "start_date", "account_id", "category_id", "owner_user_id").
+		Values(goal.Name, goal.TargetAmount, goal.TargetDate, goal.StartDate, goal.AccountID, goal.CategoryID, goal.OwnerUserID).
+		Suffix(savingsGoalReturning).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var created SavingsGoal
+	if err = pgxscan.Get(ctx, r.dbPool, &created, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *repository) updateGoal(ctx context.Context, goal *SavingsGoal) (*SavingsGoal, error) {
+	query, args, err := squirrel.
+		Update(savingsGoalTable).
+		Set("name", goal.Name).
+		Set("target_amount", goal.TargetAmount).
+		Set("target_date", goal.TargetDate).
+		Set("account_id", goal.AccountID).
+		Set("category_id", goal.CategoryID).
+		Set("owner_user_id", goal.OwnerUserID).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": goal.ID}).
+		Suffix(savingsGoalReturning).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var updated SavingsGoal
+	if err = pgxscan.Get(ctx, r.dbPool, &updated, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (r *repository) deleteGoal(ctx context.Context, id int64) error {
+	tag, err := r.dbPool.Exec(ctx, "DELETE FROM savings_goal WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// contributions sums transactions linked to the goal in [from, to]; categoryIDs are the goal category and its subcategories.
+// Category contributions are counted by absolute amount since banks disagree on the sign of transfers;
+// account movements are signed by transaction type as in balance reconciliation.
+func (r *repository) contributions(ctx context.Context, goal *SavingsGoal, categoryIDs []int64, from, to time.Time) (float64, error) {
+	amountExpr := `COALESCE(SUM(CASE
+			WHEN type = 'OUTCOME' THEN -ABS(amount)
+			WHEN type = 'INCOME' THEN ABS(amount)
+			ELSE amount
+		END), 0)::float8`
+	if goal.CategoryID != nil {
+		amountExpr = "COALESCE(SUM(ABS(amount)), 0)::float8"
+	}
+
+	queryBuilder := squirrel.
+		Select(amountExpr).
+		From("transaction").
+		Where(squirrel.GtOrEq{"transaction_date": from}).
+		Where(squirrel.LtOrEq{"transaction_date": to}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if goal.AccountID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"account_id": *goal.AccountID})
+	}
+	if goal.CategoryID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"category_id": categoryIDs})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var amount float64
+	if err = r.dbPool.QueryRow(ctx, query, args...).Scan(&amount); err != nil {
+		return 0, fmt.Errorf("failed to select goal contributions: %w", err)
+	}
+
+	return amount, nil
+}
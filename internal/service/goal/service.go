@@ -0,0 +1,244 @@
+package goal
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	repo            *repository
+	accountService  *account.Service
+	categoryService *category.Service
+	balanceService  *balance.Service
+}
+
+func NewService(
+	dbPool *pgxpool.Pool,
+	accountService *account.Service,
+	categoryService *category.Service,
+	balanceService *balance.Service,
+) *Service {
+	return &Service{
+		repo:            newRepository(dbPool),
+		accountService:  accountService,
+		categoryService: categoryService,
+		balanceService:  balanceService,
+	}
+}
+
+func (s *Service) CreateGoal(ctx context.Context, goal *SavingsGoal) (*SavingsGoal, error) {
+	goal.Name = strings.TrimSpace(goal.Name)
+	if goal.StartDate.IsZero() {
+		goal.StartDate = time.Now().UTC()
+	}
+
+	if err := s.validateGoal(ctx, goal); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.createGoal(ctx, goal)
+	if err != nil {
+		logger.ErrorWithFields("failed to create savings goal", err, "name", goal.Name)
+		return nil, psql.MapPostgresError("failed to create savings goal", err)
+	}
+
+	return created, nil
+}
+
+func (s *Service) UpdateGoal(ctx context.Context, data *SavingsGoalUpdateData) (*SavingsGoal, error) {
+	goal, err := s.GetGoal(ctx, data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Name != nil {
+		goal.Name = strings.TrimSpace(*data.Name)
+	}
+	if data.TargetAmount != nil {
+		goal.TargetAmount = *data.TargetAmount
+	}
+	if data.TargetDate != nil {
+		goal.TargetDate = *data.TargetDate
+	}
+	// Zero unlinks the account, category or owner.
+	if data.AccountID != nil {
+		goal.AccountID = nilIfZero(data.AccountID)
+	}
+	if data.CategoryID != nil {
+		goal.CategoryID = nilIfZero(data.CategoryID)
+	}
+	if data.OwnerUserID != nil {
+		goal.OwnerUserID = nilIfZero(data.OwnerUserID)
+	}
+
+	if err = s.validateGoal(ctx, goal); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.updateGoal(ctx, goal)
+	if err != nil {
+		logger.ErrorWithFields("failed to update savings goal", err, "goal_id", data.ID)
+		return nil, psql.MapPostgresError("failed to update savings goal", err)
+	}
+
+	return updated, nil
+}
+
+func (s *Service) GetGoal(ctx context.Context, id int64) (*SavingsGoal, error) {
+	goal, err := s.repo.getGoal(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to get savings goal", err, "goal_id", id)
+		return nil, psql.MapPostgresError("failed to get savings goal", err)
+	}
+
+	return goal, nil
+}
+
+func (s *Service) DeleteGoal(ctx context.Context, id int64) error {
+	err := s.repo.deleteGoal(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to delete savings goal", err, "goal_id", id)
+		return psql.MapPostgresError("failed to delete savings goal", err)
+	}
+
+	return nil
+}
+
+func (s *Service) GoalList(ctx context.Context, filter *SavingsGoalFilter) ([]SavingsGoal, error) {
+	goals, err := s.repo.goalList(ctx, filter)
+	if err != nil {
+		logger.Error("failed to get savings goals", err)
+		return nil, psql.MapPostgresError("failed to get savings goals", err)
+	}
+
+	return goals, nil
+}
+
+// GetGoalStatus computes progress from the latest balance snapshot of the linked account,
+// or from transactions when the goal is linked to a category or the account has no snapshots.
+func (s *Service) GetGoalStatus(ctx context.Context, id int64) (*Progress, error) {
+	goal, err := s.GetGoal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := strconv.ParseFloat(goal.TargetAmount, 64)
+	if err != nil {
+		logger.ErrorWithFields("failed to parse goal target amount", err, "goal_id", id, "target_amount", goal.TargetAmount)
+		return nil, status.Errorf(codes.Internal, "failed to get savings goal status")
+	}
+
+	now := time.Now().UTC()
+
+	if goal.AccountID != nil && goal.CategoryID == nil {
+		saved, contributed, ok, err := s.accountProgress(ctx, goal, now)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return buildProgress(goal, target, saved, contributed, now), nil
+		}
+	}
+
+	var categoryIDs []int64
+	if goal.CategoryID != nil {
+		categoryIDs = s.categoryService.Store().GetSubtreeIDs(*goal.CategoryID)
+	}
+
+	contributed, err := s.repo.contributions(ctx, goal, categoryIDs, goal.StartDate, now)
+	if err != nil {
+		logger.ErrorWithFields("failed to get goal contributions", err, "goal_id", id)
+		return nil, psql.MapPostgresError("failed to get savings goal status", err)
+	}
+
+	return buildProgress(goal, target, contributed, contributed, now), nil
+}
+
+// accountProgress returns the latest account balance and its change since the goal start.
+// Without a snapshot at the start date the earliest known balance is used as the starting point.
+func (s *Service) accountProgress(ctx context.Context, goal *SavingsGoal, now time.Time) (float64, float64, bool, error) {
+	snapshots, err := s.balanceService.AccountSnapshots(ctx, []int64{*goal.AccountID}, now)
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	accountSnapshots := snapshots[*goal.AccountID]
+	if len(accountSnapshots) == 0 {
+		return 0, 0, false, nil
+	}
+
+	start := accountSnapshots[0]
+	for _, snapshot := range accountSnapshots {
+		if snapshot.BalanceDate.After(goal.StartDate) {
+			break
+		}
+		start = snapshot
+	}
+	latest := accountSnapshots[len(accountSnapshots)-1]
+
+	startBalance, err := strconv.ParseFloat(start.Balance, 64)
+	if err != nil {
+		logger.ErrorWithFields("failed to parse balance snapshot", err, "snapshot_id", start.ID, "balance", start.Balance)
+		return 0, 0, false, status.Errorf(codes.Internal, "failed to get savings goal status")
+	}
+	saved, err := strconv.ParseFloat(latest.Balance, 64)
+	if err != nil {
+		logger.ErrorWithFields("failed to parse balance snapshot", err, "snapshot_id", latest.ID, "balance", latest.Balance)
+		return 0, 0, false, status.Errorf(codes.Internal, "failed to get savings goal status")
+	}
+
+	return saved, saved - startBalance, true, nil
+}
+
+func (s *Service) validateGoal(ctx context.Context, goal *SavingsGoal) error {
+	if goal.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "invalid savings goal: name is required")
+	}
+
+	target, err := strconv.ParseFloat(goal.TargetAmount, 64)
+	if err != nil || target <= 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid savings goal: target amount %q", goal.TargetAmount)
+	}
+
+	if goal.TargetDate.IsZero() {
+		return status.Errorf(codes.InvalidArgument, "invalid savings goal: target date is required")
+	}
+	if !goal.TargetDate.After(goal.StartDate) {
+		return status.Errorf(codes.InvalidArgument, "invalid savings goal: target date must be after start date")
+	}
+
+	if goal.AccountID == nil && goal.CategoryID == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid savings goal: account or category is required")
+	}
+	if goal.AccountID != nil {
+		if _, err = s.accountService.GetAccount(ctx, *goal.AccountID); err != nil {
+			return err
+		}
+	}
+	if goal.CategoryID != nil {
+		if _, err = s.categoryService.GetCategoryByID(ctx, *goal.CategoryID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func nilIfZero(id *int64) *int64 {
+	if *id == 0 {
+		return nil
+	}
+
+	return id
+}
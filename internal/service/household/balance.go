@@ -0,0 +1,117 @@
+package household
+
+import "sort"
+
+type userPair struct {
+	low  int64
+	high int64
+}
+
+// pairBalances nets share debts and settlements per user pair.
+// A positive value means pair.low owes pair.high.
+func pairBalances(debts []shareDebt, settlements []Settlement) (map[userPair]int64, error) {
+	balances := map[userPair]int64{}
+	addDebt := func(debtor, creditor, cents int64) {
+		if debtor < creditor {
+			balances[userPair{low: debtor, high: creditor}] += cents
+			return
+		}
+		balances[userPair{low: creditor, high: debtor}] -= cents
+	}
+
+	for _, debt := range debts {
+		cents, err := parseCents(debt.Amount)
+		if err != nil {
+			return nil, err
+		}
+		addDebt(debt.UserID, debt.PayerUserID, cents)
+	}
+
+	for _, settlement := range settlements {
+		cents, err := parseCents(settlement.Amount)
+		if err != nil {
+			return nil, err
+		}
+		addDebt(settlement.ToUserID, settlement.FromUserID, cents)
+	}
+
+	return balances, nil
+}
+
+func toPairBalances(balances map[userPair]int64) []PairBalance {
+	res := make([]PairBalance, 0, len(balances))
+	for pair, cents := range balances {
+		switch {
+		case cents > 0:
+			res = append(res, PairBalance{DebtorUserID: pair.low, CreditorUserID: pair.high, Amount: formatCents(cents)})
+		case cents < 0:
+			res = append(res, PairBalance{DebtorUserID: pair.high, CreditorUserID: pair.low, Amount: formatCents(-cents)})
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].DebtorUserID != res[j].DebtorUserID {
+			return res[i].DebtorUserID < res[j].DebtorUserID
+		}
+		return res[i].CreditorUserID < res[j].CreditorUserID
+	})
+
+	return res
+}
+
+type netPosition struct {
+	userID int64
+	cents  int64
+}
+
+// settleUp greedily matches the largest debtor with the largest creditor,
+// which needs at most n-1 payments for n users.
+func settleUp(balances map[userPair]int64) []SettlementSuggestion {
+	net := map[int64]int64{}
+	for pair, cents := range balances {
+		net[pair.low] -= cents
+		net[pair.high] += cents
+	}
+
+	var debtors, creditors []netPosition
+	for userID, cents := range net {
+		switch {
+		case cents < 0:
+			debtors = append(debtors, netPosition{userID: userID, cents: -cents})
+		case cents > 0:
+			creditors = append(creditors, netPosition{userID: userID, cents: cents})
+		}
+	}
+
+	byAmount := func(positions []netPosition) {
+		sort.Slice(positions, func(i, j int) bool {
+			if positions[i].cents != positions[j].cents {
+				return positions[i].cents > positions[j].cents
+			}
+			return positions[i].userID < positions[j].userID
+		})
+	}
+	byAmount(debtors)
+	byAmount(creditors)
+
+	suggestions := make([]SettlementSuggestion, 0)
+	for i, j := 0, 0; i < len(debtors) && j < len(creditors); {
+		cents := min(debtors[i].cents, creditors[j].cents)
+		suggestions = append(suggestions, SettlementSuggestion{
+			FromUserID: debtors[i].userID,
+			ToUserID:   creditors[j].userID,
+			Amount:     formatCents(cents),
+		})
+
+		debtors[i].cents -= cents
+		creditors[j].cents -= cents
+		if debtors[i].cents == 0 {
+			i++
+		}
+		if creditors[j].cents == 0 {
+			j++
+		}
+	}
+
+	return suggestions
+}
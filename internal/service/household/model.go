@@ -0,0 +1,86 @@
+package household
+
+import "time"
+
+const (
+	sharedExpenseTable      = "shared_expense"
+	sharedExpenseShareTable = "shared_expense_share"
+	settlementTable         = "settlement"
+)
+
+type SplitMethod string
+
+const (
+	EqualSplitMethod      SplitMethod = "EQUAL"
+	PercentageSplitMethod SplitMethod = "PERCENTAGE"
+	ExactSplitMethod      SplitMethod = "EXACT"
+)
+
+type SharedExpense struct {
+	ID            int64
+	TransactionID int64
+	PayerUserID   int64
+	TotalAmount   string
+	SplitMethod   SplitMethod
+	CreatedAt     time.Time
+	Shares        []ExpenseShare `db:"-"`
+}
+
+type ExpenseShare struct {
+	SharedExpenseID int64
+	UserID          int64
+	Amount          string
+	Percentage      *float64
+}
+
+type ShareInput struct {
+	UserID     int64
+	Percentage *float64
+	Amount     *string
+}
+
+type SharedExpenseData struct {
+	TransactionID int64
+	PayerUserID   *int64
+	SplitMethod   SplitMethod
+	Shares        []ShareInput
+}
+
+type Settlement struct {
+	ID            int64
+	FromUserID    int64
+	ToUserID      int64
+	Amount        string
+	TransactionID *int64
+	Note          *string
+	SettledAt     time.Time
+	CreatedAt     time.Time
+}
+
+type SettlementData struct {
+	FromUserID    int64
+	ToUserID      int64
+	Amount        *string
+	TransactionID *int64
+	Note          *string
+	SettledAt     time.Time
+}
+
+// PairBalance is the net debt between two users: debtor owes creditor the amount.
+type PairBalance struct {
+	DebtorUserID   int64
+	CreditorUserID int64
+	Amount         string
+}
+
+type SettlementSuggestion struct {
+	FromUserID int64
+	ToUserID   int64
+	Amount     string
+}
+
+type shareDebt struct {
+	PayerUserID int64
+	UserID      int64
+	Amount      string
+}
@@ -0,0 +1,248 @@
+package household
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) saveSharedExpense(ctx context.Context, expense *SharedExpense) (*SharedExpense, error) {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := squirrel.
+		Insert(sharedExpenseTable).
+		Columns("transaction_id", "payer_user_id", "total_amount", "split_method").
+		Values(expense.TransactionID, expense.PayerUserID, expense.TotalAmount, expense.SplitMethod).
+		Suffix(`ON CONFLICT (transaction_id) DO UPDATE SET
+			payer_user_id = EXCLUDED.payer_user_id,
+			total_amount = EXCLUDED.total_amount,
+			split_method = EXCLUDED.split_method
+			RETURNING id, transaction_id, payer_user_id, total_amount, split_method, created_at`).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var saved SharedExpense
+	if err = pgxscan.Get(ctx, tx, &saved, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to save shared expense: %w", err)
+	}
+
+	query, args, err = squirrel.
+		Delete(sharedExpenseShareTable).
+		Where(squirrel.Eq{"shared_expense_id": saved.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to delete shared expense shares: %w", err)
+	}
+
+	builder := squirrel.
+		Insert(sharedExpenseShareTable).
+		Columns("shared_expense_id", "user_id", "amount", "percentage").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, share := range expense.Shares {
+		builder = builder.Values(saved.ID, share.UserID, share.Amount, share.Percentage)
+	}
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to insert shared expense shares: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	for i := range expense.Shares {
+		expense.Shares[i].SharedExpenseID = saved.ID
+	}
+	saved.Shares = expense.Shares
+
+	return &saved, nil
+}
+
+func (r *repository) deleteSharedExpense(ctx context.Context, transactionID int64) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var id int64
+	err = tx.QueryRow(ctx, "DELETE FROM shared_expense WHERE transaction_id = $1 RETURNING id", transactionID).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM shared_expense_share WHERE shared_expense_id = $1", id); err != nil {
+		return fmt.Errorf("failed to delete shared expense shares: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *repository) sharedExpenseList(ctx context.Context, userID *int64) ([]SharedExpense, error) {
+	queryBuilder := squirrel.
+		Select("se.id", "se.transaction_id", "se.payer_user_id", "se.total_amount", "se.split_method", "se.created_at").
+		From("shared_expense se").
+		OrderBy("se.id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if userID != nil {
+		queryBuilder = queryBuilder.Where(
+			"(se.payer_user_id = ? OR EXISTS (SELECT 1 FROM shared_expense_share ses WHERE ses.shared_expense_id = se.id AND ses.user_id = ?))",
+			*userID, *userID,
+		)
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var expenses []SharedExpense
+	if err = pgxscan.Select(ctx, r.dbPool, &expenses, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select shared expenses: %w", err)
+	}
+
+	if len(expenses) == 0 {
+		return expenses, nil
+	}
+
+	ids := make([]int64, len(expenses))
+	for i, e := range expenses {
+		ids[i] = e.ID
+	}
+
+	query, args, err = squirrel.
+		Select("shared_expense_id", "user_id", "amount", "percentage").
+		From(sharedExpenseShareTable).
+		Where(squirrel.Eq{"shared_expense_id": ids}).
+		OrderBy("shared_expense_id", "user_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var shares []ExpenseShare
+	if err = pgxscan.Select(ctx, r.dbPool, &shares, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select shared expense shares: %w", err)
+	}
+
+	sharesByExpense := map[int64][]ExpenseShare{}
+	for _, share := range shares {
+		sharesByExpense[share.SharedExpenseID] = append(sharesByExpense[share.SharedExpenseID], share)
+	}
+
+	for i := range expenses {
+		expenses[i].Shares = sharesByExpense[expenses[i].ID]
+	}
+
+	return expenses, nil
+}
+
+func (r *repository) shareDebts(ctx context.Context) ([]shareDebt, error) {
+	query, args, err := squirrel.
+		Select("se.payer_user_id", "ses.user_id", "ses.amount").
+		From("shared_expense se").
+		Join("shared_expense_share ses ON se.id = ses.shared_expense_id").
+		Where("ses.user_id <> se.payer_user_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var debts []shareDebt
+	if err = pgxscan.Select(ctx, r.dbPool, &debts, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select share debts: %w", err)
+	}
+
+	return debts, nil
+}
+
+func (r *repository) saveSettlement(ctx context.Context, settlement *Settlement) (*Settlement, error) {
+	query, args, err := squirrel.
+		Insert(settlementTable).
+		Columns("from_user_id", "to_user_id", "amount", "transaction_id", "note", "settled_at").
+		Values(settlement.FromUserID, settlement.ToUserID, settlement.Amount, settlement.TransactionID, settlement.Note, settlement.SettledAt).
+		Suffix("RETURNING id, from_user_id, to_user_id, amount, transaction_id, note, settled_at, created_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var saved Settlement
+	if err = pgxscan.Get(ctx, r.dbPool, &saved, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to save settlement: %w", err)
+	}
+
+	return &saved, nil
+}
+
+func (r *repository) settlementList(ctx context.Context, userID *int64) ([]Settlement, error) {
+	queryBuilder := squirrel.
+		Select("id", "from_user_id", "to_user_id", "amount", "transaction_id", "note", "settled_at", "created_at").
+		From(settlementTable).
+		OrderBy("settled_at", "id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if userID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Or{
+			squirrel.Eq{"from_user_id": *userID},
+			squirrel.Eq{"to_user_id": *userID},
+		})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var settlements []Settlement
+	if err = pgxscan.Select(ctx, r.dbPool, &settlements, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select settlements: %w", err)
+	}
+
+	return settlements, nil
+}
+
+func (r *repository) settlementExistsForTransaction(ctx context.Context, transactionID int64) (bool, error) {
+	var exists bool
+	err := r.dbPool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM settlement WHERE transaction_id = $1)", transactionID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check settlement: %w", err)
+	}
+
+	return exists, nil
+}
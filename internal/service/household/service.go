@@ -0,0 +1,223 @@
+package household
+
+import (
+	"context"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	repo               *repository
+	transactionService *transaction.Service
+}
+
+func NewService(dbPool *pgxpool.Pool, transactionService *transaction.Service) *Service {
+	return &Service{
+		repo:               newRepository(dbPool),
+		transactionService: transactionService,
+	}
+}
+
+func (s *Service) MarkSharedExpense(ctx context.Context, data *SharedExpenseData) (*SharedExpense, error) {
+	tr, err := s.transactionService.GetTransaction(ctx, data.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCents, err := parseCents(tr.Amount)
+	if err != nil {
+		logger.ErrorWithFields("failed to parse transaction amount", err, "transaction_id", tr.ID, "amount", tr.Amount)
+		return nil, status.Errorf(codes.Internal, "failed to parse transaction amount")
+	}
+	totalCents = absCents(totalCents)
+
+	shares, err := splitShares(data.SplitMethod, totalCents, data.Shares)
+	if err != nil {
+		logger.ErrorWithFields("invalid split", err, "transaction_id", tr.ID, "split_method", data.SplitMethod)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid split: %v", err)
+	}
+
+	payerUserID := tr.UserID
+	if data.PayerUserID != nil {
+		payerUserID = *data.PayerUserID
+	}
+
+	expense, err := s.repo.saveSharedExpense(ctx, &SharedExpense{
+		TransactionID: tr.ID,
+		PayerUserID:   payerUserID,
+		TotalAmount:   formatCents(totalCents),
+		SplitMethod:   data.SplitMethod,
+		Shares:        shares,
+	})
+	if err != nil {
+		logger.ErrorWithFields("failed to save shared expense", err, "transaction_id", tr.ID)
+		return nil, psql.MapPostgresError("failed to save shared expense", err)
+	}
+
+	return expense, nil
+}
+
+func (s *Service) UnmarkSharedExpense(ctx context.Context, transactionID int64) error {
+	err := s.repo.deleteSharedExpense(ctx, transactionID)
+	if err != nil {
+		logger.ErrorWithFields("failed to delete shared expense", err, "transaction_id", transactionID)
+		return psql.MapPostgresError("failed to delete shared expense", err)
+	}
+
+	return nil
+}
+
+func (s *Service) SharedExpenseList(ctx context.Context, userID *int64) ([]SharedExpense, error) {
+	expenses, err := s.repo.sharedExpenseList(ctx, userID)
+	if err != nil {
+		logger.Error("failed to get shared expenses", err)
+		return nil, psql.MapPostgresError("failed to get shared expenses", err)
+	}
+
+	return expenses, nil
+}
+
+func (s *Service) GetBalances(ctx context.Context, userID *int64) ([]PairBalance, error) {
+	balances, err := s.getPairBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toPairBalances(balances)
+	if userID == nil {
+		return res, nil
+	}
+
+	filtered := make([]PairBalance, 0, len(res))
+	for _, b := range res {
+		if b.DebtorUserID == *userID || b.CreditorUserID == *userID {
+			filtered = append(filtered, b)
+		}
+	}
+
+	return filtered, nil
+}
+
+func (s *Service) GetSettleUpSuggestions(ctx context.Context) ([]SettlementSuggestion, error) {
+	balances, err := s.getPairBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return settleUp(balances), nil
+}
+
+func (s *Service) getPairBalances(ctx context.Context) (map[userPair]int64, error) {
+	debts, err := s.repo.shareDebts(ctx)
+	if err != nil {
+		logger.Error("failed to get share debts", err)
+		return nil, psql.MapPostgresError("failed to get balances", err)
+	}
+
+	settlements, err := s.repo.settlementList(ctx, nil)
+	if err != nil {
+		logger.Error("failed to get settlements", err)
+		return nil, psql.MapPostgresError("failed to get balances", err)
+	}
+
+	balances, err := pairBalances(debts, settlements)
+	if err != nil {
+		logger.Error("failed to compute balances", err)
+		return nil, status.Errorf(codes.Internal, "failed to compute balances")
+	}
+
+	return balances, nil
+}
+
+func (s *Service) RecordSettlement(ctx context.Context, data *SettlementData) (*Settlement, error) {
+	if data.FromUserID <= 0 || data.ToUserID <= 0 || data.FromUserID == data.ToUserID {
+		return nil, status.Errorf(codes.InvalidArgument, "settlement requires two different users")
+	}
+
+	settlement := &Settlement{
+		FromUserID:    data.FromUserID,
+		ToUserID:      data.ToUserID,
+		TransactionID: data.TransactionID,
+		Note:          data.Note,
+		SettledAt:     data.SettledAt,
+	}
+
+	if data.TransactionID != nil {
+		tr, err := s.linkedTransfer(ctx, *data.TransactionID, data.FromUserID)
+		if err != nil {
+			return nil, err
+		}
+		if settlement.SettledAt.IsZero() {
+			settlement.SettledAt = tr.TransactionDate
+		}
+		if data.Amount == nil {
+			data.Amount = &tr.Amount
+		}
+	}
+
+	if data.Amount == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "settlement amount is required")
+	}
+
+	cents, err := parseCents(*data.Amount)
+	if err != nil || cents == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid settlement amount")
+	}
+	settlement.Amount = formatCents(absCents(cents))
+
+	if settlement.SettledAt.IsZero() {
+		settlement.SettledAt = time.Now()
+	}
+
+	saved, err := s.repo.saveSettlement(ctx, settlement)
+	if err != nil {
+		logger.ErrorWithFields("failed to save settlement", err, "from_user_id", data.FromUserID, "to_user_id", data.ToUserID)
+		return nil, psql.MapPostgresError("failed to save settlement", err)
+	}
+
+	return saved, nil
+}
+
+// linkedTransfer checks that the transaction is a payment made by the settling user and not yet used by another settlement.
+func (s *Service) linkedTransfer(ctx context.Context, transactionID, fromUserID int64) (*transaction.EnrichedTransaction, error) {
+	tr, err := s.transactionService.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.UserID != fromUserID {
+		logger.ErrorWithFields("settlement transaction belongs to another user", nil, "transaction_id", tr.ID, "user_id", tr.UserID)
+		return nil, status.Errorf(codes.InvalidArgument, "transaction does not belong to the paying user")
+	}
+
+	if tr.Type == transaction.IncomeTransactionType {
+		return nil, status.Errorf(codes.InvalidArgument, "settlement transaction must be an outgoing payment")
+	}
+
+	exists, err := s.repo.settlementExistsForTransaction(ctx, tr.ID)
+	if err != nil {
+		logger.ErrorWithFields("failed to check settlement transaction", err, "transaction_id", tr.ID)
+		return nil, psql.MapPostgresError("failed to check settlement transaction", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "transaction is already linked to a settlement")
+	}
+
+	return tr, nil
+}
+
+func (s *Service) SettlementList(ctx context.Context, userID *int64) ([]Settlement, error) {
+	settlements, err := s.repo.settlementList(ctx, userID)
+	if err != nil {
+		logger.Error("failed to get settlements", err)
+		return nil, psql.MapPostgresError("failed to get settlements", err)
+	}
+
+	return settlements, nil
+}
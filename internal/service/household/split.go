@@ -0,0 +1,145 @@
+package household
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+const percentTolerance = 0.01
+
+func parseCents(amount string) (int64, error) {
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount format: %s", amount)
+	}
+
+	return int64(math.Round(value * 100)), nil
+}
+
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+func absCents(cents int64) int64 {
+	if cents < 0 {
+		return -cents
+	}
+	return cents
+}
+
+// splitShares distributes totalCents between share holders according to the split method.
+// Rounding remainders go to holders in ascending user id order so the result is deterministic.
+func splitShares(method SplitMethod, totalCents int64, inputs []ShareInput) ([]ExpenseShare, error) {
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("at least one share is required")
+	}
+
+	sorted := make([]ShareInput, len(inputs))
+	copy(sorted, inputs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].UserID < sorted[j].UserID
+	})
+
+	for i, in := range sorted {
+		if in.UserID <= 0 {
+			return nil, fmt.Errorf("invalid user id: %d", in.UserID)
+		}
+		if i > 0 && sorted[i-1].UserID == in.UserID {
+			return nil, fmt.Errorf("duplicate share for user %d", in.UserID)
+		}
+	}
+
+	switch method {
+	case EqualSplitMethod:
+		return splitEqual(totalCents, sorted), nil
+	case PercentageSplitMethod:
+		return splitPercentage(totalCents, sorted)
+	case ExactSplitMethod:
+		return splitExact(totalCents, sorted)
+	default:
+		return nil, fmt.Errorf("unknown split method: %s", method)
+	}
+}
+
+func splitEqual(totalCents int64, inputs []ShareInput) []ExpenseShare {
+	count := int64(len(inputs))
+	base := totalCents / count
+	remainder := totalCents % count
+
+	shares := make([]ExpenseShare, len(inputs))
+	for i, in := range inputs {
+		cents := base
+		if int64(i) < remainder {
+			cents++
+		}
+		shares[i] = ExpenseShare{UserID: in.UserID, Amount: formatCents(cents)}
+	}
+
+	return shares
+}
+
+func splitPercentage(totalCents int64, inputs []ShareInput) ([]ExpenseShare, error) {
+	var totalPercent float64
+	for _, in := range inputs {
+		if in.Percentage == nil || *in.Percentage <= 0 {
+			return nil, fmt.Errorf("positive percentage is required for user %d", in.UserID)
+		}
+		totalPercent += *in.Percentage
+	}
+
+	if math.Abs(totalPercent-100) > percentTolerance {
+		return nil, fmt.Errorf("percentages must add up to 100, got %.2f", totalPercent)
+	}
+
+	shares := make([]ExpenseShare, len(inputs))
+	var allocated int64
+	for i, in := range inputs {
+		cents := int64(math.Floor(float64(totalCents) * *in.Percentage / 100))
+		allocated += cents
+		shares[i] = ExpenseShare{UserID: in.UserID, Percentage: in.Percentage}
+		shares[i].Amount = formatCents(cents)
+	}
+
+	for i := 0; allocated < totalCents; i = (i + 1) % len(shares) {
+		cents, _ := parseCents(shares[i].Amount)
+		shares[i].Amount = formatCents(cents + 1)
+		allocated++
+	}
+
+	return shares, nil
+}
+
+func splitExact(totalCents int64, inputs []ShareInput) ([]ExpenseShare, error) {
+	shares := make([]ExpenseShare, len(inputs))
+	var allocated int64
+	for i, in := range inputs {
+		if in.Amount == nil {
+			return nil, fmt.Errorf("amount is required for user %d", in.UserID)
+		}
+
+		cents, err := parseCents(*in.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if cents < 0 {
+			return nil, fmt.Errorf("negative amount for user %d", in.UserID)
+		}
+
+		allocated += cents
+		shares[i] = ExpenseShare{UserID: in.UserID, Amount: formatCents(cents)}
+	}
+
+	if allocated != totalCents {
+		return nil, fmt.Errorf("exact amounts must add up to %s, got %s", formatCents(totalCents), formatCents(allocated))
+	}
+
+	return shares, nil
+}
@@ -0,0 +1,83 @@
+package insight
+
+import "time"
+
+const (
+	// historyMonths is the size of the rolling window the target month is compared against.
+	historyMonths = 12
+	// minHistoryMonths is the minimal number of months with spend required to compute a z-score.
+	minHistoryMonths = 3
+	// categoryAnomalyZScore flags a category month as unusual.
+	categoryAnomalyZScore = 2.0
+
+	// minTransactionSamples is the minimal number of historic transactions required to judge a single one.
+	minTransactionSamples = 5
+	// transactionAnomalyZScore flags a single transaction as unusually large.
+	transactionAnomalyZScore = 3.0
+)
+
+type BaselineSource string
+
+const (
+	MerchantBaselineSource BaselineSource = "MERCHANT"
+	CategoryBaselineSource BaselineSource = "CATEGORY"
+)
+
+type MonthlyCategorySpend struct {
+	CategoryID   int64
+	CategoryName string
+	Month        time.Time
+	Amount       float64
+}
+
+type SpendTransaction struct {
+	ID              int64
+	UserID          int64
+	BankID          int64
+	CategoryID      int64
+	CategoryName    string
+	Description     string
+	Amount          float64
+	TransactionDate time.Time
+}
+
+// CategoryInsight covers the category and its subcategories, except for the drilled-down category itself,
+// which only covers its own transactions.
+type CategoryInsight struct {
+	CategoryID                  int64
+	CategoryName                string
+	HasSubcategories            bool
+	Amount                      float64
+	PreviousMonthAmount         float64
+	MonthOverMonthChangePercent *float64
+	LastYearAmount              float64
+	YearOverYearChangePercent   *float64
+	RollingMean                 float64
+	RollingStdDev               float64
+	ZScore                      *float64
+	IsAnomaly                   bool
+}
+
+type TransactionInsight struct {
+	Transaction    SpendTransaction
+	BaselineSource BaselineSource
+	BaselineMean   float64
+	BaselineStdDev float64
+	ZScore         float64
+}
+
+type SpendingInsights struct {
+	Month         int32
+	Year          int32
+	TotalSpend    float64
+	Categories    []CategoryInsight
+	LargeExpenses []TransactionInsight
+}
+
+type InsightFilter struct {
+	Month  int32
+	Year   int32
+	UserID *int64
+	// ParentCategoryID drills down into the subcategories of a category; top-level categories are returned without it.
+	ParentCategoryID *int64
+}
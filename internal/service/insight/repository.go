@@ -0,0 +1,99 @@
+package insight
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const outcomeTransactionType = "OUTCOME"
+
+// nonSpendingKinds are the category kinds whose outcomes are not spending.
+var nonSpendingKinds = []string{"TRANSFER", "EXCLUDED", "SAVINGS"}
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) monthlyCategorySpend(ctx context.Context, from, to time.Time, userID *int64) ([]MonthlyCategorySpend, error) {
+	queryBuilder := squirrel.
+		Select(
+			"t.category_id",
+			"c.name AS category_name",
+			"date_trunc('month', t.transaction_date)::date AS month",
+			"SUM(ABS(t.amount))::float8 AS amount",
+		).
+		From("transaction t").
+		LeftJoin("category c ON t.category_id = c.id").
+		Where(squirrel.Eq{"t.type": outcomeTransactionType}).
+		Where(squirrel.NotEq{"c.kind": nonSpendingKinds}).
+		Where(squirrel.GtOrEq{"t.transaction_date": from}).
+		Where(squirrel.Lt{"t.transaction_date": to}).
+		GroupBy("t.category_id", "c.name", "month").
+		OrderBy("month").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if userID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"t.user_id": *userID})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var spend []MonthlyCategorySpend
+	if err = pgxscan.Select(ctx, r.dbPool, &spend, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select monthly category spend: %w", err)
+	}
+
+	return spend, nil
+}
+
+func (r *repository) spendTransactions(ctx context.Context, from, to time.Time, userID *int64) ([]SpendTransaction, error) {
+	queryBuilder := squirrel.
+		Select(
+			"t.id",
+			"t.user_id",
+			"t.bank_id",
+			"t.category_id",
+			"c.name AS category_name",
+			"t.description",
+			"ABS(t.amount)::float8 AS amount",
+			"t.transaction_date",
+		).
+		From("transaction t").
+		LeftJoin("category c ON t.category_id = c.id").
+		Where(squirrel.Eq{"t.type": outcomeTransactionType}).
+		Where(squirrel.NotEq{"c.kind": nonSpendingKinds}).
+		Where(squirrel.GtOrEq{"t.transaction_date": from}).
+		Where(squirrel.Lt{"t.transaction_date": to}).
+		OrderBy("t.transaction_date", "t.id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if userID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"t.user_id": *userID})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var transactions []SpendTransaction
+	if err = pgxscan.Select(ctx, r.dbPool, &transactions, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select spend transactions: %w", err)
+	}
+
+	return transactions, nil
+}
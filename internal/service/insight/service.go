@@ -0,0 +1,216 @@
+package insight
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	repo            *repository
+	categoryService *category.Service
+}
+
+func NewService(dbPool *pgxpool.Pool, categoryService *category.Service) *Service {
+	return &Service{
+		repo:            newRepository(dbPool),
+		categoryService: categoryService,
+	}
+}
+
+func (s *Service) GetSpendingInsights(ctx context.Context, filter *InsightFilter) (*SpendingInsights, error) {
+	if filter.Month < 1 || filter.Month > 12 || filter.Year <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid month or year")
+	}
+	if filter.ParentCategoryID != nil {
+		if _, err := s.categoryService.GetCategoryByID(ctx, *filter.ParentCategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	target := time.Date(int(filter.Year), time.Month(filter.Month), 1, 0, 0, 0, 0, time.UTC)
+	historyFrom := target.AddDate(0, -historyMonths, 0)
+	to := target.AddDate(0, 1, 0)
+
+	monthlySpend, err := s.repo.monthlyCategorySpend(ctx, historyFrom, to, filter.UserID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get monthly category spend", err, "month", filter.Month, "year", filter.Year)
+		return nil, psql.MapPostgresError("failed to get spending insights", err)
+	}
+
+	transactions, err := s.repo.spendTransactions(ctx, historyFrom, to, filter.UserID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get spend transactions", err, "month", filter.Month, "year", filter.Year)
+		return nil, psql.MapPostgresError("failed to get spending insights", err)
+	}
+
+	categories, totalSpend := buildCategoryInsights(s.rollUp(monthlySpend, filter.ParentCategoryID), target)
+	for i := range categories {
+		drilledDown := filter.ParentCategoryID != nil && categories[i].CategoryID == *filter.ParentCategoryID
+		categories[i].HasSubcategories = !drilledDown && !s.categoryService.Store().IsLeaf(categories[i].CategoryID)
+	}
+
+	return &SpendingInsights{
+		Month:         filter.Month,
+		Year:          filter.Year,
+		TotalSpend:    totalSpend,
+		Categories:    categories,
+		LargeExpenses: buildTransactionInsights(transactions, target),
+	}, nil
+}
+
+// rollUp totals spend under top-level categories, or under the direct subcategories of parentID when drilling down,
+// and drops spend outside of parentID.
+func (s *Service) rollUp(monthlySpend []MonthlyCategorySpend, parentID *int64) []MonthlyCategorySpend {
+	store := s.categoryService.Store()
+
+	res := make([]MonthlyCategorySpend, 0, len(monthlySpend))
+	for _, spend := range monthlySpend {
+		categoryID, ok := store.RollUp(spend.CategoryID, parentID)
+		if !ok {
+			continue
+		}
+
+		if categoryID != spend.CategoryID {
+			spend.CategoryID = categoryID
+			if category := store.GetCategory(categoryID); category != nil {
+				spend.CategoryName = category.Name
+			}
+		}
+		res = append(res, spend)
+	}
+
+	return res
+}
+
+func buildCategoryInsights(monthlySpend []MonthlyCategorySpend, target time.Time) ([]CategoryInsight, float64) {
+	categoryNames := map[int64]string{}
+	categoryMonths := map[int64]map[string]float64{}
+	for _, spend := range monthlySpend {
+		categoryNames[spend.CategoryID] = spend.CategoryName
+		if categoryMonths[spend.CategoryID] == nil {
+			categoryMonths[spend.CategoryID] = map[string]float64{}
+		}
+		categoryMonths[spend.CategoryID][monthKey(spend.Month)] += spend.Amount
+	}
+
+	targetKey := monthKey(target)
+	previousKey := monthKey(target.AddDate(0, -1, 0))
+	lastYearKey := monthKey(target.AddDate(-1, 0, 0))
+
+	var totalSpend float64
+	insights := make([]CategoryInsight, 0, len(categoryMonths))
+	for categoryID, months := range categoryMonths {
+		amount := months[targetKey]
+		previous := months[previousKey]
+		if amount == 0 && previous == 0 {
+			continue
+		}
+		totalSpend += amount
+
+		insight := CategoryInsight{
+			CategoryID:                  categoryID,
+			CategoryName:                categoryNames[categoryID],
+			Amount:                      amount,
+			PreviousMonthAmount:         previous,
+			MonthOverMonthChangePercent: changePercent(amount, previous),
+			LastYearAmount:              months[lastYearKey],
+			YearOverYearChangePercent:   changePercent(amount, months[lastYearKey]),
+		}
+
+		history := categoryHistory(months, target)
+		insight.RollingMean, insight.RollingStdDev = meanStdDev(history)
+		if len(history) >= minHistoryMonths && insight.RollingStdDev > 0 {
+			zScore := (amount - insight.RollingMean) / insight.RollingStdDev
+			insight.ZScore = &zScore
+			insight.IsAnomaly = math.Abs(zScore) >= categoryAnomalyZScore
+		}
+
+		insights = append(insights, insight)
+	}
+
+	sort.Slice(insights, func(i, j int) bool {
+		if insights[i].IsAnomaly != insights[j].IsAnomaly {
+			return insights[i].IsAnomaly
+		}
+		return insights[i].Amount > insights[j].Amount
+	})
+
+	return insights, totalSpend
+}
+
+// categoryHistory returns monthly spend for the window preceding the target month,
+// starting from the first month the category had any spend so new categories are not diluted by zeros.
+func categoryHistory(months map[string]float64, target time.Time) []float64 {
+	history := make([]float64, 0, historyMonths)
+	started := false
+	for i := historyMonths; i >= 1; i-- {
+		amount := months[monthKey(target.AddDate(0, -i, 0))]
+		if !started && amount == 0 {
+			continue
+		}
+		started = true
+		history = append(history, amount)
+	}
+
+	return history
+}
+
+func buildTransactionInsights(transactions []SpendTransaction, target time.Time) []TransactionInsight {
+	merchantHistory := map[string][]float64{}
+	categoryHistory := map[int64][]float64{}
+	current := make([]SpendTransaction, 0)
+	for _, tr := range transactions {
+		if !tr.TransactionDate.Before(target) {
+			current = append(current, tr)
+			continue
+		}
+		merchantHistory[merchantKey(tr.Description)] = append(merchantHistory[merchantKey(tr.Description)], tr.Amount)
+		categoryHistory[tr.CategoryID] = append(categoryHistory[tr.CategoryID], tr.Amount)
+	}
+
+	insights := make([]TransactionInsight, 0)
+	for _, tr := range current {
+		source := MerchantBaselineSource
+		samples := merchantHistory[merchantKey(tr.Description)]
+		if len(samples) < minTransactionSamples {
+			source = CategoryBaselineSource
+			samples = categoryHistory[tr.CategoryID]
+		}
+		if len(samples) < minTransactionSamples {
+			continue
+		}
+
+		mean, stdDev := meanStdDev(samples)
+		if stdDev == 0 {
+			continue
+		}
+
+		zScore := (tr.Amount - mean) / stdDev
+		if zScore < transactionAnomalyZScore {
+			continue
+		}
+
+		insights = append(insights, TransactionInsight{
+			Transaction:    tr,
+			BaselineSource: source,
+			BaselineMean:   mean,
+			BaselineStdDev: stdDev,
+			ZScore:         zScore,
+		})
+	}
+
+	sort.Slice(insights, func(i, j int) bool {
+		return insights[i].ZScore > insights[j].ZScore
+	})
+
+	return insights
+}
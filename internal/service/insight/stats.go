@@ -0,0 +1,44 @@
+package insight
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+func meanStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var sqDiff float64
+	for _, v := range values {
+		sqDiff += (v - mean) * (v - mean)
+	}
+
+	return mean, math.Sqrt(sqDiff / float64(len(values)))
+}
+
+// changePercent returns nil when there is no base to compare against.
+func changePercent(current, base float64) *float64 {
+	if base == 0 {
+		return nil
+	}
+
+	change := (current - base) / base * 100
+	return &change
+}
+
+func monthKey(t time.Time) string {
+	return t.Format("2006_01")
+}
+
+func merchantKey(description string) string {
+	return strings.ToLower(strings.TrimSpace(description))
+}
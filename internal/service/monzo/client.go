@@ -16,6 +16,7 @@ const (
 	authURL            = "https://auth.monzo.com"
 	getAccountsURL     = "https://api.monzo.com/accounts"
 	getTransactionsURL = "https://api.monzo.com/transactions"
+	getBalanceURL      = "https://api.monzo.com/balance"
 	getAuthTokenURL    = "https://api.monzo.com/oauth2/token"
 
 	authHeader        = "Authorization"
@@ -144,7 +145,7 @@ func (c *client) getTokens(ctx context.Context, code string) (*tokenResponse, er
 	return &tokenResp, nil
 }
 
-func (c *client) getAccountID(ctx context.Context, accessToken string) (string, error) {
+func (c *client) getAccounts(ctx context.Context, accessToken string) ([]MonzoAccount, error) {
 	reqData := requestData{
 		method: http.MethodGet,
 		url:    getAccountsURL,
@@ -155,27 +156,22 @@ func (c *client) getAccountID(ctx context.Context, accessToken string) (string,
 
 	body, err := c.sendResponse(ctx, reqData)
 	if err != nil {
-		logger.Error("failed to send account id request", err)
-		return "", err
-	}
-
-	var data struct {
-		Accounts []struct {
-			ID string `json:"id"`
-		} `json:"accounts"`
+		logger.Error("failed to send accounts request", err)
+		return nil, err
 	}
 
+	var data monzoAccountsResponse
 	if err = json.NewDecoder(body).Decode(&data); err != nil {
 		logger.Error("failed to decode account response", err)
-		return "", fmt.Errorf("failed to decode account response: %w", err)
+		return nil, fmt.Errorf("failed to decode account response: %w", err)
 	}
 
 	if len(data.Accounts) == 0 {
 		logger.Error("no accounts found", err)
-		return "", fmt.Errorf("no accounts found")
+		return nil, fmt.Errorf("no accounts found")
 	}
 
-	return data.Accounts[0].ID, nil
+	return data.Accounts, nil
 }
 
 func (c *client) getMonzoTransactions(ctx context.Context, accessToken string, accountID string, since, before time.Time) ([]MonzoTransaction, error) {
@@ -209,6 +205,33 @@ func (c *client) getMonzoTransactions(ctx context.Context, accessToken string, a
 	return monzoResp.Transactions, nil
 }
 
+func (c *client) getBalance(ctx context.Context, accessToken string, accountID string) (*monzoBalance, error) {
+	values := url.Values{}
+	values.Set(accountIDField, accountID)
+
+	reqData := requestData{
+		method: http.MethodGet,
+		url:    fmt.Sprintf("%s?%s", getBalanceURL, values.Encode()),
+		headers: map[string]string{
+			authHeader: bearerSchema + " " + accessToken,
+		},
+	}
+
+	body, err := c.sendResponse(ctx, reqData)
+	if err != nil {
+		logger.Error("failed to send balance request", err)
+		return nil, err
+	}
+
+	var balance monzoBalance
+	if err = json.NewDecoder(body).Decode(&balance); err != nil {
+		logger.Error("failed to decode balance response", err)
+		return nil, fmt.Errorf("failed to decode balance response: %w", err)
+	}
+
+	return &balance, nil
+}
+
 func (c *client) refreshToken(ctx context.Context, refreshToken string) (*tokenResponse, error) {
 	cfg := c.monzoCfg
 	values := url.Values{}
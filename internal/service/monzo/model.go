@@ -24,6 +24,23 @@ func (a *authTokens) IsExpired() bool {
 	return time.Now().After(a.issuedAt.Add(time.Duration(a.expiresIn) * time.Second))
 }
 
+type monzoAccountsResponse struct {
+	Accounts []MonzoAccount `json:"accounts"`
+}
+
+type MonzoAccount struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Currency    string `json:"currency"`
+	Closed      bool   `json:"closed"`
+}
+
+type monzoBalance struct {
+	Balance  int64  `json:"balance"`
+	Currency string `json:"currency"`
+}
+
 type monzoTransactionsResponse struct {
 	Transactions []MonzoTransaction `json:"transactions"`
 }
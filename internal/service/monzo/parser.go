@@ -33,12 +33,6 @@ func (s *Service) parseMonzoTransactions(
 			continue
 		}
 
-		ctgID, err := s.parseCategory(ctx, mTr.Category, mTr.Description)
-		if err != nil {
-			errStr := err.Error()
-			failedTrErr[errStr] = append(failedTrErr[errStr], mTr)
-		}
-
 		tr := &transaction.Transaction{
 			UserID:          userID,
 			BankID:          bankID,
@@ -46,9 +40,10 @@ func (s *Service) parseMonzoTransactions(
 			Amount:          parseAmount(mTr.Amount),
 			Description:     parseDescription(mTr.Description, mTr.Category, mTr.Notes, mTr.Scheme),
 			TransactionDate: date,
-			CategoryID:      ctgID,
+			CategoryID:      category.UncategorizedID,
 			Type:            parseType(mTr.Amount),
 		}
+		s.parseCategory(tr, mTr.Category, mTr.Description)
 
 		trs = append(trs, tr)
 	}
@@ -100,18 +95,6 @@ func parseDate(createdAt string, from time.Time) (time.Time, error) {
 	return from, fmt.Errorf("unknown date format: %s", createdAt)
 }
 
-func (s *Service) parseCategory(ctx context.Context, mCategory, desc string) (int64, error) {
-	keywordCategory, err := s.categoryService.GetKeywordCategoryIDMap(ctx)
-	if err != nil {
-		return category.UncategorizedID, err
-	}
-
-	combinedText := strings.ToLower(strings.Join([]string{mCategory, desc}, " "))
-	for keyword, categoryID := range keywordCategory {
-		if strings.Contains(combinedText, strings.ToLower(keyword)) {
-			return categoryID, nil
-		}
-	}
-
-	return category.UncategorizedID, nil
+func (s *Service) parseCategory(tr *transaction.Transaction, mCategory, desc string) {
+	s.ruleService.Categorize(tr, strings.Join([]string{mCategory, desc}, " "), []string{mCategory})
 }
@@ -2,7 +2,11 @@ package monzo
 
 import (
 	"context"
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
 	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/event"
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
 	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
 	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
 	"google.golang.org/grpc/codes"
@@ -17,14 +21,31 @@ type Service struct {
 	authStore          *authStore
 	transactionService *transaction.Service
 	categoryService    *category.Service
+	accountService     *account.Service
+	balanceService     *balance.Service
+	eventService       *event.Service
+	ruleService        *rule.Service
 }
 
-func NewService(monzoCfg *MonzoCfg, timeout time.Duration, transactionService *transaction.Service, categoryService *category.Service) *Service {
+func NewService(
+	monzoCfg *MonzoCfg,
+	timeout time.Duration,
+	transactionService *transaction.Service,
+	categoryService *category.Service,
+	accountService *account.Service,
+	balanceService *balance.Service,
+	eventService *event.Service,
+	ruleService *rule.Service,
+) *Service {
 	return &Service{
 		client:             newClient(timeout, monzoCfg),
 		authStore:          &authStore{},
 		transactionService: transactionService,
 		categoryService:    categoryService,
+		accountService:     accountService,
+		balanceService:     balanceService,
+		eventService:       eventService,
+		ruleService:        ruleService,
 	}
 }
 
@@ -64,23 +85,30 @@ func (s *Service) AuthCallback(ctx context.Context, state, code string) error {
 	return nil
 }
 
-func (s *Service) GetAccountID(ctx context.Context) error {
+// GetAccounts returns Monzo accounts available for the token and remembers the first open one
+// as the default account for syncs that do not target a specific account.
+func (s *Service) GetAccounts(ctx context.Context) ([]MonzoAccount, error) {
 	err := s.checkTokens(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	tokens := s.authStore.getAuthToken()
 
-	accountID, err := s.client.getAccountID(ctx, tokens.accessToken)
+	accounts, err := s.client.getAccounts(ctx, tokens.accessToken)
 	if err != nil {
-		logger.Error("failed to get monzo account id", err)
-		return status.Errorf(codes.Unavailable, "Monzo API error: %v", err)
+		logger.Error("failed to get monzo accounts", err)
+		return nil, status.Errorf(codes.Unavailable, "Monzo API error: %v", err)
 	}
 
-	s.authStore.setAccountID(accountID)
+	for _, account := range accounts {
+		if !account.Closed {
+			s.authStore.setAccountID(account.ID)
+			break
+		}
+	}
 
-	return nil
+	return accounts, nil
 }
 
 func (s *Service) checkTokens(ctx context.Context) error {
@@ -124,20 +152,72 @@ func (s *Service) checkAuth(ctx context.Context) error {
 	return nil
 }
 
-func (s *Service) GetMonzoTransactions(ctx context.Context, since, before time.Time, userID, bankID int64) error {
-	if err := s.checkAuth(ctx); err != nil {
+// resolveAccountID returns the Monzo account id to sync: the external id of the target account
+// when one is given, otherwise the default account remembered by GetAccounts.
+func (s *Service) resolveAccountID(ctx context.Context, bankID, userID int64, targetAccountID *int64) (string, error) {
+	if targetAccountID == nil {
+		if err := s.checkAuth(ctx); err != nil {
+			return "", err
+		}
+
+		return s.authStore.getAccountID(), nil
+	}
+
+	if err := s.checkTokens(ctx); err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "user is unauthenticated")
+	}
+
+	acc, err := s.accountService.GetImportAccount(ctx, *targetAccountID, bankID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if acc.ExternalID == nil || *acc.ExternalID == "" {
+		logger.ErrorWithFields("account has no Monzo account id", nil, "account_id", acc.ID)
+		return "", status.Errorf(codes.FailedPrecondition, "account has no external account id")
+	}
+
+	return *acc.ExternalID, nil
+}
+
+// saveBalanceSnapshot records the current Monzo balance; failures do not block the sync.
+func (s *Service) saveBalanceSnapshot(ctx context.Context, accessToken, monzoAccountID string, accountID int64) {
+	monzoBalance, err := s.client.getBalance(ctx, accessToken, monzoAccountID)
+	if err != nil {
+		logger.ErrorWithFields("failed to fetch Monzo balance", err, "account_id", accountID)
+		return
+	}
+
+	err = s.balanceService.SaveImportedSnapshots(ctx, []balance.Snapshot{{
+		AccountID:   accountID,
+		Balance:     parseAmount(monzoBalance.Balance),
+		BalanceDate: time.Now(),
+		Source:      balance.APISnapshotSource,
+	}})
+	if err != nil {
+		logger.ErrorWithFields("failed to save Monzo balance", err, "account_id", accountID)
+	}
+}
+
+func (s *Service) GetMonzoTransactions(ctx context.Context, since, before time.Time, userID, bankID int64, targetAccountID *int64) error {
+	accountID, err := s.resolveAccountID(ctx, bankID, userID, targetAccountID)
+	if err != nil {
 		return err
 	}
 
 	authToken := s.authStore.getAuthToken()
-	accountID := s.authStore.getAccountID()
 	if authToken == nil || accountID == "" {
 		return status.Errorf(codes.Unauthenticated, "user is unauthenticated")
 	}
 
+	if targetAccountID != nil {
+		s.saveBalanceSnapshot(ctx, authToken.accessToken, accountID, *targetAccountID)
+	}
+
 	monzoTransaction, err := s.client.getMonzoTransactions(ctx, authToken.accessToken, accountID, since, before)
 	if err != nil {
 		logger.ErrorWithFields("failed to fetch Monzo transactions", err, "account_id", accountID, "since", since, "before", before)
+		s.importFailed(ctx, bankID, userID, "failed to fetch Monzo transactions")
 		return status.Errorf(codes.Unavailable, "failed to get Monzo transactions")
 	}
 
@@ -149,15 +229,30 @@ func (s *Service) GetMonzoTransactions(ctx context.Context, since, before time.T
 	trs, trErr, err := s.parseMonzoTransactions(ctx, monzoTransaction, since, userID, bankID)
 	if err != nil {
 		logger.ErrorWithFields("failed to parse Monzo transactions", err, "since", since, "user_id", userID, "bank_id", bankID)
+		s.importFailed(ctx, bankID, userID, "failed to parse Monzo transactions")
 		return status.Errorf(codes.Internal, "failed to parse Monzo transactions")
 	}
 
+	for _, tr := range trs {
+		tr.AccountID = targetAccountID
+	}
+
 	err = s.transactionService.SaveTransactions(ctx, trs)
 	if err != nil {
 		logger.ErrorWithFields("failed to save Monzo transactions", err, "since", since, "user_id", userID, "bank_id", bankID)
+		s.importFailed(ctx, bankID, userID, "failed to save Monzo transactions")
 		return status.Errorf(codes.Internal, "failed to save Monzo transactions")
 	}
 
+	s.eventService.Publish(ctx, event.ImportCompletedType, event.ImportCompleted{
+		Source:      event.MonzoImportSource,
+		BankID:      bankID,
+		UserID:      userID,
+		AccountID:   targetAccountID,
+		RecordCount: len(monzoTransaction),
+		ErrorCount:  len(trErr),
+	})
+
 	//todo
 	if len(trErr) == 0 {
 		return nil
@@ -166,3 +261,12 @@ func (s *Service) GetMonzoTransactions(ctx context.Context, since, before time.T
 	logger.ErrorWithFields("failed to save few transactions", nil, "transactions_errors", trErr)
 	return status.Errorf(codes.Internal, "failed to save some Monzo transactions")
 }
+
+func (s *Service) importFailed(ctx context.Context, bankID, userID int64, reason string) {
+	s.eventService.Publish(ctx, event.ImportFailedType, event.ImportFailed{
+		Source: event.MonzoImportSource,
+		BankID: bankID,
+		UserID: userID,
+		Reason: reason,
+	})
+}
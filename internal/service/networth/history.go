@@ -0,0 +1,108 @@
+package networth
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+)
+
+type series struct {
+	class  AssetClass
+	kind   AssetKind
+	values []datedValue
+}
+
+// valueAt carries the latest known value forward; nothing is known before the first valuation.
+func (s *series) valueAt(date time.Time) float64 {
+	i := sort.Search(len(s.values), func(i int) bool {
+		return s.values[i].date.After(date)
+	})
+	if i == 0 {
+		return 0
+	}
+
+	return s.values[i-1].value
+}
+
+func accountClass(accountType account.AccountType) (AssetClass, AssetKind) {
+	switch accountType {
+	case account.CurrentAccountType:
+		return CashAssetClass, AssetAssetKind
+	case account.SavingsAccountType:
+		return SavingsAssetClass, AssetAssetKind
+	case account.CreditCardAccountType:
+		return CreditCardAssetClass, LiabilityAssetKind
+	case account.LoanAccountType:
+		return LoanAssetClass, LiabilityAssetKind
+	default:
+		return OtherAssetClass, AssetAssetKind
+	}
+}
+
+// historyPoints returns month-end dates between from and to, with to as the last point.
+func historyPoints(from, to time.Time) []time.Time {
+	points := make([]time.Time, 0)
+	monthStart := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for !monthStart.After(to) {
+		monthEnd := monthStart.AddDate(0, 1, -1)
+		if monthEnd.After(to) {
+			monthEnd = to
+		}
+		points = append(points, monthEnd)
+		monthStart = monthStart.AddDate(0, 1, 0)
+	}
+
+	return points
+}
+
+func buildHistory(points []time.Time, allSeries []*series) []NetWorthPoint {
+	history := make([]NetWorthPoint, 0, len(points))
+	for _, date := range points {
+		point := NetWorthPoint{Date: date}
+		byClass := map[AssetClass]*ClassValue{}
+
+		for _, s := range allSeries {
+			value := s.valueAt(date)
+			if value == 0 {
+				continue
+			}
+
+			if s.kind == LiabilityAssetKind {
+				point.TotalLiabilities += value
+			} else {
+				point.TotalAssets += value
+			}
+
+			cv, ok := byClass[s.class]
+			if !ok {
+				cv = &ClassValue{AssetClass: s.class, Kind: s.kind}
+				byClass[s.class] = cv
+			}
+			cv.Value += value
+		}
+
+		for _, cv := range byClass {
+			cv.Value = round2(cv.Value)
+			point.Breakdown = append(point.Breakdown, *cv)
+		}
+		sort.Slice(point.Breakdown, func(i, j int) bool {
+			if point.Breakdown[i].Kind != point.Breakdown[j].Kind {
+				return point.Breakdown[i].Kind == AssetAssetKind
+			}
+			return point.Breakdown[i].Value > point.Breakdown[j].Value
+		})
+
+		point.TotalAssets = round2(point.TotalAssets)
+		point.TotalLiabilities = round2(point.TotalLiabilities)
+		point.NetWorth = round2(point.TotalAssets - point.TotalLiabilities)
+		history = append(history, point)
+	}
+
+	return history
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,91 @@
+package networth
+
+import "time"
+
+const (
+	assetTable          = "asset"
+	assetValuationTable = "asset_valuation"
+)
+
+// defaultHistoryMonths is used when the history range is not specified.
+const defaultHistoryMonths = 12
+
+type AssetKind string
+
+const (
+	AssetAssetKind     AssetKind = "ASSET"
+	LiabilityAssetKind AssetKind = "LIABILITY"
+)
+
+type AssetClass string
+
+const (
+	CashAssetClass       AssetClass = "CASH"
+	SavingsAssetClass    AssetClass = "SAVINGS"
+	InvestmentAssetClass AssetClass = "INVESTMENT"
+	PensionAssetClass    AssetClass = "PENSION"
+	PropertyAssetClass   AssetClass = "PROPERTY"
+	VehicleAssetClass    AssetClass = "VEHICLE"
+	MortgageAssetClass   AssetClass = "MORTGAGE"
+	LoanAssetClass       AssetClass = "LOAN"
+	CreditCardAssetClass AssetClass = "CREDIT_CARD"
+	OtherAssetClass      AssetClass = "OTHER"
+)
+
+type Asset struct {
+	ID          int64
+	Name        string
+	Kind        AssetKind
+	AssetClass  AssetClass
+	Currency    string
+	OwnerUserID *int64
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+type AssetUpdateData struct {
+	ID          int64
+	Name        *string
+	AssetClass  *AssetClass
+	OwnerUserID *int64
+}
+
+type AssetFilter struct {
+	UserID *int64
+}
+
+// Valuation is the value of an asset or the outstanding amount of a liability, always positive.
+type Valuation struct {
+	ID            int64
+	AssetID       int64
+	Value         string
+	ValuationDate time.Time
+	Note          *string
+	CreatedAt     time.Time
+}
+
+type HistoryFilter struct {
+	From   *time.Time
+	To     *time.Time
+	UserID *int64
+}
+
+type ClassValue struct {
+	AssetClass AssetClass
+	Kind       AssetKind
+	Value      float64
+}
+
+type NetWorthPoint struct {
+	Date             time.Time
+	TotalAssets      float64
+	TotalLiabilities float64
+	NetWorth         float64
+	Breakdown        []ClassValue
+}
+
+// datedValue is a signed value known from a date onwards, used to carry valuations forward.
+type datedValue struct {
+	date  time.Time
+	value float64
+}
@@ -0,0 +1,178 @@
+package networth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) getAsset(ctx context.Context, id int64) (*Asset, error) {
+	query, args, err := squirrel.
+		Select("id", "name", "kind", "asset_class", "currency", "owner_user_id", "created_at", "updated_at").
+		From(assetTable).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var asset Asset
+	if err = pgxscan.Get(ctx, r.dbPool, &asset, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &asset, nil
+}
+
+func (r *repository) assetList(ctx context.Context, filter *AssetFilter) ([]Asset, error) {
+	queryBuilder := squirrel.
+		Select("id", "name", "kind", "asset_class", "currency", "owner_user_id", "created_at", "updated_at").
+		From(assetTable).
+		OrderBy("kind", "name").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.UserID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"owner_user_id": *filter.UserID})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var assets []Asset
+	if err = pgxscan.Select(ctx, r.dbPool, &assets, query, args...); err != nil {
+		return nil, err
+	}
+
+	return assets, nil
+}
+
+func (r *repository) createAsset(ctx context.Context, asset *Asset) (*Asset, error) {
+	query, args, err := squirrel.
+		Insert(assetTable).
+		Columns("name", "kind", "asset_class", "currency", "owner_user_id").
+		Values(asset.Name, asset.Kind, asset.AssetClass, asset.Currency, asset.OwnerUserID).
+		Suffix("RETURNING id, name, kind, asset_class, currency, owner_user_id, created_at, updated_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var created Asset
+	if err = pgxscan.Get(ctx, r.dbPool, &created, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *repository) updateAsset(ctx context.Context, asset *Asset) (*Asset, error) {
+	query, args, err := squirrel.
+		Update(assetTable).
+		Set("name", asset.Name).
+		Set("asset_class", asset.AssetClass).
+		Set("owner_user_id", asset.OwnerUserID).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": asset.ID}).
+		Suffix("RETURNING id, name, kind, asset_class, currency, owner_user_id, created_at, updated_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var updated Asset
+	if err = pgxscan.Get(ctx, r.dbPool, &updated, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (r *repository) deleteAsset(ctx context.Context, id int64) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, "DELETE FROM asset WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM asset_valuation WHERE asset_id = $1", id); err != nil {
+		return fmt.Errorf("failed to delete asset valuations: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *repository) saveValuation(ctx context.Context, valuation *Valuation) (*Valuation, error) {
+	query, args, err := squirrel.
+		Insert(assetValuationTable).
+		Columns("asset_id", "value", "valuation_date", "note").
+		Values(valuation.AssetID, valuation.Value, valuation.ValuationDate, valuation.Note).
+		Suffix(`ON CONFLICT (asset_id, valuation_date) DO UPDATE SET
+			value = EXCLUDED.value,
+			note = EXCLUDED.note
+			RETURNING id, asset_id, value, valuation_date, note, created_at`).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var saved Valuation
+	if err = pgxscan.Get(ctx, r.dbPool, &saved, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+func (r *repository) valuationList(ctx context.Context, assetIDs []int64, to *time.Time) ([]Valuation, error) {
+	queryBuilder := squirrel.
+		Select("id", "asset_id", "value", "valuation_date", "note", "created_at").
+		From(assetValuationTable).
+		Where(squirrel.Eq{"asset_id": assetIDs}).
+		OrderBy("asset_id", "valuation_date").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if to != nil {
+		queryBuilder = queryBuilder.Where(squirrel.LtOrEq{"valuation_date": *to})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var valuations []Valuation
+	if err = pgxscan.Select(ctx, r.dbPool, &valuations, query, args...); err != nil {
+		return nil, err
+	}
+
+	return valuations, nil
+}
@@ -0,0 +1,276 @@
+package networth
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	repo           *repository
+	accountService *account.Service
+	balanceService *balance.Service
+}
+
+func NewService(dbPool *pgxpool.Pool, accountService *account.Service, balanceService *balance.Service) *Service {
+	return &Service{
+		repo:           newRepository(dbPool),
+		accountService: accountService,
+		balanceService: balanceService,
+	}
+}
+
+func (s *Service) CreateAsset(ctx context.Context, asset *Asset) (*Asset, error) {
+	asset.Name = strings.TrimSpace(asset.Name)
+	if asset.Currency == "" {
+		asset.Currency = account.DefaultCurrency
+	}
+	asset.Currency = strings.ToUpper(asset.Currency)
+
+	if err := validateAsset(asset); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset: %v", err)
+	}
+
+	created, err := s.repo.createAsset(ctx, asset)
+	if err != nil {
+		logger.ErrorWithFields("failed to create asset", err, "name", asset.Name)
+		return nil, psql.MapPostgresError("failed to create asset", err)
+	}
+
+	return created, nil
+}
+
+func (s *Service) UpdateAsset(ctx context.Context, data *AssetUpdateData) (*Asset, error) {
+	asset, err := s.GetAsset(ctx, data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Name != nil {
+		asset.Name = strings.TrimSpace(*data.Name)
+	}
+	if data.AssetClass != nil {
+		asset.AssetClass = *data.AssetClass
+	}
+	if data.OwnerUserID != nil {
+		asset.OwnerUserID = data.OwnerUserID
+		if *data.OwnerUserID == 0 {
+			asset.OwnerUserID = nil
+		}
+	}
+
+	if err = validateAsset(asset); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset: %v", err)
+	}
+
+	updated, err := s.repo.updateAsset(ctx, asset)
+	if err != nil {
+		logger.ErrorWithFields("failed to update asset", err, "asset_id", data.ID)
+		return nil, psql.MapPostgresError("failed to update asset", err)
+	}
+
+	return updated, nil
+}
+
+func (s *Service) GetAsset(ctx context.Context, id int64) (*Asset, error) {
+	asset, err := s.repo.getAsset(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to get asset", err, "asset_id", id)
+		return nil, psql.MapPostgresError("failed to get asset", err)
+	}
+
+	return asset, nil
+}
+
+func (s *Service) DeleteAsset(ctx context.Context, id int64) error {
+	err := s.repo.deleteAsset(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to delete asset", err, "asset_id", id)
+		return psql.MapPostgresError("failed to delete asset", err)
+	}
+
+	return nil
+}
+
+func (s *Service) AssetList(ctx context.Context, filter *AssetFilter) ([]Asset, error) {
+	assets, err := s.repo.assetList(ctx, filter)
+	if err != nil {
+		logger.Error("failed to get assets", err)
+		return nil, psql.MapPostgresError("failed to get assets", err)
+	}
+
+	return assets, nil
+}
+
+func (s *Service) AddValuation(ctx context.Context, valuation *Valuation) (*Valuation, error) {
+	if _, err := s.GetAsset(ctx, valuation.AssetID); err != nil {
+		return nil, err
+	}
+
+	value, err := strconv.ParseFloat(valuation.Value, 64)
+	if err != nil || value < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid valuation: %s", valuation.Value)
+	}
+
+	if valuation.ValuationDate.IsZero() {
+		valuation.ValuationDate = time.Now()
+	}
+
+	saved, err := s.repo.saveValuation(ctx, valuation)
+	if err != nil {
+		logger.ErrorWithFields("failed to save asset valuation", err, "asset_id", valuation.AssetID)
+		return nil, psql.MapPostgresError("failed to save asset valuation", err)
+	}
+
+	return saved, nil
+}
+
+func (s *Service) ValuationList(ctx context.Context, assetID int64) ([]Valuation, error) {
+	valuations, err := s.repo.valuationList(ctx, []int64{assetID}, nil)
+	if err != nil {
+		logger.ErrorWithFields("failed to get asset valuations", err, "asset_id", assetID)
+		return nil, psql.MapPostgresError("failed to get asset valuations", err)
+	}
+
+	return valuations, nil
+}
+
+// GetNetWorthHistory combines manual asset valuations with account balance snapshots
+// into a month-end time series.
+func (s *Service) GetNetWorthHistory(ctx context.Context, filter *HistoryFilter) ([]NetWorthPoint, error) {
+	to := time.Now().UTC()
+	if filter.To != nil {
+		to = filter.To.UTC()
+	}
+	from := to.AddDate(0, -defaultHistoryMonths, 0)
+	if filter.From != nil {
+		from = filter.From.UTC()
+	}
+	if from.After(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid history range")
+	}
+
+	assetSeries, err := s.assetSeries(ctx, filter.UserID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	accountSeries, err := s.accountSeries(ctx, filter.UserID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildHistory(historyPoints(from, to), append(assetSeries, accountSeries...)), nil
+}
+
+func (s *Service) assetSeries(ctx context.Context, userID *int64, to time.Time) ([]*series, error) {
+	assets, err := s.AssetList(ctx, &AssetFilter{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	if len(assets) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, len(assets))
+	byID := make(map[int64]*series, len(assets))
+	for i, asset := range assets {
+		ids[i] = asset.ID
+		byID[asset.ID] = &series{class: asset.AssetClass, kind: asset.Kind}
+	}
+
+	valuations, err := s.repo.valuationList(ctx, ids, &to)
+	if err != nil {
+		logger.Error("failed to get asset valuations", err)
+		return nil, psql.MapPostgresError("failed to get net worth history", err)
+	}
+
+	for _, v := range valuations {
+		value, err := strconv.ParseFloat(v.Value, 64)
+		if err != nil {
+			logger.ErrorWithFields("failed to parse asset valuation", err, "valuation_id", v.ID, "value", v.Value)
+			continue
+		}
+		byID[v.AssetID].values = append(byID[v.AssetID].values, datedValue{date: v.ValuationDate, value: value})
+	}
+
+	res := make([]*series, 0, len(byID))
+	for _, sr := range byID {
+		res = append(res, sr)
+	}
+
+	return res, nil
+}
+
+func (s *Service) accountSeries(ctx context.Context, userID *int64, to time.Time) ([]*series, error) {
+	accounts, err := s.accountService.AccountList(ctx, &account.AccountFilter{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(accounts))
+	for i, acc := range accounts {
+		ids[i] = acc.ID
+	}
+
+	snapshots, err := s.balanceService.AccountSnapshots(ctx, ids, to)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*series, 0, len(accounts))
+	for _, acc := range accounts {
+		class, kind := accountClass(acc.Type)
+		sr := &series{class: class, kind: kind}
+		for _, snapshot := range snapshots[acc.ID] {
+			value, err := strconv.ParseFloat(snapshot.Balance, 64)
+			if err != nil {
+				logger.ErrorWithFields("failed to parse balance snapshot", err, "snapshot_id", snapshot.ID, "balance", snapshot.Balance)
+				continue
+			}
+			if kind == LiabilityAssetKind {
+				value = math.Abs(value)
+			}
+			sr.values = append(sr.values, datedValue{date: snapshot.BalanceDate, value: value})
+		}
+		res = append(res, sr)
+	}
+
+	return res, nil
+}
+
+func validateAsset(asset *Asset) error {
+	if asset.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	switch asset.Kind {
+	case AssetAssetKind, LiabilityAssetKind:
+	default:
+		return fmt.Errorf("unknown kind: %s", asset.Kind)
+	}
+
+	switch asset.AssetClass {
+	case CashAssetClass, SavingsAssetClass, InvestmentAssetClass, PensionAssetClass, PropertyAssetClass,
+		VehicleAssetClass, MortgageAssetClass, LoanAssetClass, CreditCardAssetClass, OtherAssetClass:
+	default:
+		return fmt.Errorf("unknown asset class: %s", asset.AssetClass)
+	}
+
+	if len(asset.Currency) != 3 {
+		return fmt.Errorf("invalid currency: %s", asset.Currency)
+	}
+
+	return nil
+}
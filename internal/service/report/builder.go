@@ -0,0 +1,202 @@
+package report
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+)
+
+type monthTransactions struct {
+	start        time.Time
+	transactions []transaction.EnrichedTransaction
+	// categoryNames maps category ids to the name spend is totalled under.
+	categoryNames map[int64]string
+}
+
+type monthTotals struct {
+	income     float64
+	outcome    float64
+	categories map[string]float64
+}
+
+// totals normalises amounts by transaction type since banks disagree on the sign of outcomes.
+func (m *monthTransactions) totals() monthTotals {
+	res := monthTotals{categories: map[string]float64{}}
+	for _, tr := range m.transactions {
+		amount, ok := parseAmount(&tr)
+		if !ok {
+			continue
+		}
+
+		switch tr.Type {
+		case transaction.IncomeTransactionType:
+			res.income += amount
+		case transaction.OutcomeTransactionType:
+			res.outcome += amount
+			res.categories[m.categoryName(&tr)] += amount
+		}
+	}
+
+	return res
+}
+
+func (m *monthTransactions) categoryName(tr *transaction.EnrichedTransaction) string {
+	if name, ok := m.categoryNames[tr.CategoryID]; ok {
+		return name
+	}
+
+	return tr.CategoryName
+}
+
+// buildReport summarises the reported months; previous is the month before the period, if known,
+// used for category month-over-month comparison of monthly reports.
+func buildReport(title string, period Period, months []monthTransactions, previous *monthTransactions) *Report {
+	from := months[0].start
+	report := &Report{
+		Title:       title,
+		Period:      period,
+		From:        from,
+		To:          months[len(months)-1].start.AddDate(0, 1, -1),
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	categories := map[string]float64{}
+	merchants := map[string]*MerchantTotal{}
+	largest := make([]LargeTransaction, 0)
+
+	var prevTotals *monthTotals
+	if previous != nil {
+		totals := previous.totals()
+		prevTotals = &totals
+		report.Changes = append(report.Changes, PeriodChange{
+			Label:   previous.start.Format("Jan 2006"),
+			Income:  round2(totals.income),
+			Outcome: round2(totals.outcome),
+			Net:     round2(totals.income - totals.outcome),
+		})
+	}
+
+	for i := range months {
+		totals := months[i].totals()
+		report.TotalIncome += totals.income
+		report.TotalOutcome += totals.outcome
+		report.TransactionCount += len(months[i].transactions)
+		for name, amount := range totals.categories {
+			categories[name] += amount
+		}
+
+		change := PeriodChange{
+			Label:   months[i].start.Format("Jan 2006"),
+			Income:  round2(totals.income),
+			Outcome: round2(totals.outcome),
+			Net:     round2(totals.income - totals.outcome),
+		}
+		if len(report.Changes) > 0 {
+			change.OutcomeChange = percentChange(report.Changes[len(report.Changes)-1].Outcome, totals.outcome)
+		}
+		report.Changes = append(report.Changes, change)
+
+		for _, tr := range months[i].transactions {
+			if tr.Type != transaction.OutcomeTransactionType {
+				continue
+			}
+			amount, ok := parseAmount(&tr)
+			if !ok {
+				continue
+			}
+
+			key := strings.ToLower(strings.TrimSpace(tr.Description))
+			merchant, ok := merchants[key]
+			if !ok {
+				merchant = &MerchantTotal{Name: strings.TrimSpace(tr.Description)}
+				merchants[key] = merchant
+			}
+			merchant.Amount += amount
+			merchant.Count++
+
+			largest = append(largest, LargeTransaction{
+				Date:        tr.TransactionDate,
+				Description: tr.Description,
+				Category:    tr.CategoryName,
+				Bank:        tr.BankName,
+				Amount:      round2(amount),
+			})
+		}
+	}
+
+	report.Net = round2(report.TotalIncome - report.TotalOutcome)
+	report.TotalIncome = round2(report.TotalIncome)
+	report.TotalOutcome = round2(report.TotalOutcome)
+
+	for name, amount := range categories {
+		category := CategoryTotal{
+			Name:   name,
+			Amount: round2(amount),
+		}
+		if report.TotalOutcome > 0 {
+			category.Share = round2(amount / report.TotalOutcome * 100)
+		}
+		if prevTotals != nil {
+			category.PreviousTotal = round2(prevTotals.categories[name])
+			category.Change = percentChange(prevTotals.categories[name], amount)
+		}
+		report.Categories = append(report.Categories, category)
+	}
+	sort.Slice(report.Categories, func(i, j int) bool {
+		return report.Categories[i].Amount > report.Categories[j].Amount
+	})
+
+	for _, merchant := range merchants {
+		merchant.Amount = round2(merchant.Amount)
+		report.TopMerchants = append(report.TopMerchants, *merchant)
+	}
+	sort.Slice(report.TopMerchants, func(i, j int) bool {
+		return report.TopMerchants[i].Amount > report.TopMerchants[j].Amount
+	})
+	if len(report.TopMerchants) > topLimit {
+		report.TopMerchants = report.TopMerchants[:topLimit]
+	}
+
+	sort.Slice(largest, func(i, j int) bool {
+		return largest[i].Amount > largest[j].Amount
+	})
+	if len(largest) > topLimit {
+		largest = largest[:topLimit]
+	}
+	report.LargestTransactions = largest
+
+	// The previous month only provides the baseline for the first change.
+	if previous != nil && period == AnnualPeriod {
+		report.Changes = report.Changes[1:]
+	}
+
+	return report
+}
+
+func parseAmount(tr *transaction.EnrichedTransaction) (float64, bool) {
+	amount, err := strconv.ParseFloat(tr.Amount, 64)
+	if err != nil {
+		logger.ErrorWithFields("failed to parse transaction amount", err, "transaction_id", tr.ID, "amount", tr.Amount)
+		return 0, false
+	}
+
+	return math.Abs(amount), true
+}
+
+func percentChange(previous, current float64) *float64 {
+	if previous == 0 {
+		return nil
+	}
+
+	change := round2((current - previous) / previous * 100)
+	return &change
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
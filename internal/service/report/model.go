@@ -0,0 +1,81 @@
+package report
+
+import "time"
+
+// topLimit caps the merchant and largest transaction sections.
+const topLimit = 10
+
+type Period string
+
+const (
+	MonthlyPeriod Period = "MONTHLY"
+	AnnualPeriod  Period = "ANNUAL"
+)
+
+type Format string
+
+const (
+	HTMLFormat Format = "HTML"
+	PDFFormat  Format = "PDF"
+)
+
+type ReportRequest struct {
+	Period Period
+	Year   int32
+	Month  int32
+	Format Format
+}
+
+type CategoryTotal struct {
+	Name          string
+	Amount        float64
+	Share         float64
+	PreviousTotal float64
+	Change        *float64
+}
+
+type MerchantTotal struct {
+	Name   string
+	Amount float64
+	Count  int
+}
+
+type LargeTransaction struct {
+	Date        time.Time
+	Description string
+	Category    string
+	Bank        string
+	Amount      float64
+}
+
+// PeriodChange is the month-over-month movement of income and spending; for a monthly report
+// it holds the previous and the reported month, for an annual report every month of the year.
+type PeriodChange struct {
+	Label         string
+	Income        float64
+	Outcome       float64
+	Net           float64
+	OutcomeChange *float64
+}
+
+type Report struct {
+	Title               string
+	Period              Period
+	From                time.Time
+	To                  time.Time
+	GeneratedAt         time.Time
+	TransactionCount    int
+	TotalIncome         float64
+	TotalOutcome        float64
+	Net                 float64
+	Categories          []CategoryTotal
+	TopMerchants        []MerchantTotal
+	LargestTransactions []LargeTransaction
+	Changes             []PeriodChange
+}
+
+type Document struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
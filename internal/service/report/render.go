@@ -0,0 +1,159 @@
+package report
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"strconv"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+//go:embed templates/report.html
+var templatesFS embed.FS
+
+var htmlTemplate = template.Must(template.New("report.html").Funcs(template.FuncMap{
+	"amount":      formatAmount,
+	"change":      formatChange,
+	"changeClass": changeClass,
+}).ParseFS(templatesFS, "templates/report.html"))
+
+func renderHTML(report *Report) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, report); err != nil {
+		return nil, fmt.Errorf("failed to render html report: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// renderPDF lays the same sections out as the HTML template using the core PDF fonts.
+func renderPDF(report *Report) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 10, tr(report.Title), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.SetTextColor(119, 119, 119)
+	pdf.CellFormat(0, 6, fmt.Sprintf("%s - %s, generated %s UTC",
+		report.From.Format("2 Jan 2006"),
+		report.To.Format("2 Jan 2006"),
+		report.GeneratedAt.Format("2 Jan 2006 15:04"),
+	), "", 1, "L", false, 0, "")
+	pdf.SetTextColor(34, 34, 34)
+	pdf.Ln(4)
+
+	pdfTable(pdf, tr, []string{"Income", "Spending", "Net", "Transactions"}, []float64{45, 45, 45, 45}, 0, [][]string{{
+		formatAmount(report.TotalIncome),
+		formatAmount(report.TotalOutcome),
+		formatAmount(report.Net),
+		strconv.Itoa(report.TransactionCount),
+	}})
+
+	pdfHeading(pdf, "Spending by category")
+	if report.Period == MonthlyPeriod {
+		rows := make([][]string, len(report.Categories))
+		for i, c := range report.Categories {
+			rows[i] = []string{c.Name, formatAmount(c.Amount), fmt.Sprintf("%.1f%%", c.Share), formatAmount(c.PreviousTotal), formatChange(c.Change)}
+		}
+		pdfTable(pdf, tr, []string{"Category", "Amount", "Share", "Previous month", "Change"}, []float64{60, 30, 25, 35, 30}, 1, rows)
+	} else {
+		rows := make([][]string, len(report.Categories))
+		for i, c := range report.Categories {
+			rows[i] = []string{c.Name, formatAmount(c.Amount), fmt.Sprintf("%.1f%%", c.Share)}
+		}
+		pdfTable(pdf, tr, []string{"Category", "Amount", "Share"}, []float64{100, 45, 35}, 1, rows)
+	}
+
+	pdfHeading(pdf, "Month over month")
+	changeRows := make([][]string, len(report.Changes))
+	for i, c := range report.Changes {
+		changeRows[i] = []string{c.Label, formatAmount(c.Income), formatAmount(c.Outcome), formatAmount(c.Net), formatChange(c.OutcomeChange)}
+	}
+	pdfTable(pdf, tr, []string{"Month", "Income", "Spending", "Net", "Spending change"}, []float64{40, 35, 35, 35, 35}, 1, changeRows)
+
+	pdfHeading(pdf, "Top merchants")
+	merchantRows := make([][]string, len(report.TopMerchants))
+	for i, m := range report.TopMerchants {
+		merchantRows[i] = []string{m.Name, strconv.Itoa(m.Count), formatAmount(m.Amount)}
+	}
+	pdfTable(pdf, tr, []string{"Merchant", "Transactions", "Amount"}, []float64{110, 35, 35}, 1, merchantRows)
+
+	pdfHeading(pdf, "Largest transactions")
+	largestRows := make([][]string, len(report.LargestTransactions))
+	for i, t := range report.LargestTransactions {
+		largestRows[i] = []string{t.Date.Format("02 Jan 2006"), t.Description, t.Category, t.Bank, formatAmount(t.Amount)}
+	}
+	pdfTable(pdf, tr, []string{"Date", "Description", "Category", "Bank", "Amount"}, []float64{25, 70, 35, 25, 25}, 4, largestRows)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render pdf report: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func pdfHeading(pdf *gofpdf.Fpdf, title string) {
+	pdf.Ln(6)
+	pdf.SetFont("Helvetica", "B", 13)
+	pdf.CellFormat(0, 8, title, "B", 1, "L", false, 0, "")
+	pdf.Ln(1)
+}
+
+// pdfTable left-aligns the first leftColumns text columns and right-aligns the amounts, truncating text that does not fit.
+func pdfTable(pdf *gofpdf.Fpdf, tr func(string) string, header []string, widths []float64, leftColumns int, rows [][]string) {
+	pdf.SetFont("Helvetica", "B", 9)
+	for i, h := range header {
+		pdf.CellFormat(widths[i], 7, h, "B", 0, cellAlign(i, leftColumns), false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, row := range rows {
+		for i, value := range row {
+			value = tr(value)
+			for len(value) > 0 && pdf.GetStringWidth(value) > widths[i]-2 {
+				value = value[:len(value)-1]
+			}
+			pdf.CellFormat(widths[i], 6, value, "", 0, cellAlign(i, leftColumns), false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+}
+
+func cellAlign(column, leftColumns int) string {
+	if column < leftColumns {
+		return "L"
+	}
+
+	return "R"
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+func formatChange(change *float64) string {
+	if change == nil {
+		return "-"
+	}
+
+	return fmt.Sprintf("%+.1f%%", *change)
+}
+
+func changeClass(change *float64) string {
+	switch {
+	case change == nil:
+		return ""
+	case *change > 0:
+		return "up"
+	default:
+		return "down"
+	}
+}
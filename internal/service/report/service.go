@@ -0,0 +1,223 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	transactionService *transaction.Service
+	categoryService    *category.Service
+}
+
+func NewService(transactionService *transaction.Service, categoryService *category.Service) *Service {
+	return &Service{
+		transactionService: transactionService,
+		categoryService:    categoryService,
+	}
+}
+
+func (s *Service) GenerateReport(ctx context.Context, req *ReportRequest) (*Document, error) {
+	report, err := s.buildReport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Format {
+	case HTMLFormat:
+		content, err := renderHTML(report)
+		if err != nil {
+			logger.Error("failed to render html report", err)
+			return nil, status.Errorf(codes.Internal, "failed to generate report")
+		}
+		return &Document{
+			FileName:    reportFileName(req) + ".html",
+			ContentType: "text/html; charset=utf-8",
+			Content:     content,
+		}, nil
+	case PDFFormat:
+		content, err := renderPDF(report)
+		if err != nil {
+			logger.Error("failed to render pdf report", err)
+			return nil, status.Errorf(codes.Internal, "failed to generate report")
+		}
+		return &Document{
+			FileName:    reportFileName(req) + ".pdf",
+			ContentType: "application/pdf",
+			Content:     content,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown report format: %s", req.Format)
+	}
+}
+
+// RunScheduler writes the statement for the previous month and the review of the previous year
+// to dir on start and then on every tick, skipping reports that were already written.
+func (s *Service) RunScheduler(ctx context.Context, dir string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.writeDueReports(ctx, dir, time.Now().UTC())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) writeDueReports(ctx context.Context, dir string, now time.Time) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		logger.ErrorWithFields("failed to create report directory", err, "dir", dir)
+		return
+	}
+
+	lastMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+	due := []ReportRequest{
+		{Period: MonthlyPeriod, Year: int32(lastMonth.Year()), Month: int32(lastMonth.Month())},
+		{Period: AnnualPeriod, Year: int32(now.Year() - 1)},
+	}
+
+	for _, req := range due {
+		for _, format := range []Format{HTMLFormat, PDFFormat} {
+			req.Format = format
+			if err := s.writeReport(ctx, dir, &req); err != nil {
+				logger.ErrorWithFields("failed to write scheduled report", err, "period", req.Period, "year", req.Year, "month", req.Month, "format", format)
+			}
+		}
+	}
+}
+
+func (s *Service) writeReport(ctx context.Context, dir string, req *ReportRequest) error {
+	ext := ".html"
+	if req.Format == PDFFormat {
+		ext = ".pdf"
+	}
+
+	path := filepath.Join(dir, reportFileName(req)+ext)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	doc, err := s.GenerateReport(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a partially written report is never picked up as done.
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, doc.Content, 0o644); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return err
+	}
+
+	logger.Info("report written", "path", path)
+
+	return nil
+}
+
+func (s *Service) buildReport(ctx context.Context, req *ReportRequest) (*Report, error) {
+	switch req.Period {
+	case MonthlyPeriod:
+		if req.Month < 1 || req.Month > 12 || req.Year <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid month or year")
+		}
+
+		start := time.Date(int(req.Year), time.Month(req.Month), 1, 0, 0, 0, 0, time.UTC)
+		current, err := s.monthTransactions(ctx, start)
+		if err != nil {
+			return nil, err
+		}
+		previous, err := s.monthTransactions(ctx, start.AddDate(0, -1, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		title := fmt.Sprintf("Household statement %s", start.Format("January 2006"))
+		return buildReport(title, MonthlyPeriod, []monthTransactions{*current}, previous), nil
+	case AnnualPeriod:
+		if req.Year <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid year")
+		}
+
+		start := time.Date(int(req.Year), time.January, 1, 0, 0, 0, 0, time.UTC)
+		previous, err := s.monthTransactions(ctx, start.AddDate(0, -1, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		months := make([]monthTransactions, 0, 12)
+		for i := 0; i < 12; i++ {
+			month, err := s.monthTransactions(ctx, start.AddDate(0, i, 0))
+			if err != nil {
+				return nil, err
+			}
+			months = append(months, *month)
+		}
+
+		title := fmt.Sprintf("Year in review %d", req.Year)
+		return buildReport(title, AnnualPeriod, months, previous), nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown report period: %s", req.Period)
+	}
+}
+
+func (s *Service) monthTransactions(ctx context.Context, start time.Time) (*monthTransactions, error) {
+	summary, err := s.transactionService.GetSummaryTransactions(ctx, int32(start.Month()), int32(start.Year()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Transfers and excluded categories are left out of statements.
+	store := s.categoryService.Store()
+	transactions := make([]transaction.EnrichedTransaction, 0, len(summary.Transactions))
+	for _, tr := range summary.Transactions {
+		if store.GetKind(tr.CategoryID).Counted() {
+			transactions = append(transactions, tr)
+		}
+	}
+
+	// Spending by category is reported per top-level category, with subcategories rolled up into it.
+	categoryNames := map[int64]string{}
+	for _, tr := range transactions {
+		if _, ok := categoryNames[tr.CategoryID]; ok {
+			continue
+		}
+
+		categoryNames[tr.CategoryID] = tr.CategoryName
+		rootID, _ := store.RollUp(tr.CategoryID, nil)
+		if root := store.GetCategory(rootID); root != nil {
+			categoryNames[tr.CategoryID] = root.Name
+		}
+	}
+
+	return &monthTransactions{
+		start:         start,
+		transactions:  transactions,
+		categoryNames: categoryNames,
+	}, nil
+}
+
+func reportFileName(req *ReportRequest) string {
+	if req.Period == AnnualPeriod {
+		return fmt.Sprintf("year-in-review-%d", req.Year)
+	}
+
+	return fmt.Sprintf("statement-%d-%02d", req.Year, req.Month)
+}
@@ -0,0 +1,205 @@
+package rule
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+)
+
+// compile validates the rule and prepares its conditions for matching.
+func compile(rule *Rule) (compiledRule, error) {
+	compiled := compiledRule{
+		rule:       rule,
+		conditions: make([]compiledCondition, len(rule.Conditions)),
+	}
+
+	for i, condition := range rule.Conditions {
+		c, err := compileCondition(condition)
+		if err != nil {
+			return compiledRule{}, fmt.Errorf("condition %d: %w", i+1, err)
+		}
+		compiled.conditions[i] = c
+	}
+
+	return compiled, nil
+}
+
+func compileCondition(condition Condition) (compiledCondition, error) {
+	c := compiledCondition{Condition: condition}
+
+	switch condition.Type {
+	case ContainsConditionType, ExactConditionType:
+		c.value = strings.ToLower(strings.TrimSpace(condition.Value))
+		if c.value == "" {
+			return c, fmt.Errorf("%s value is required", condition.Type)
+		}
+	case RegexConditionType:
+		if condition.Value == "" {
+			return c, fmt.Errorf("%s value is required", condition.Type)
+		}
+		re, err := regexp.Compile(condition.Value)
+		if err != nil {
+			return c, fmt.Errorf("invalid regex %q: %w", condition.Value, err)
+		}
+		c.re = re
+	case AmountRangeConditionType:
+		if condition.MinAmount == nil && condition.MaxAmount == nil {
+			return c, fmt.Errorf("%s needs a min or max amount", condition.Type)
+		}
+		var err error
+		if c.minAmount, err = parseBound(condition.MinAmount); err != nil {
+			return c, err
+		}
+		if c.maxAmount, err = parseBound(condition.MaxAmount); err != nil {
+			return c, err
+		}
+		if c.minAmount != nil && c.maxAmount != nil && *c.minAmount > *c.maxAmount {
+			return c, fmt.Errorf("min amount is greater than max amount")
+		}
+	case BankConditionType:
+		if condition.BankID == nil || *condition.BankID <= 0 {
+			return c, fmt.Errorf("%s bank id is required", condition.Type)
+		}
+	case UserConditionType:
+		if condition.UserID == nil || *condition.UserID <= 0 {
+			return c, fmt.Errorf("%s user id is required", condition.Type)
+		}
+	case SignConditionType:
+		if condition.Sign != PositiveSign && condition.Sign != NegativeSign {
+			return c, fmt.Errorf("invalid sign %q", condition.Sign)
+		}
+	case DayOfMonthConditionType:
+		if !validDay(condition.FromDay) || !validDay(condition.ToDay) {
+			return c, fmt.Errorf("%s needs from and to days between 1 and 31", condition.Type)
+		}
+	default:
+		return c, fmt.Errorf("unknown condition type %q", condition.Type)
+	}
+
+	return c, nil
+}
+
+func parseBound(bound *string) (*float64, error) {
+	if bound == nil {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(*bound), 64)
+	if err != nil || value < 0 {
+		return nil, fmt.Errorf("invalid amount %q", *bound)
+	}
+
+	return &value, nil
+}
+
+func validDay(day *int32) bool {
+	return day != nil && *day >= 1 && *day <= 31
+}
+
+// evaluate applies the rules in order to the transaction and the text it is categorised by.
+func evaluate(rules []compiledRule, tr *transaction.Transaction, text string) *Result {
+	res := &Result{}
+	lowerText := strings.ToLower(text)
+	amount, amountErr := strconv.ParseFloat(strings.TrimSpace(tr.Amount), 64)
+
+	for i := range rules {
+		r := &rules[i]
+		matched, ok := r.matches(tr, text, lowerText, amount, amountErr == nil)
+		if !ok {
+			continue
+		}
+
+		res.RuleIDs = append(res.RuleIDs, r.rule.ID)
+
+		actions := r.rule.Actions
+		if res.CategoryID == nil && actions.CategoryID != nil {
+			res.CategoryID = actions.CategoryID
+			res.Source = transaction.RuleCategorySource
+			res.CategoryRuleID = &r.rule.ID
+			res.MatchedText = matched
+		}
+		if res.Type == nil && actions.Type != nil {
+			res.Type = actions.Type
+		}
+		if res.Merchant == nil && actions.Merchant != nil {
+			res.Merchant = actions.Merchant
+		}
+		res.Tags = appendUnique(res.Tags, actions.Tags...)
+	}
+
+	return res
+}
+
+// matches reports whether all conditions hold, and returns the text matched by the first text condition.
+func (r *compiledRule) matches(tr *transaction.Transaction, text, lowerText string, amount float64, hasAmount bool) (string, bool) {
+	var matched string
+	for i := range r.conditions {
+		c := &r.conditions[i]
+
+		var ok bool
+		switch c.Type {
+		case ContainsConditionType:
+			ok = strings.Contains(lowerText, c.value)
+			if ok && matched == "" {
+				matched = c.value
+			}
+		case ExactConditionType:
+			ok = strings.TrimSpace(lowerText) == c.value
+			if ok && matched == "" {
+				matched = c.value
+			}
+		case RegexConditionType:
+			loc := c.re.FindStringIndex(text)
+			ok = loc != nil
+			if ok && matched == "" {
+				matched = text[loc[0]:loc[1]]
+			}
+		case AmountRangeConditionType:
+			abs := math.Abs(amount)
+			ok = hasAmount && (c.minAmount == nil || abs >= *c.minAmount) && (c.maxAmount == nil || abs <= *c.maxAmount)
+		case BankConditionType:
+			ok = tr.BankID == *c.BankID
+		case UserConditionType:
+			ok = tr.UserID == *c.UserID
+		case SignConditionType:
+			ok = hasAmount && ((c.Sign == PositiveSign && amount > 0) || (c.Sign == NegativeSign && amount < 0))
+		case DayOfMonthConditionType:
+			ok = !tr.TransactionDate.IsZero() && dayInRange(int32(tr.TransactionDate.Day()), *c.FromDay, *c.ToDay)
+		}
+
+		if !ok {
+			return "", false
+		}
+	}
+
+	return matched, true
+}
+
+func dayInRange(day, from, to int32) bool {
+	if from <= to {
+		return day >= from && day <= to
+	}
+
+	return day >= from || day <= to
+}
+
+func appendUnique(tags []string, add ...string) []string {
+	for _, tag := range add {
+		found := false
+		for _, existing := range tags {
+			if existing == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
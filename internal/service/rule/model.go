@@ -0,0 +1,179 @@
+package rule
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+)
+
+const (
+	ruleTable       = "categorization_rule"
+	suggestionTable = "category_suggestion"
+)
+
+// hitFlushInterval is how often rule hit counts collected in memory are added to the stored counts.
+const hitFlushInterval = time.Minute
+
+type ConditionType string
+
+const (
+	// ContainsConditionType matches when the text contains the value, ignoring case.
+	ContainsConditionType ConditionType = "CONTAINS"
+	// RegexConditionType matches the text against an RE2 pattern as written; prefix it with (?i) to ignore case.
+	RegexConditionType ConditionType = "REGEX"
+	// ExactConditionType matches when the trimmed text equals the value, ignoring case.
+	ExactConditionType       ConditionType = "EXACT"
+	AmountRangeConditionType ConditionType = "AMOUNT_RANGE"
+	BankConditionType        ConditionType = "BANK"
+	UserConditionType        ConditionType = "USER"
+	SignConditionType        ConditionType = "SIGN"
+	DayOfMonthConditionType  ConditionType = "DAY_OF_MONTH"
+)
+
+type Sign string
+
+const (
+	PositiveSign Sign = "POSITIVE"
+	NegativeSign Sign = "NEGATIVE"
+)
+
+// Condition is one check of a rule, only the fields of its type are set.
+type Condition struct {
+	Type  ConditionType `json:"type"`
+	Value string        `json:"value,omitempty"`
+	// MinAmount and MaxAmount bound the absolute amount, both inclusive and optional.
+	MinAmount *string `json:"min_amount,omitempty"`
+	MaxAmount *string `json:"max_amount,omitempty"`
+	BankID    *int64  `json:"bank_id,omitempty"`
+	UserID    *int64  `json:"user_id,omitempty"`
+	Sign      Sign    `json:"sign,omitempty"`
+	// FromDay and ToDay are inclusive; a range such as 28-3 wraps around the end of the month.
+	FromDay *int32 `json:"from_day,omitempty"`
+	ToDay   *int32 `json:"to_day,omitempty"`
+}
+
+type Actions struct {
+	CategoryID *int64                       `json:"category_id,omitempty"`
+	Type       *transaction.TransactionType `json:"type,omitempty"`
+	Tags       []string                     `json:"tags,omitempty"`
+	Merchant   *string                      `json:"merchant,omitempty"`
+}
+
+// Rule matches when all its conditions hold. Rules are evaluated by ascending priority, then by id.
+type Rule struct {
+	ID         int64
+	Name       string
+	Priority   int32
+	Conditions []Condition
+	Actions    Actions
+	Enabled    bool
+	// HitCount is how many transactions the rule matched, so rules that never match can be pruned.
+	HitCount  int64
+	LastHitAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+type RuleFilter struct {
+	// MaxHitCount limits the list to rules that matched at most this many transactions.
+	MaxHitCount *int64
+}
+
+type RuleUpdateData struct {
+	ID         int64
+	Name       *string
+	Priority   *int32
+	Conditions []Condition
+	Actions    *Actions
+	Enabled    *bool
+}
+
+// Result is what the matching rules set on a transaction. Category, type and merchant come from
+// the first matching rule that sets them, tags are collected from every matching rule. When no rule set
+// the type, the kind of the category may.
+type Result struct {
+	CategoryID *int64
+	Type       *transaction.TransactionType
+	Tags       []string
+	Merchant   *string
+	RuleIDs    []int64
+	// Source says what set the category, empty when nothing did. CategoryRuleID is the rule that set it and
+	// MatchedText what matched: the text of the first text condition of the rule, the bank category or the keyword.
+	Source         transaction.CategorySource
+	CategoryRuleID *int64
+	MatchedText    string
+	// Confidence is set when the category was predicted by the classifier.
+	Confidence *float64
+}
+
+// Explanation tells how a transaction got its stored category and what categorising it again would give.
+type Explanation struct {
+	Transaction *transaction.EnrichedTransaction
+	// Current is the outcome of the current rules, mappings, keywords and classifier, it is not applied.
+	Current *Result
+	// KeywordMatches are all keywords found in the text, the first in matching order decides.
+	KeywordMatches []category.KeywordMatch
+	// RuleNames names the rules referenced by the stored provenance and by Current.
+	RuleNames map[int64]string
+}
+
+type SuggestionStatus string
+
+const (
+	PendingSuggestionStatus  SuggestionStatus = "PENDING"
+	AcceptedSuggestionStatus SuggestionStatus = "ACCEPTED"
+	RejectedSuggestionStatus SuggestionStatus = "REJECTED"
+)
+
+// SuggestionKind is what an accepted suggestion becomes.
+type SuggestionKind string
+
+const (
+	KeywordSuggestionKind SuggestionKind = "KEYWORD"
+	RuleSuggestionKind    SuggestionKind = "RULE"
+)
+
+// Suggestion is a keyword or rule proposed from manual category corrections. Repeated corrections to the same
+// pattern and category add up in Occurrences of the pending suggestion.
+type Suggestion struct {
+	ID            int64
+	Pattern       string
+	CategoryID    int64
+	TransactionID int64
+	Description   string
+	Occurrences   int32
+	Status        SuggestionStatus
+	Kind          *SuggestionKind
+	KeywordID     *int64
+	RuleID        *int64
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+type SuggestionFilter struct {
+	Status *SuggestionStatus
+}
+
+type SuggestionAcceptData struct {
+	ID   int64
+	Kind SuggestionKind
+	// Pattern overrides the suggested pattern.
+	Pattern *string
+	// ApplyRetroactively moves uncategorized transactions whose description contains the pattern to the category.
+	ApplyRetroactively bool
+}
+
+type compiledRule struct {
+	rule       *Rule
+	conditions []compiledCondition
+}
+
+type compiledCondition struct {
+	Condition
+	value     string
+	re        *regexp.Regexp
+	minAmount *float64
+	maxAmount *float64
+}
@@ -0,0 +1,257 @@
+package rule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ruleColumns = []string{"id", "name", "priority", "conditions", "actions", "enabled", "hit_count", "last_hit_at", "created_at", "updated_at"}
+
+const ruleReturning = "RETURNING id, name, priority, conditions, actions, enabled, hit_count, last_hit_at, created_at, updated_at"
+
+var suggestionColumns = []string{
+	"id", "pattern", "category_id", "transaction_id", "description", "occurrences", "status", "kind", "keyword_id", "rule_id",
+	"created_at", "updated_at",
+}
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) getRule(ctx context.Context, id int64) (*Rule, error) {
+	query, args, err := squirrel.
+		Select(ruleColumns...).
+		From(ruleTable).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var rule Rule
+	if err = pgxscan.Get(ctx, r.dbPool, &rule, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (r *repository) ruleList(ctx context.Context, filter *RuleFilter) ([]Rule, error) {
+	queryBuilder := squirrel.
+		Select(ruleColumns...).
+		From(ruleTable).
+		OrderBy("priority", "id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter != nil && filter.MaxHitCount != nil {
+		queryBuilder = queryBuilder.Where(squirrel.LtOrEq{"hit_count": *filter.MaxHitCount})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var rules []Rule
+	if err = pgxscan.Select(ctx, r.dbPool, &rules, query, args...); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *repository) createRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	conditions, actions, err := marshalRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := squirrel.
+		Insert(ruleTable).
+		Columns("name", "priority", "conditions", "actions", "enabled").
+		Values(rule.Name, rule.Priority, conditions, actions, rule.Enabled).
+		Suffix(ruleReturning).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var created Rule
+	if err = pgxscan.Get(ctx, r.dbPool, &created, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *repository) updateRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	conditions, actions, err := marshalRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := squirrel.
+		Update(ruleTable).
+		Set("name", rule.Name).
+		Set("priority", rule.Priority).
+		Set("conditions", conditions).
+		Set("actions", actions).
+		Set("enabled", rule.Enabled).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": rule.ID}).
+		Suffix(ruleReturning).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var updated Rule
+	if err = pgxscan.Get(ctx, r.dbPool, &updated, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (r *repository) deleteRule(ctx context.Context, id int64) error {
+	tag, err := r.dbPool.Exec(ctx, "DELETE FROM categorization_rule WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// addHits adds hit counts collected since the last flush to the stored counts.
+func (r *repository) addHits(ctx context.Context, hits map[int64]int64, at time.Time) error {
+	batch := &pgx.Batch{}
+	for id, count := range hits {
+		batch.Queue("UPDATE categorization_rule SET hit_count = hit_count + $2, last_hit_at = $3 WHERE id = $1", id, count, at)
+	}
+
+	return r.dbPool.SendBatch(ctx, batch).Close()
+}
+
+func (r *repository) getSuggestion(ctx context.Context, id int64) (*Suggestion, error) {
+	query, args, err := squirrel.
+		Select(suggestionColumns...).
+		From(suggestionTable).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var suggestion Suggestion
+	if err = pgxscan.Get(ctx, r.dbPool, &suggestion, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &suggestion, nil
+}
+
+func (r *repository) suggestionList(ctx context.Context, filter *SuggestionFilter) ([]Suggestion, error) {
+	queryBuilder := squirrel.
+		Select(suggestionColumns...).
+		From(suggestionTable).
+		OrderBy("occurrences DESC", "id DESC").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.Status != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"status": *filter.Status})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var suggestions []Suggestion
+	if err = pgxscan.Select(ctx, r.dbPool, &suggestions, query, args...); err != nil {
+		return nil, err
+	}
+
+	return suggestions, nil
+}
+
+// saveSuggestion records a pending suggestion, or counts one more occurrence of the same pending suggestion.
+func (r *repository) saveSuggestion(ctx context.Context, suggestion *Suggestion) error {
+	query, args, err := squirrel.
+		Insert(suggestionTable).
+		Columns("pattern", "category_id", "transaction_id", "description").
+		Values(suggestion.Pattern, suggestion.CategoryID, suggestion.TransactionID, suggestion.Description).
+		Suffix(`ON CONFLICT (pattern, category_id) WHERE status = 'PENDING' DO UPDATE SET
+			occurrences = category_suggestion.occurrences + 1,
+			transaction_id = EXCLUDED.transaction_id,
+			description = EXCLUDED.description,
+			updated_at = CURRENT_TIMESTAMP`).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = r.dbPool.Exec(ctx, query, args...)
+	return err
+}
+
+// resolveSuggestion closes a pending suggestion; it returns pgx.ErrNoRows if the suggestion is no longer pending.
+func (r *repository) resolveSuggestion(ctx context.Context, suggestion *Suggestion) (*Suggestion, error) {
+	query, args, err := squirrel.
+		Update(suggestionTable).
+		Set("pattern", suggestion.Pattern).
+		Set("status", suggestion.Status).
+		Set("kind", suggestion.Kind).
+		Set("keyword_id", suggestion.KeywordID).
+		Set("rule_id", suggestion.RuleID).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": suggestion.ID, "status": PendingSuggestionStatus}).
+		Suffix("RETURNING " + strings.Join(suggestionColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var resolved Suggestion
+	if err = pgxscan.Get(ctx, r.dbPool, &resolved, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &resolved, nil
+}
+
+func marshalRule(rule *Rule) (string, string, error) {
+	conditions, err := json.Marshal(rule.Conditions)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal rule conditions: %w", err)
+	}
+
+	actions, err := json.Marshal(rule.Actions)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal rule actions: %w", err)
+	}
+
+	return string(conditions), string(actions), nil
+}
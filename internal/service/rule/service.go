@@ -0,0 +1,436 @@
+package rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/classifier"
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultPriority is used for rules created without a priority.
+const DefaultPriority = int32(100)
+
+const (
+	maxNameLen     = 100
+	maxMerchantLen = 100
+)
+
+type Service struct {
+	repo               *repository
+	store              *Store
+	categoryService    *category.Service
+	transactionService *transaction.Service
+	classifierService  *classifier.Service
+}
+
+func NewService(
+	dbPool *pgxpool.Pool,
+	categoryService *category.Service,
+	transactionService *transaction.Service,
+	classifierService *classifier.Service,
+) *Service {
+	return &Service{
+		repo:               newRepository(dbPool),
+		store:              NewStore(),
+		categoryService:    categoryService,
+		transactionService: transactionService,
+		classifierService:  classifierService,
+	}
+}
+
+func (s *Service) Initialize(ctx context.Context) error {
+	if err := s.reload(ctx); err != nil {
+		logger.Error("failed to load categorization rules", err)
+		return fmt.Errorf("service initialization failed: %w", err)
+	}
+
+	return nil
+}
+
+// reload compiles the enabled rules into the store. A stored rule that no longer compiles is skipped, not fatal.
+func (s *Service) reload(ctx context.Context) error {
+	rules, err := s.repo.ruleList(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	compiled := make([]compiledRule, 0, len(rules))
+	for i := range rules {
+		if !rules[i].Enabled {
+			continue
+		}
+
+		c, err := compile(&rules[i])
+		if err != nil {
+			logger.ErrorWithFields("skipping invalid categorization rule", err, "rule_id", rules[i].ID)
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+
+	s.store.Reload(compiled)
+
+	return nil
+}
+
+// Categorize evaluates the rules, the provider category mappings and then the category keywords against the
+// transaction and the text it is categorised by, and applies the outcome to the transaction. providerCategories
+// are the categories the bank reported for the transaction, if any. Mappings only decide the category when no rule
+// did, keywords when neither did, and the classifier only when none did and it is confident enough.
+func (s *Service) Categorize(tr *transaction.Transaction, text string, providerCategories []string) *Result {
+	res := s.match(tr, text, providerCategories)
+	s.store.RecordHits(res.RuleIDs)
+	apply(tr, res)
+
+	return res
+}
+
+// RecategorizeTransactions categorises existing transactions again with the current rules, keywords and
+// classifier. The text is the description and the merchant; the import row and the provider categories are not
+// kept. Transactions nothing matches keep their category.
+func (s *Service) RecategorizeTransactions(ctx context.Context, filter *transaction.RecategorizeFilter) (*transaction.RecategorizeResult, error) {
+	return s.transactionService.Recategorize(ctx, filter, func(tr *transaction.Transaction) {
+		res := s.match(tr, categorizationText(tr), nil)
+		if !filter.DryRun {
+			s.store.RecordHits(res.RuleIDs)
+		}
+		apply(tr, res)
+	})
+}
+
+// ExplainTransaction returns the stored categorisation provenance of a transaction together with what the current
+// rules, keywords and classifier would give it. Nothing is applied and no rule hits are recorded.
+func (s *Service) ExplainTransaction(ctx context.Context, id int64) (*Explanation, error) {
+	tr, err := s.transactionService.GetTransaction(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	current := &transaction.Transaction{
+		ID:              tr.ID,
+		BankID:          tr.BankID,
+		AccountID:       tr.AccountID,
+		UserID:          tr.UserID,
+		Amount:          tr.Amount,
+		CategoryID:      tr.CategoryID,
+		Description:     tr.Description,
+		Type:            tr.Type,
+		Merchant:        tr.Merchant,
+		TransactionDate: tr.TransactionDate,
+	}
+	text := categorizationText(current)
+	res := s.match(current, text, nil)
+
+	ruleIDs := append([]int64{}, res.RuleIDs...)
+	if tr.CategoryRuleID != nil {
+		ruleIDs = append(ruleIDs, *tr.CategoryRuleID)
+	}
+
+	ruleNames := make(map[int64]string, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		if _, ok := ruleNames[ruleID]; ok {
+			continue
+		}
+
+		rule, err := s.repo.getRule(ctx, ruleID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			logger.ErrorWithFields("failed to get categorization rule", err, "rule_id", ruleID)
+			return nil, psql.MapPostgresError("failed to get categorization rule", err)
+		}
+		ruleNames[ruleID] = rule.Name
+	}
+
+	return &Explanation{
+		Transaction:    tr,
+		Current:        res,
+		KeywordMatches: s.categoryService.Store().MatchKeywords(tr.UserID, text),
+		RuleNames:      ruleNames,
+	}, nil
+}
+
+// categorizationText is the text a stored transaction is categorised by: the import row is not kept, so it is the
+// description and the merchant.
+func categorizationText(tr *transaction.Transaction) string {
+	text := tr.Description
+	if tr.Merchant != nil {
+		text += " " + *tr.Merchant
+	}
+
+	return text
+}
+
+func (s *Service) match(tr *transaction.Transaction, text string, providerCategories []string) *Result {
+	res := evaluate(s.store.GetRules(), tr, text)
+
+	if res.CategoryID == nil {
+		for _, providerCategory := range providerCategories {
+			if categoryID, ok := s.categoryService.Store().GetProviderCategory(tr.BankID, providerCategory); ok {
+				res.CategoryID = &categoryID
+				res.Source = transaction.ProviderCategorySource
+				res.MatchedText = providerCategory
+				break
+			}
+		}
+	}
+
+	if res.CategoryID == nil {
+		if keyword, ok := s.categoryService.Store().MatchKeyword(tr.UserID, text); ok {
+			res.CategoryID = &keyword.CategoryID
+			res.Source = transaction.KeywordCategorySource
+			res.MatchedText = keyword.Name
+		}
+	}
+
+	if res.CategoryID == nil {
+		if prediction := s.classifierService.Classify(tr); prediction != nil {
+			res.CategoryID = &prediction.CategoryID
+			res.Source = transaction.ClassifierCategorySource
+			res.Confidence = &prediction.Confidence
+		}
+	}
+
+	if res.Type == nil && res.CategoryID != nil && *res.CategoryID != category.UncategorizedID {
+		res.Type = kindType(s.categoryService.Store().GetKind(*res.CategoryID))
+	}
+
+	return res
+}
+
+// kindType returns the transaction type implied by a category kind. Transfers, savings and excluded categories
+// move money either way, so they keep the type taken from the amount sign.
+func kindType(kind category.Kind) *transaction.TransactionType {
+	var t transaction.TransactionType
+	switch kind {
+	case category.ExpenseKind:
+		t = transaction.OutcomeTransactionType
+	case category.IncomeKind:
+		t = transaction.IncomeTransactionType
+	default:
+		return nil
+	}
+
+	return &t
+}
+
+func apply(tr *transaction.Transaction, res *Result) {
+	if res.CategoryID != nil {
+		tr.CategoryID = *res.CategoryID
+		tr.CategoryProvenance = transaction.NewCategoryProvenance(res.Source, res.CategoryRuleID, res.MatchedText, res.Confidence)
+	}
+	if res.Type != nil {
+		tr.Type = *res.Type
+	}
+	if res.Merchant != nil {
+		tr.Merchant = res.Merchant
+	}
+	if len(res.Tags) > 0 {
+		tr.Tags = appendUnique(tr.Tags, res.Tags...)
+	}
+}
+
+func (s *Service) CreateRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	rule.Name = strings.TrimSpace(rule.Name)
+	normalizeActions(&rule.Actions)
+
+	if err := s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.createRule(ctx, rule)
+	if err != nil {
+		logger.ErrorWithFields("failed to create categorization rule", err, "name", rule.Name)
+		return nil, psql.MapPostgresError("failed to create categorization rule", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return created, nil
+}
+
+func (s *Service) UpdateRule(ctx context.Context, data *RuleUpdateData) (*Rule, error) {
+	rule, err := s.repo.getRule(ctx, data.ID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get categorization rule", err, "rule_id", data.ID)
+		return nil, psql.MapPostgresError("failed to get categorization rule", err)
+	}
+
+	if data.Name != nil {
+		rule.Name = strings.TrimSpace(*data.Name)
+	}
+	if data.Priority != nil {
+		rule.Priority = *data.Priority
+	}
+	if len(data.Conditions) > 0 {
+		rule.Conditions = data.Conditions
+	}
+	if data.Actions != nil {
+		rule.Actions = *data.Actions
+		normalizeActions(&rule.Actions)
+	}
+	if data.Enabled != nil {
+		rule.Enabled = *data.Enabled
+	}
+
+	if err = s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.updateRule(ctx, rule)
+	if err != nil {
+		logger.ErrorWithFields("failed to update categorization rule", err, "rule_id", data.ID)
+		return nil, psql.MapPostgresError("failed to update categorization rule", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return updated, nil
+}
+
+func (s *Service) DeleteRule(ctx context.Context, id int64) error {
+	err := s.repo.deleteRule(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to delete categorization rule", err, "rule_id", id)
+		return psql.MapPostgresError("failed to delete categorization rule", err)
+	}
+
+	s.reloadAfterChange(ctx)
+
+	return nil
+}
+
+func (s *Service) RuleList(ctx context.Context, filter *RuleFilter) ([]Rule, error) {
+	rules, err := s.repo.ruleList(ctx, filter)
+	if err != nil {
+		logger.Error("failed to get categorization rules", err)
+		return nil, psql.MapPostgresError("failed to get categorization rules", err)
+	}
+
+	return rules, nil
+}
+
+// RunHitCounter adds the rule hits counted in memory to the stored hit counts until the context is cancelled.
+func (s *Service) RunHitCounter(ctx context.Context) {
+	ticker := time.NewTicker(hitFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.flushHits(ctx)
+		}
+	}
+}
+
+// flushHits stores the counted hits. Counts only guide pruning, so hits of a failed flush are dropped.
+func (s *Service) flushHits(ctx context.Context) {
+	hits := s.store.TakeHits()
+	if len(hits) == 0 {
+		return
+	}
+
+	if err := s.repo.addHits(ctx, hits, time.Now().UTC()); err != nil {
+		logger.Error("failed to save categorization rule hits", err)
+	}
+}
+
+// Reload refreshes the store from the database, for changes to rules or the categories they reference made
+// outside this service; a failed reload leaves the previous rules in use.
+func (s *Service) Reload(ctx context.Context) error {
+	return s.reload(ctx)
+}
+
+// reloadAfterChange refreshes the store once a change to rules or the categories they reference is committed;
+// a failure leaves the previous rules in use.
+func (s *Service) reloadAfterChange(ctx context.Context) {
+	if err := s.reload(ctx); err != nil {
+		logger.Error("failed to reload categorization rules", err)
+	}
+}
+
+func normalizeActions(actions *Actions) {
+	tags := make([]string, 0, len(actions.Tags))
+	for _, tag := range actions.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = appendUnique(tags, tag)
+		}
+	}
+	actions.Tags = tags
+
+	if actions.Merchant != nil {
+		merchant := strings.TrimSpace(*actions.Merchant)
+		actions.Merchant = &merchant
+	}
+}
+
+func (s *Service) validateRule(ctx context.Context, rule *Rule) error {
+	if rule.Name == "" || len(rule.Name) > maxNameLen {
+		return status.Errorf(codes.InvalidArgument, "invalid categorization rule: name is required and must be at most %d characters", maxNameLen)
+	}
+
+	if len(rule.Conditions) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid categorization rule: at least one condition is required")
+	}
+	if _, err := compile(rule); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid categorization rule: %v", err)
+	}
+
+	actions := rule.Actions
+	if actions.CategoryID == nil && actions.Type == nil && len(actions.Tags) == 0 && actions.Merchant == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid categorization rule: at least one action is required")
+	}
+	if actions.Type != nil {
+		switch *actions.Type {
+		case transaction.IncomeTransactionType, transaction.OutcomeTransactionType, transaction.UnspecifiedTransactionType:
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid categorization rule: unknown transaction type %s", *actions.Type)
+		}
+	}
+	if actions.Merchant != nil && (*actions.Merchant == "" || len(*actions.Merchant) > maxMerchantLen) {
+		return status.Errorf(codes.InvalidArgument, "invalid categorization rule: merchant must be 1 to %d characters", maxMerchantLen)
+	}
+	if actions.CategoryID != nil {
+		if _, err := s.categoryService.GetCategoryByID(ctx, *actions.CategoryID); err != nil {
+			return err
+		}
+		if err := s.categoryService.ValidateLeaf(*actions.CategoryID); err != nil {
+			return err
+		}
+		if err := validateOwner(rule, s.categoryService.Store().GetCategory(*actions.CategoryID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateOwner checks that a rule setting a user category only applies to transactions of its owner.
+func validateOwner(rule *Rule, c *category.Category) error {
+	if c == nil || c.UserID == nil {
+		return nil
+	}
+
+	for _, condition := range rule.Conditions {
+		if condition.Type == UserConditionType && condition.UserID != nil && *condition.UserID == *c.UserID {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.InvalidArgument,
+		"invalid categorization rule: category %d belongs to user %d, add a %s condition for that user", c.ID, *c.UserID, UserConditionType)
+}
@@ -0,0 +1,58 @@
+package rule
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+)
+
+// BenchmarkCategorize categorises a 100k row import with 500 category keywords and no rules, the way the uploader
+// does for every saved row.
+func BenchmarkCategorize(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	word := func() string {
+		letters := make([]byte, 4+rnd.Intn(6))
+		for i := range letters {
+			letters[i] = byte('a' + rnd.Intn(26))
+		}
+		return string(letters)
+	}
+
+	categories := []category.Category{{ID: category.UncategorizedID, Name: "Uncategorized"}}
+	for i := int64(2); i < 42; i++ {
+		categories = append(categories, category.Category{ID: i, Name: fmt.Sprintf("Category %d", i), Kind: category.ExpenseKind})
+	}
+	keywords := make([]category.CategoryKeyword, 500)
+	for i := range keywords {
+		keywords[i] = category.CategoryKeyword{ID: int64(i + 1), CategoryID: int64(i%40 + 2), Name: word() + " " + word()}
+	}
+	s := newTestService(categories, keywords)
+
+	transactions := make([]transaction.Transaction, 100000)
+	for i := range transactions {
+		description := fmt.Sprintf("CARD PAYMENT %s %s REF %d", word(), word(), rnd.Intn(1000000))
+		if rnd.Intn(2) == 0 {
+			description += " " + keywords[rnd.Intn(len(keywords))].Name
+		}
+		transactions[i] = transaction.Transaction{
+			UserID:          1,
+			BankID:          1,
+			Amount:          "-12.50",
+			CategoryID:      category.UncategorizedID,
+			Description:     description,
+			TransactionDate: time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	b.ResetTimer()
+	for range b.N {
+		for i := range transactions {
+			tr := transactions[i]
+			s.Categorize(&tr, tr.Description, nil)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package rule
+
+import "sync"
+
+// Store keeps the enabled rules compiled and in evaluation order, and counts rule hits until they are flushed.
+type Store struct {
+	mu    sync.RWMutex
+	rules []compiledRule
+
+	hitsMu sync.Mutex
+	hits   map[int64]int64
+}
+
+func NewStore() *Store {
+	return &Store{
+		hits: map[int64]int64{},
+	}
+}
+
+func (s *Store) Reload(rules []compiledRule) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rules = rules
+}
+
+func (s *Store) GetRules() []compiledRule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.rules
+}
+
+func (s *Store) RecordHits(ruleIDs []int64) {
+	s.hitsMu.Lock()
+	defer s.hitsMu.Unlock()
+
+	for _, id := range ruleIDs {
+		s.hits[id]++
+	}
+}
+
+// TakeHits returns the hits recorded since the previous call and resets them.
+func (s *Store) TakeHits() map[int64]int64 {
+	s.hitsMu.Lock()
+	defer s.hitsMu.Unlock()
+
+	hits := s.hits
+	s.hits = map[int64]int64{}
+
+	return hits
+}
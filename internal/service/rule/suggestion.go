@@ -0,0 +1,253 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/event"
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxPatternLen matches the longest category keyword.
+	maxPatternLen   = 30
+	maxPatternWords = 3
+	minPatternWord  = 3
+)
+
+var patternWordRe = regexp.MustCompile(`[\p{L}\p{N}&']+`)
+
+// noiseWords are payment boilerplate that tells nothing about the merchant.
+var noiseWords = map[string]bool{
+	"card":        true,
+	"payment":     true,
+	"purchase":    true,
+	"pos":         true,
+	"visa":        true,
+	"mastercard":  true,
+	"debit":       true,
+	"contactless": true,
+	"transaction": true,
+	"ref":         true,
+	"www":         true,
+}
+
+// HandleTransactionRecategorized turns a manual category correction into a pending keyword suggestion.
+func (s *Service) HandleTransactionRecategorized(ctx context.Context, e *event.Event) error {
+	payload, err := transaction.DecodeTransactionRecategorized(e)
+	if err != nil {
+		return err
+	}
+
+	tr := payload.Transaction
+	if tr.CategoryID == category.UncategorizedID {
+		return nil
+	}
+
+	pattern := derivePattern(tr.Description, tr.Merchant)
+	if pattern == "" {
+		return nil
+	}
+
+	for _, keyword := range s.categoryService.Store().GetKeywords() {
+		if keyword.Name == pattern && keyword.CategoryID == tr.CategoryID {
+			return nil
+		}
+	}
+
+	err = s.repo.saveSuggestion(ctx, &Suggestion{
+		Pattern:       pattern,
+		CategoryID:    tr.CategoryID,
+		TransactionID: tr.ID,
+		Description:   tr.Description,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save category suggestion: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) SuggestionList(ctx context.Context, filter *SuggestionFilter) ([]Suggestion, error) {
+	suggestions, err := s.repo.suggestionList(ctx, filter)
+	if err != nil {
+		logger.Error("failed to get category suggestions", err)
+		return nil, psql.MapPostgresError("failed to get category suggestions", err)
+	}
+
+	return suggestions, nil
+}
+
+// AcceptSuggestion adds the suggested pattern as a category keyword or as a rule, and optionally moves matching
+// uncategorized transactions to the category. It returns the number of moved transactions.
+func (s *Service) AcceptSuggestion(ctx context.Context, data *SuggestionAcceptData) (*Suggestion, int64, error) {
+	suggestion, err := s.pendingSuggestion(ctx, data.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if data.Pattern != nil {
+		suggestion.Pattern = strings.ToLower(strings.TrimSpace(*data.Pattern))
+	}
+	if suggestion.Pattern == "" || len(suggestion.Pattern) > maxPatternLen {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "invalid pattern: must be 1-%d characters", maxPatternLen)
+	}
+
+	kind := data.Kind
+	if kind == "" {
+		kind = KeywordSuggestionKind
+	}
+
+	// Keywords and rules learned for a user category must not categorise transactions of other users.
+	target, err := s.categoryService.GetCategoryByID(ctx, suggestion.CategoryID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch kind {
+	case KeywordSuggestionKind:
+		keyword, err := s.categoryService.AddKeyword(ctx, suggestionKeyword(suggestion, target))
+		if err != nil {
+			return nil, 0, err
+		}
+		suggestion.KeywordID = &keyword.ID
+	case RuleSuggestionKind:
+		conditions := []Condition{{Type: ContainsConditionType, Value: suggestion.Pattern}}
+		if target.UserID != nil {
+			conditions = append(conditions, Condition{Type: UserConditionType, UserID: target.UserID})
+		}
+
+		rule, err := s.CreateRule(ctx, &Rule{
+			Name:       fmt.Sprintf("Learned: %s", suggestion.Pattern),
+			Priority:   DefaultPriority,
+			Conditions: conditions,
+			Actions:    Actions{CategoryID: &suggestion.CategoryID},
+			Enabled:    true,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		suggestion.RuleID = &rule.ID
+	default:
+		return nil, 0, status.Errorf(codes.InvalidArgument, "invalid suggestion kind: %s", kind)
+	}
+
+	suggestion.Status = AcceptedSuggestionStatus
+	suggestion.Kind = &kind
+
+	accepted, err := s.repo.resolveSuggestion(ctx, suggestion)
+	if err != nil {
+		logger.ErrorWithFields("failed to accept category suggestion", err, "suggestion_id", data.ID)
+		return nil, 0, psql.MapPostgresError("failed to accept category suggestion", err)
+	}
+
+	if !data.ApplyRetroactively {
+		return accepted, 0, nil
+	}
+
+	provenance := transaction.NewCategoryProvenance(transaction.KeywordCategorySource, nil, accepted.Pattern, nil)
+	if accepted.RuleID != nil {
+		provenance = transaction.NewCategoryProvenance(transaction.RuleCategorySource, accepted.RuleID, accepted.Pattern, nil)
+	}
+
+	moved, err := s.transactionService.CategorizeUncategorized(ctx, accepted.Pattern, accepted.CategoryID, target.UserID, provenance)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return accepted, moved, nil
+}
+
+func (s *Service) RejectSuggestion(ctx context.Context, id int64) (*Suggestion, error) {
+	suggestion, err := s.pendingSuggestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion.Status = RejectedSuggestionStatus
+
+	rejected, err := s.repo.resolveSuggestion(ctx, suggestion)
+	if err != nil {
+		logger.ErrorWithFields("failed to reject category suggestion", err, "suggestion_id", id)
+		return nil, psql.MapPostgresError("failed to reject category suggestion", err)
+	}
+
+	return rejected, nil
+}
+
+func (s *Service) pendingSuggestion(ctx context.Context, id int64) (*Suggestion, error) {
+	suggestion, err := s.repo.getSuggestion(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to get category suggestion", err, "suggestion_id", id)
+		return nil, psql.MapPostgresError("failed to get category suggestion", err)
+	}
+
+	if suggestion.Status != PendingSuggestionStatus {
+		return nil, status.Errorf(codes.FailedPrecondition, "category suggestion is already %s", strings.ToLower(string(suggestion.Status)))
+	}
+
+	return suggestion, nil
+}
+
+// derivePattern proposes a keyword for a corrected transaction: the merchant when the description contains it,
+// otherwise the first words of the description that look like a name, skipping numbers, references and
+// payment boilerplate. The pattern is always a substring of the lowercased description, so it matches it again.
+func derivePattern(description string, merchant *string) string {
+	lower := strings.ToLower(strings.TrimSpace(description))
+
+	if merchant != nil {
+		name := strings.ToLower(strings.TrimSpace(*merchant))
+		if name != "" && len(name) <= maxPatternLen && strings.Contains(lower, name) {
+			return name
+		}
+	}
+
+	start, end, words := -1, -1, 0
+	for _, loc := range patternWordRe.FindAllStringIndex(lower, -1) {
+		significant := isPatternWord(lower[loc[0]:loc[1]])
+
+		if start < 0 {
+			if significant {
+				start, end, words = loc[0], loc[1], 1
+			}
+			continue
+		}
+
+		// Only words separated by a single space are joined, anything else would not match the description.
+		if !significant || lower[end:loc[0]] != " " || loc[1]-start > maxPatternLen || words == maxPatternWords {
+			break
+		}
+		end, words = loc[1], words+1
+	}
+
+	if start < 0 {
+		return ""
+	}
+
+	return lower[start:end]
+}
+
+func isPatternWord(word string) bool {
+	if len(word) < minPatternWord || len(word) > maxPatternLen || noiseWords[word] {
+		return false
+	}
+
+	return !strings.ContainsFunc(word, unicode.IsDigit)
+}
+
+// suggestionKeyword is the keyword an accepted suggestion adds; the keyword of a user category belongs to its owner.
+func suggestionKeyword(suggestion *Suggestion, target *category.Category) *category.CategoryKeyword {
+	return &category.CategoryKeyword{
+		CategoryID: suggestion.CategoryID,
+		Name:       suggestion.Pattern,
+		UserID:     target.UserID,
+	}
+}
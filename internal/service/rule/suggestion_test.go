@@ -0,0 +1,64 @@
+package rule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/classifier"
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+)
+
+// newTestService returns a rule service working on in-memory stores only.
+func newTestService(categories []category.Category, keywords []category.CategoryKeyword) *Service {
+	categoryService := category.NewService(nil)
+	categoryService.Store().ReloadCategoriesMap(categories)
+	categoryService.Store().ReloadKeywords(keywords)
+
+	return NewService(nil, categoryService, nil, classifier.NewService(nil, categoryService, nil))
+}
+
+func TestAcceptedKeywordSuggestionOnUserCategory(t *testing.T) {
+	owner, other := int64(7), int64(8)
+	ponyClub := category.Category{ID: 10, Name: "Pony club", Kind: category.ExpenseKind, UserID: &owner}
+
+	keyword := suggestionKeyword(&Suggestion{Pattern: "pony club", CategoryID: ponyClub.ID}, &ponyClub)
+	if keyword.UserID == nil || *keyword.UserID != owner {
+		t.Fatalf("keyword owner = %v, want %d", keyword.UserID, owner)
+	}
+	keyword.ID = 1
+
+	s := newTestService([]category.Category{
+		{ID: category.UncategorizedID, Name: "Uncategorized"},
+		ponyClub,
+	}, []category.CategoryKeyword{*keyword})
+
+	tests := []struct {
+		name         string
+		userID       int64
+		wantCategory *int64
+	}{
+		{name: "owner", userID: owner, wantCategory: &ponyClub.ID},
+		{name: "other user", userID: other},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &transaction.Transaction{
+				UserID:          tt.userID,
+				BankID:          1,
+				Amount:          "-40.00",
+				CategoryID:      category.UncategorizedID,
+				Description:     "PONY CLUB MEMBERSHIP",
+				TransactionDate: time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC),
+			}
+
+			res := s.Categorize(tr, tr.Description, nil)
+			switch {
+			case tt.wantCategory == nil && res.CategoryID != nil:
+				t.Fatalf("categorised as %d, want uncategorised", *res.CategoryID)
+			case tt.wantCategory != nil && (res.CategoryID == nil || *res.CategoryID != *tt.wantCategory):
+				t.Fatalf("category = %v, want %d", res.CategoryID, *tt.wantCategory)
+			}
+		})
+	}
+}
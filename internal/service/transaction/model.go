@@ -4,6 +4,11 @@ import "time"
 
 const (
 	transactionTable = "transaction"
+	// maxCategoryMatchLen is the longest matched text kept with the category provenance.
+	maxCategoryMatchLen = 100
+	// recategorizeBatchSize is the number of transactions read and updated at once when categorising again.
+	recategorizeBatchSize = 500
+	maxReportedChanges    = 1000
 )
 
 type TransactionField string
@@ -14,6 +19,11 @@ const (
 	CategoryTransactionField    TransactionField = "CATEGORY"
 	ExternalIDTransactionField  TransactionField = "EXTERNALID"
 	DescriptionTransactionField TransactionField = "DESCRIPTION"
+	BalanceTransactionField     TransactionField = "BALANCE"
+	// DebitTransactionField and CreditTransactionField are the money out and money in columns of banks
+	// exporting the amount in two columns.
+	DebitTransactionField  TransactionField = "DEBIT"
+	CreditTransactionField TransactionField = "CREDIT"
 )
 
 type TransactionType string
@@ -24,35 +34,71 @@ const (
 	OutcomeTransactionType     = "OUTCOME"
 )
 
+// CategorySource says what set the category of a transaction.
+type CategorySource string
+
+const (
+	RuleCategorySource       CategorySource = "RULE"
+	ProviderCategorySource   CategorySource = "PROVIDER_MAPPING"
+	KeywordCategorySource    CategorySource = "KEYWORD"
+	ClassifierCategorySource CategorySource = "CLASSIFIER"
+	ManualCategorySource     CategorySource = "MANUAL"
+)
+
+// CategoryProvenance records how a transaction got its category. All fields are nil for uncategorised
+// transactions and for transactions categorised before provenance was recorded.
+type CategoryProvenance struct {
+	CategorySource *CategorySource
+	// CategoryRuleID is the rule that set the category.
+	CategoryRuleID *int64
+	// CategoryMatch is what matched: the keyword, the bank category, or the text a rule condition matched.
+	CategoryMatch *string
+	// CategoryConfidence is the probability the classifier gave the category.
+	CategoryConfidence *float64
+}
+
 type Transaction struct {
 	ID              int64
 	ExternalID      string
 	BankID          int64
+	AccountID       *int64
 	UserID          int64
 	Amount          string
 	CategoryID      int64
 	Description     string
 	Type            TransactionType
+	Merchant        *string
+	Tags            []string
 	TransactionDate time.Time
 	CreatedAt       time.Time
 	UpdatedAt       *time.Time
+	CategoryProvenance
+	// TypeEditedAt is when the type was last changed by hand, only read when categorising again.
+	TypeEditedAt *time.Time
+	// RunningBalance is the account balance after the transaction as reported by the import source, not persisted.
+	RunningBalance *string `db:"-"`
 }
 
 type EnrichedTransaction struct {
 	ID              int64
 	ExternalID      string
 	BankID          int64
+	AccountID       *int64
 	UserID          int64
 	Amount          string
 	CategoryID      int64
 	Description     string
 	Type            TransactionType
+	Merchant        *string
+	Tags            []string
 	TransactionDate time.Time
 	CreatedAt       time.Time
 	UpdatedAt       *time.Time
 	BankName        string
+	AccountName     *string
 	CategoryName    string
 	UserName        string
+	CategoryProvenance
 }
 
 type TransactionSummary struct {
@@ -67,3 +113,61 @@ type TransactionUpdateData struct {
 	Type       *TransactionType
 	CategoryID *int64
 }
+
+// TransactionEvent is the event payload describing a transaction.
+type TransactionEvent struct {
+	ID              int64           `json:"id"`
+	ExternalID      string          `json:"external_id"`
+	BankID          int64           `json:"bank_id"`
+	AccountID       *int64          `json:"account_id,omitempty"`
+	UserID          int64           `json:"user_id"`
+	Amount          string          `json:"amount"`
+	CategoryID      int64           `json:"category_id"`
+	Description     string          `json:"description"`
+	Type            TransactionType `json:"type"`
+	Merchant        *string         `json:"merchant,omitempty"`
+	Tags            []string        `json:"tags,omitempty"`
+	TransactionDate time.Time       `json:"transaction_date"`
+}
+
+type TransactionsCreatedEvent struct {
+	Transactions []TransactionEvent `json:"transactions"`
+}
+
+// TransactionRecategorizedEvent is recorded when a user manually changes the category of a transaction.
+type TransactionRecategorizedEvent struct {
+	Transaction        TransactionEvent `json:"transaction"`
+	PreviousCategoryID int64            `json:"previous_category_id"`
+}
+
+// RecategorizeFilter selects the transactions to categorise again. From and To default to the dates of the oldest
+// and the newest transaction.
+type RecategorizeFilter struct {
+	From        *time.Time
+	To          *time.Time
+	BankID      *int64
+	UserID      *int64
+	AccountID   *int64
+	CategoryIDs []int64
+	// SkipManuallyEdited leaves transactions whose category was changed by hand alone and keeps types changed by hand.
+	SkipManuallyEdited bool
+	DryRun             bool
+}
+
+// CategoryChange is the outcome of categorising a transaction again, before and after.
+type CategoryChange struct {
+	TransactionID      int64
+	TransactionDate    time.Time
+	Description        string
+	PreviousCategoryID int64
+	CategoryID         int64
+	PreviousType       TransactionType
+	Type               TransactionType
+}
+
+type RecategorizeResult struct {
+	Scanned int64
+	Changed int64
+	// Changes lists at most maxReportedChanges of the changed transactions.
+	Changes []CategoryChange
+}
@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"github.com/Masterminds/squirrel"
 	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"strings"
 	"time"
 )
 
+const transactionReturning = `RETURNING id, bank_id, account_id, external_id, user_id, transaction_date, amount, category_id, description,
+	created_at, type, merchant, tags, category_source, category_rule_id, category_match, category_confidence`
+
 type repository struct {
 	dbPool *pgxpool.Pool
 }
@@ -48,6 +53,7 @@ func (r *repository) enrichedTransactionList(ctx context.Context, month int32, y
 		Select(
 			"t.id",
 			"t.bank_id",
+			"t.account_id",
 			"t.external_id",
 			"t.user_id",
 			"t.transaction_date",
@@ -55,13 +61,21 @@ func (r *repository) enrichedTransactionList(ctx context.Context, month int32, y
 			"t.category_id",
 			"t.description",
 			"t.type",
+			"t.merchant",
+			"t.tags",
 			"t.created_at",
+			"t.category_source",
+			"t.category_rule_id",
+			"t.category_match",
+			"t.category_confidence",
 			"b.name AS bank_name",
+			"a.display_name AS account_name",
 			"c.name AS category_name",
 			"u.name AS user_name",
 		).
 		From("transaction t").
 		LeftJoin("bank b ON t.bank_id = b.id").
+		LeftJoin("account a ON t.account_id = a.id").
 		LeftJoin("category c ON t.category_id = c.id").
 		LeftJoin("users u ON t.user_id = u.id").
 		Where("EXTRACT(MONTH FROM t.transaction_date) = ?", month).
@@ -87,6 +101,7 @@ func (r *repository) getEnrichedTransaction(ctx context.Context, id int64) (*Enr
 		Select(
 			"t.id",
 			"t.bank_id",
+			"t.account_id",
 			"t.external_id",
 			"t.user_id",
 			"t.transaction_date",
@@ -94,13 +109,21 @@ func (r *repository) getEnrichedTransaction(ctx context.Context, id int64) (*Enr
 			"t.category_id",
 			"t.description",
 			"t.type",
+			"t.merchant",
+			"t.tags",
 			"t.created_at",
+			"t.category_source",
+			"t.category_rule_id",
+			"t.category_match",
+			"t.category_confidence",
 			"b.name AS bank_name",
+			"a.display_name AS account_name",
 			"c.name AS category_name",
 			"u.name AS user_name",
 		).
 		From("transaction t").
 		LeftJoin("bank b ON t.bank_id = b.id").
+		LeftJoin("account a ON t.account_id = a.id").
 		LeftJoin("category c ON t.category_id = c.id").
 		LeftJoin("users u ON t.user_id = u.id").
 		Where(squirrel.Eq{"t.id": id}).
@@ -126,14 +149,21 @@ func (r *repository) getTransaction(ctx context.Context, id int64) (*Transaction
 			"id",
 			"external_id",
 			"bank_id",
+			"account_id",
 			"user_id",
 			"amount",
 			"category_id",
 			"description",
 			"type",
+			"merchant",
+			"tags",
 			"transaction_date",
 			"created_at",
 			"updated_at",
+			"category_source",
+			"category_rule_id",
+			"category_match",
+			"category_confidence",
 		).
 		From(transactionTable).
 		Where(squirrel.Eq{"id": id}).
@@ -152,15 +182,26 @@ func (r *repository) getTransaction(ctx context.Context, id int64) (*Transaction
 	return &transaction, nil
 }
 
-func (r *repository) saveTransaction(ctx context.Context, transactions []*Transaction) error {
+// saveTransaction returns only the inserted transactions, duplicates of already imported ones are skipped.
+// onSaved runs in the same database transaction, so anything it writes commits or rolls back with the insert.
+func (r *repository) saveTransaction(ctx context.Context, transactions []*Transaction, onSaved func(tx pgx.Tx, inserted []Transaction) error) ([]Transaction, error) {
 	builder := squirrel.
 		Insert(transactionTable).
-		Columns("bank_id", "external_id", "user_id", "transaction_date", "amount", "category_id", "description", "type").
+		Columns(
+			"bank_id", "account_id", "external_id", "user_id", "transaction_date", "amount", "category_id", "description", "type", "merchant", "tags",
+			"category_source", "category_rule_id", "category_match", "category_confidence",
+		).
 		PlaceholderFormat(squirrel.Dollar)
 
 	for _, t := range transactions {
+		tags := t.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
 		builder = builder.Values(
 			t.BankID,
+			t.AccountID,
 			t.ExternalID,
 			t.UserID,
 			t.TransactionDate,
@@ -168,29 +209,76 @@ func (r *repository) saveTransaction(ctx context.Context, transactions []*Transa
 			t.CategoryID,
 			t.Description,
 			t.Type,
+			t.Merchant,
+			tags,
+			t.CategorySource,
+			t.CategoryRuleID,
+			t.CategoryMatch,
+			t.CategoryConfidence,
 		)
 	}
 
-	query, args, err := builder.Suffix("ON CONFLICT ON CONSTRAINT uniq_transaction_external DO NOTHING").ToSql()
+	query, args, err := builder.
+		Suffix(`ON CONFLICT ON CONSTRAINT uniq_transaction_external DO NOTHING
+			RETURNING id, bank_id, account_id, external_id, user_id, transaction_date, amount, category_id, description, type, merchant, tags, created_at,
+				category_source, category_rule_id, category_match, category_confidence`).
+		ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to build SQL: %w", err)
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
 	}
 
-	_, err = r.dbPool.Exec(ctx, query, args...)
+	tx, err := r.dbPool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to execute insert: %w", err)
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
-	return nil
+	var inserted []Transaction
+	if err = pgxscan.Select(ctx, tx, &inserted, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to execute insert: %w", err)
+	}
+
+	if len(inserted) > 0 {
+		if err = onSaved(tx, inserted); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return inserted, nil
 }
 
-func (r *repository) updateTransaction(ctx context.Context, tx *EnrichedTransaction) (*Transaction, error) {
-	query, args, err := squirrel.
+// updateTransaction runs onUpdated in the same database transaction as the update. categoryEdited and typeEdited
+// mark the category and the type as set by hand, so categorising again can leave them alone.
+func (r *repository) updateTransaction(
+	ctx context.Context,
+	tr *EnrichedTransaction,
+	categoryEdited, typeEdited bool,
+	onUpdated func(tx pgx.Tx, updated *Transaction) error,
+) (*Transaction, error) {
+	builder := squirrel.
 		Update("transaction").
-		Set("category_id", tx.CategoryID).
-		Set("type", tx.Type).
-		Where(squirrel.Eq{"id": tx.ID}).
-		Suffix("RETURNING id, bank_id, external_id, user_id, transaction_date, amount, category_id, description, created_at, type").
+		Set("category_id", tr.CategoryID).
+		Set("type", tr.Type)
+
+	if categoryEdited {
+		builder = builder.
+			Set("category_edited_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+			Set("category_source", ManualCategorySource).
+			Set("category_rule_id", nil).
+			Set("category_match", nil).
+			Set("category_confidence", nil)
+	}
+	if typeEdited {
+		builder = builder.Set("type_edited_at", squirrel.Expr("CURRENT_TIMESTAMP"))
+	}
+
+	query, args, err := builder.
+		Where(squirrel.Eq{"id": tr.ID}).
+		Suffix(transactionReturning).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 
@@ -198,11 +286,199 @@ func (r *repository) updateTransaction(ctx context.Context, tx *EnrichedTransact
 		return nil, fmt.Errorf("failed to build update SQL: %w", err)
 	}
 
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
 	var updatedTr Transaction
-	err = pgxscan.Get(ctx, r.dbPool, &updatedTr, query, args...)
+	err = pgxscan.Get(ctx, tx, &updatedTr, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update transaction: %w", err)
 	}
 
+	if err = onUpdated(tx, &updatedTr); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return &updatedTr, nil
 }
+
+// categorizeUncategorized moves uncategorized transactions whose description contains the pattern, ignoring case,
+// to the category. Transactions of other users are left alone when userID is set.
+func (r *repository) categorizeUncategorized(
+	ctx context.Context,
+	pattern string,
+	uncategorizedID, categoryID int64,
+	userID *int64,
+	provenance *CategoryProvenance,
+	onUpdated func(tx pgx.Tx, updated []Transaction) error,
+) ([]Transaction, error) {
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(pattern)
+
+	queryBuilder := squirrel.
+		Update("transaction").
+		Set("category_id", categoryID).
+		Set("category_source", provenance.CategorySource).
+		Set("category_rule_id", provenance.CategoryRuleID).
+		Set("category_match", provenance.CategoryMatch).
+		Set("category_confidence", provenance.CategoryConfidence).
+		Where(squirrel.Eq{"category_id": uncategorizedID}).
+		Where(squirrel.ILike{"description": "%" + escaped + "%"}).
+		Suffix(transactionReturning).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if userID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"user_id": *userID})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update SQL: %w", err)
+	}
+
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var updated []Transaction
+	if err = pgxscan.Select(ctx, tx, &updated, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to update transactions: %w", err)
+	}
+
+	if len(updated) > 0 {
+		if err = onUpdated(tx, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return updated, nil
+}
+
+// transactionDateRange returns the dates of the oldest and the newest transaction, nil when there are none.
+func (r *repository) transactionDateRange(ctx context.Context) (*time.Time, *time.Time, error) {
+	var first, last *time.Time
+	err := r.dbPool.QueryRow(ctx, "SELECT MIN(transaction_date), MAX(transaction_date) FROM transaction").Scan(&first, &last)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get transaction date range: %w", err)
+	}
+
+	return first, last, nil
+}
+
+// recategorizationBatch returns the next transactions after afterID dated from start up to end, exclusive, and up
+// to filter.To, inclusive. Callers keep start and end within one month so the query reads a single partition.
+func (r *repository) recategorizationBatch(ctx context.Context, filter *RecategorizeFilter, start, end time.Time, afterID int64) ([]Transaction, error) {
+	queryBuilder := squirrel.
+		Select(
+			"id",
+			"external_id",
+			"bank_id",
+			"account_id",
+			"user_id",
+			"amount",
+			"category_id",
+			"description",
+			"type",
+			"merchant",
+			"tags",
+			"transaction_date",
+			"created_at",
+			"updated_at",
+			"category_source",
+			"category_rule_id",
+			"category_match",
+			"category_confidence",
+			"type_edited_at",
+		).
+		From(transactionTable).
+		Where(squirrel.GtOrEq{"transaction_date": start}).
+		Where(squirrel.Lt{"transaction_date": end}).
+		Where(squirrel.Gt{"id": afterID}).
+		OrderBy("id").
+		Limit(recategorizeBatchSize).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.To != nil {
+		queryBuilder = queryBuilder.Where(squirrel.LtOrEq{"transaction_date": *filter.To})
+	}
+	if filter.BankID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"bank_id": *filter.BankID})
+	}
+	if filter.UserID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"user_id": *filter.UserID})
+	}
+	if filter.AccountID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"account_id": *filter.AccountID})
+	}
+	if len(filter.CategoryIDs) > 0 {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"category_id": filter.CategoryIDs})
+	}
+	if filter.SkipManuallyEdited {
+		queryBuilder = queryBuilder.Where("category_edited_at IS NULL")
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var transactions []Transaction
+	if err = pgxscan.Select(ctx, r.dbPool, &transactions, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select transactions: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// saveCategorization stores the category with its provenance, type, merchant and tags of the transactions. onUpdated runs in the same
+// database transaction.
+func (r *repository) saveCategorization(ctx context.Context, transactions []Transaction, onUpdated func(tx pgx.Tx) error) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	for _, t := range transactions {
+		tags := t.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
+		batch.Queue(
+			`UPDATE transaction SET category_id = $3, type = $4, merchant = $5, tags = $6,
+				category_source = $7, category_rule_id = $8, category_match = $9, category_confidence = $10,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1 AND transaction_date = $2`,
+			t.ID, t.TransactionDate, t.CategoryID, t.Type, t.Merchant, tags,
+			t.CategorySource, t.CategoryRuleID, t.CategoryMatch, t.CategoryConfidence,
+		)
+	}
+
+	if err = tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to update transactions: %w", err)
+	}
+
+	if err = onUpdated(tx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -2,13 +2,17 @@ package transaction
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/event"
 	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
 	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"slices"
 	"strconv"
 	"time"
 )
@@ -16,13 +20,15 @@ import (
 type Service struct {
 	repo            *repository
 	categoryService *category.Service
+	eventService    *event.Service
 }
 
-func NewService(dbPool *pgxpool.Pool, categoryService *category.Service) *Service {
+func NewService(dbPool *pgxpool.Pool, categoryService *category.Service, eventService *event.Service) *Service {
 	repo := newRepository(dbPool)
 	return &Service{
 		repo:            repo,
 		categoryService: categoryService,
+		eventService:    eventService,
 	}
 }
 
@@ -57,6 +63,10 @@ func (s *Service) GetSummaryTransactions(ctx context.Context, month int32, year
 	var totalIncome float64 = 0
 	var totalOutcome float64 = 0
 	for _, tr := range enrichedTrs {
+		if !s.categoryService.Store().GetKind(tr.CategoryID).Counted() {
+			continue
+		}
+
 		amount, err := strconv.ParseFloat(tr.Amount, 64)
 		if err != nil {
 			logger.ErrorWithFields("failed to parse transaction amount", err,
@@ -83,6 +93,16 @@ func (s *Service) GetSummaryTransactions(ctx context.Context, month int32, year
 	}, nil
 }
 
+func (s *Service) GetTransaction(ctx context.Context, id int64) (*EnrichedTransaction, error) {
+	tr, err := s.repo.getEnrichedTransaction(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("transaction not found", err, "transaction_id", id)
+		return nil, psql.MapPostgresError("transaction not found", err)
+	}
+
+	return tr, nil
+}
+
 func (s *Service) UpdateTransaction(ctx context.Context, data *TransactionUpdateData) (*EnrichedTransaction, error) {
 	tr, err := s.repo.getEnrichedTransaction(ctx, data.ID)
 	if err != nil {
@@ -90,40 +110,68 @@ func (s *Service) UpdateTransaction(ctx context.Context, data *TransactionUpdate
 		return nil, psql.MapPostgresError("transaction not found", err)
 	}
 
+	previousType := tr.Type
 	if data.Type != nil {
 		tr.Type = *data.Type
 	}
 
+	previousCategoryID := tr.CategoryID
 	if data.CategoryID != nil {
 		ctgr, err := s.categoryService.GetCategoryByID(ctx, *data.CategoryID)
 		if err != nil {
 			return nil, err
 		}
+		if err = s.categoryService.ValidateLeaf(ctgr.ID); err != nil {
+			return nil, err
+		}
+		if err = s.categoryService.ValidateVisible(ctgr.ID, tr.UserID); err != nil {
+			return nil, err
+		}
 		tr.CategoryID = ctgr.ID
 		tr.CategoryName = ctgr.Name
 	}
 
-	updatedTr, err := s.repo.updateTransaction(ctx, tr)
+	updatedTr, err := s.repo.updateTransaction(ctx, tr, tr.CategoryID != previousCategoryID, tr.Type != previousType, func(tx pgx.Tx, updated *Transaction) error {
+		trEvent := newTransactionEvent(updated)
+		if err := s.eventService.Append(ctx, tx, event.TransactionUpdatedType, trEvent); err != nil {
+			return err
+		}
+		if updated.CategoryID == previousCategoryID {
+			return nil
+		}
+
+		return s.eventService.Append(ctx, tx, event.TransactionRecategorizedType, TransactionRecategorizedEvent{
+			Transaction:        trEvent,
+			PreviousCategoryID: previousCategoryID,
+		})
+	})
 	if err != nil {
 		logger.Error("failed to update transaction", err)
 		return nil, psql.MapPostgresError("failed to update transaction", err)
 	}
 
 	newTr := &EnrichedTransaction{
-		ID:              updatedTr.ID,
-		ExternalID:      updatedTr.ExternalID,
-		BankID:          updatedTr.BankID,
-		UserID:          updatedTr.UserID,
-		Amount:          updatedTr.Amount,
-		CategoryID:      updatedTr.CategoryID,
-		Description:     updatedTr.Description,
-		Type:            updatedTr.Type,
-		TransactionDate: updatedTr.TransactionDate,
-		CreatedAt:       updatedTr.CreatedAt,
-		BankName:        tr.BankName,
-		CategoryName:    tr.CategoryName,
+		ID:                 updatedTr.ID,
+		ExternalID:         updatedTr.ExternalID,
+		BankID:             updatedTr.BankID,
+		AccountID:          updatedTr.AccountID,
+		UserID:             updatedTr.UserID,
+		Amount:             updatedTr.Amount,
+		CategoryID:         updatedTr.CategoryID,
+		Description:        updatedTr.Description,
+		Type:               updatedTr.Type,
+		Merchant:           updatedTr.Merchant,
+		Tags:               updatedTr.Tags,
+		TransactionDate:    updatedTr.TransactionDate,
+		CreatedAt:          updatedTr.CreatedAt,
+		CategoryProvenance: updatedTr.CategoryProvenance,
+		BankName:           tr.BankName,
+		AccountName:        tr.AccountName,
+		CategoryName:       tr.CategoryName,
 	}
 
+	s.eventService.Notify()
+
 	return newTr, nil
 }
 
@@ -132,14 +180,148 @@ func (s *Service) SaveTransactions(ctx context.Context, transactions []*Transact
 		return status.Errorf(codes.InvalidArgument, "no transactions to save")
 	}
 
-	err := s.repo.saveTransaction(ctx, transactions)
+	_, err := s.repo.saveTransaction(ctx, transactions, func(tx pgx.Tx, inserted []Transaction) error {
+		events := make([]TransactionEvent, len(inserted))
+		for i := range inserted {
+			events[i] = newTransactionEvent(&inserted[i])
+		}
+
+		return s.eventService.Append(ctx, tx, event.TransactionsCreatedType, TransactionsCreatedEvent{Transactions: events})
+	})
 	if err != nil {
 		return psql.MapPostgresError("failed to save transactions", err)
 	}
 
+	s.eventService.Notify()
+
 	return nil
 }
 
+// CategorizeUncategorized applies a learned keyword retroactively: uncategorized transactions whose description
+// contains the pattern move to the category, recorded with the given provenance. Only transactions of userID are
+// moved when it is set, as for categories owned by a user. It returns the number of updated transactions.
+func (s *Service) CategorizeUncategorized(ctx context.Context, pattern string, categoryID int64, userID *int64, provenance CategoryProvenance) (int64, error) {
+	updated, err := s.repo.categorizeUncategorized(ctx, pattern, category.UncategorizedID, categoryID, userID, &provenance, func(tx pgx.Tx, updated []Transaction) error {
+		for i := range updated {
+			if err := s.eventService.Append(ctx, tx, event.TransactionUpdatedType, newTransactionEvent(&updated[i])); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.ErrorWithFields("failed to categorize uncategorized transactions", err, "category_id", categoryID)
+		return 0, psql.MapPostgresError("failed to categorize transactions", err)
+	}
+
+	s.eventService.Notify()
+
+	return int64(len(updated)), nil
+}
+
+// Recategorize runs categorize over the transactions the filter selects and saves those whose category, type,
+// merchant or tags it changed. It works through one monthly partition at a time, in batches that each commit on
+// their own, so a failure keeps the batches saved before it. A dry run only reports the changes.
+func (s *Service) Recategorize(ctx context.Context, filter *RecategorizeFilter, categorize func(tr *Transaction)) (*RecategorizeResult, error) {
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid date range: from must not be after to")
+	}
+
+	res := &RecategorizeResult{}
+
+	from, to := filter.From, filter.To
+	if from == nil || to == nil {
+		first, last, err := s.repo.transactionDateRange(ctx)
+		if err != nil {
+			logger.Error("failed to get transaction date range", err)
+			return nil, psql.MapPostgresError("failed to get transactions", err)
+		}
+		if first == nil {
+			return res, nil
+		}
+		if from == nil {
+			from = first
+		}
+		if to == nil {
+			to = last
+		}
+	}
+
+	rangeFilter := *filter
+	rangeFilter.To = to
+
+	for month := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, from.Location()); !month.After(*to); month = month.AddDate(0, 1, 0) {
+		start := month
+		if from.After(start) {
+			start = *from
+		}
+
+		var afterID int64
+		for {
+			batch, err := s.repo.recategorizationBatch(ctx, &rangeFilter, start, month.AddDate(0, 1, 0), afterID)
+			if err != nil {
+				logger.ErrorWithFields("failed to get transactions to categorize", err, "month", month.Format("2006-01"))
+				return nil, psql.MapPostgresError("failed to get transactions", err)
+			}
+			if len(batch) == 0 {
+				break
+			}
+
+			changed := make([]Transaction, 0, len(batch))
+			for i := range batch {
+				tr := batch[i]
+				tr.Tags = slices.Clone(batch[i].Tags)
+				categorize(&tr)
+				if filter.SkipManuallyEdited && tr.TypeEditedAt != nil {
+					tr.Type = batch[i].Type
+				}
+
+				if !categorizationChanged(&batch[i], &tr) {
+					continue
+				}
+				changed = append(changed, tr)
+
+				if len(res.Changes) < maxReportedChanges {
+					res.Changes = append(res.Changes, CategoryChange{
+						TransactionID:      tr.ID,
+						TransactionDate:    tr.TransactionDate,
+						Description:        tr.Description,
+						PreviousCategoryID: batch[i].CategoryID,
+						CategoryID:         tr.CategoryID,
+						PreviousType:       batch[i].Type,
+						Type:               tr.Type,
+					})
+				}
+			}
+			res.Scanned += int64(len(batch))
+			res.Changed += int64(len(changed))
+
+			if !filter.DryRun && len(changed) > 0 {
+				err = s.repo.saveCategorization(ctx, changed, func(tx pgx.Tx) error {
+					for i := range changed {
+						if err := s.eventService.Append(ctx, tx, event.TransactionUpdatedType, newTransactionEvent(&changed[i])); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+				if err != nil {
+					logger.ErrorWithFields("failed to save transaction categories", err, "month", month.Format("2006-01"))
+					return nil, psql.MapPostgresError("failed to save transaction categories", err)
+				}
+				s.eventService.Notify()
+			}
+
+			if len(batch) < recategorizeBatchSize {
+				break
+			}
+			afterID = batch[len(batch)-1].ID
+		}
+	}
+
+	return res, nil
+}
+
 func (s *Service) GetTransactionTypeList() []TransactionType {
 	return []TransactionType{
 		UnspecifiedTransactionType,
@@ -147,3 +329,88 @@ func (s *Service) GetTransactionTypeList() []TransactionType {
 		OutcomeTransactionType,
 	}
 }
+
+// NewCategoryProvenance returns the provenance of a category set by source. match is cut to the stored length,
+// an empty match is not recorded.
+func NewCategoryProvenance(source CategorySource, ruleID *int64, match string, confidence *float64) CategoryProvenance {
+	provenance := CategoryProvenance{
+		CategorySource:     &source,
+		CategoryRuleID:     ruleID,
+		CategoryConfidence: confidence,
+	}
+
+	if runes := []rune(match); len(runes) > maxCategoryMatchLen {
+		match = string(runes[:maxCategoryMatchLen])
+	}
+	if match != "" {
+		provenance.CategoryMatch = &match
+	}
+
+	return provenance
+}
+
+func categorizationChanged(before, after *Transaction) bool {
+	if before.CategoryID != after.CategoryID || before.Type != after.Type || !slices.Equal(before.Tags, after.Tags) {
+		return true
+	}
+	if before.Merchant == nil || after.Merchant == nil {
+		return before.Merchant != after.Merchant
+	}
+
+	return *before.Merchant != *after.Merchant
+}
+
+func newTransactionEvent(tr *Transaction) TransactionEvent {
+	return TransactionEvent{
+		ID:              tr.ID,
+		ExternalID:      tr.ExternalID,
+		BankID:          tr.BankID,
+		AccountID:       tr.AccountID,
+		UserID:          tr.UserID,
+		Amount:          tr.Amount,
+		CategoryID:      tr.CategoryID,
+		Description:     tr.Description,
+		Type:            tr.Type,
+		Merchant:        tr.Merchant,
+		Tags:            tr.Tags,
+		TransactionDate: tr.TransactionDate,
+	}
+}
+
+// DecodeTransactionRecategorized returns the payload of a transaction.recategorized event.
+func DecodeTransactionRecategorized(e *event.Event) (*TransactionRecategorizedEvent, error) {
+	var payload TransactionRecategorizedEvent
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event %d: %w", e.Type, e.ID, err)
+	}
+
+	return &payload, nil
+}
+
+// DecodeTransactionsCreated returns the transactions carried by a transaction.created event.
+func DecodeTransactionsCreated(e *event.Event) ([]Transaction, error) {
+	var payload TransactionsCreatedEvent
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event %d: %w", e.Type, e.ID, err)
+	}
+
+	transactions := make([]Transaction, len(payload.Transactions))
+	for i, tr := range payload.Transactions {
+		transactions[i] = Transaction{
+			ID:              tr.ID,
+			ExternalID:      tr.ExternalID,
+			BankID:          tr.BankID,
+			AccountID:       tr.AccountID,
+			UserID:          tr.UserID,
+			Amount:          tr.Amount,
+			CategoryID:      tr.CategoryID,
+			Description:     tr.Description,
+			Type:            tr.Type,
+			Merchant:        tr.Merchant,
+			Tags:            tr.Tags,
+			TransactionDate: tr.TransactionDate,
+		}
+	}
+
+	return transactions, nil
+}
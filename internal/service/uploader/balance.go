@@ -0,0 +1,39 @@
+package uploader
+
+import (
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
+)
+
+type balancePoint struct {
+	date    time.Time
+	row     int
+	balance string
+}
+
+// dailyBalanceSnapshots keeps the balance after the latest transaction of every day.
+// Rows with the same timestamp are ordered by their position in the file.
+func dailyBalanceSnapshots(accountID int64, points []balancePoint) []balance.Snapshot {
+	latest := map[string]balancePoint{}
+	for _, p := range points {
+		key := p.date.Format(time.DateOnly)
+		current, ok := latest[key]
+		if !ok || p.date.After(current.date) || (p.date.Equal(current.date) && p.row > current.row) {
+			latest[key] = p
+		}
+	}
+
+	snapshots := make([]balance.Snapshot, 0, len(latest))
+	for _, p := range latest {
+		y, m, d := p.date.Date()
+		snapshots = append(snapshots, balance.Snapshot{
+			AccountID:   accountID,
+			Balance:     p.balance,
+			BalanceDate: time.Date(y, m, d, 0, 0, 0, 0, time.UTC),
+			Source:      balance.CSVSnapshotSource,
+		})
+	}
+
+	return snapshots
+}
@@ -0,0 +1,209 @@
+package csv_parser
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// sniffLines is the number of non-blank lines the delimiter and quote are detected from.
+	sniffLines = 50
+	// sniffBytes is the size of the prefix checked for UTF-16 without a byte order mark.
+	sniffBytes = 4096
+	// headerSearchRows is the number of rows searched for the header row.
+	headerSearchRows = 20
+)
+
+var (
+	delimiterCandidates = []rune{',', ';', '\t', '|'}
+
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+)
+
+// Dialect is how a CSV file was read: settings of the parsing profile, and what was detected for those the profile
+// leaves empty.
+type Dialect struct {
+	Encoding Encoding
+	// BOM is set when the file starts with a byte order mark, which decides the encoding.
+	BOM       bool
+	Delimiter string
+	// Quote is the character quoting fields, `"` or `'`.
+	Quote string
+	// LenientQuotes is set when the file has quotes inside unquoted fields, which are then kept as text.
+	LenientQuotes bool
+	// HeaderRow is the number of non-blank rows above the header row.
+	HeaderRow int32
+}
+
+// detectEncoding strips a byte order mark and returns the encoding it stands for. Without one, the profile
+// encoding is used when set; otherwise UTF-16 is recognised by its zero bytes, valid UTF-8 stays UTF-8 and
+// anything else is taken for Windows-1252, the usual encoding of spreadsheet exports.
+func detectEncoding(data []byte, profileEncoding Encoding) ([]byte, Encoding, bool) {
+	switch {
+	case bytes.HasPrefix(data, utf8BOM):
+		return data[len(utf8BOM):], UTF8Encoding, true
+	case bytes.HasPrefix(data, utf16LEBOM):
+		return data[len(utf16LEBOM):], UTF16LEEncoding, true
+	case bytes.HasPrefix(data, utf16BEBOM):
+		return data[len(utf16BEBOM):], UTF16BEEncoding, true
+	}
+
+	if profileEncoding != "" {
+		return data, profileEncoding, false
+	}
+
+	sample := data[:min(len(data), sniffBytes)]
+	var evenZeros, oddZeros int
+	for i, b := range sample {
+		if b != 0 {
+			continue
+		}
+		if i%2 == 0 {
+			evenZeros++
+		} else {
+			oddZeros++
+		}
+	}
+	// Text that is mostly ASCII has a zero byte in every other position in UTF-16.
+	switch {
+	case oddZeros > len(sample)/4 && oddZeros > evenZeros:
+		return data, UTF16LEEncoding, false
+	case evenZeros > len(sample)/4:
+		return data, UTF16BEEncoding, false
+	case utf8.Valid(data):
+		return data, UTF8Encoding, false
+	default:
+		return data, Windows1252Encoding, false
+	}
+}
+
+// detectDelimiter picks the candidate found the same number of times, outside double quotes, on the most lines.
+// Ties go to the candidate found more often per line, so amounts with a decimal comma do not make a semicolon
+// separated file comma separated.
+func detectDelimiter(lines []string) rune {
+	best, bestLines, bestCount := ',', 0, 0
+	for _, candidate := range delimiterCandidates {
+		frequencies := map[int]int{}
+		for _, line := range lines {
+			if count := countOutsideQuotes(line, candidate); count > 0 {
+				frequencies[count]++
+			}
+		}
+
+		for count, lineCount := range frequencies {
+			if lineCount > bestLines || (lineCount == bestLines && count > bestCount) {
+				best, bestLines, bestCount = candidate, lineCount, count
+			}
+		}
+	}
+
+	return best
+}
+
+func countOutsideQuotes(line string, delimiter rune) int {
+	count, quoted := 0, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == delimiter && !quoted:
+			count++
+		}
+	}
+
+	return count
+}
+
+// detectQuote returns `'` when more fields are enclosed in single quotes than in double quotes.
+func detectQuote(lines []string, delimiter rune) rune {
+	var double, single int
+	for _, line := range lines {
+		for _, field := range strings.Split(line, string(delimiter)) {
+			field = strings.TrimSpace(field)
+			if len(field) < 2 {
+				continue
+			}
+			switch {
+			case field[0] == '"' && field[len(field)-1] == '"':
+				double++
+			case field[0] == '\'' && field[len(field)-1] == '\'':
+				single++
+			}
+		}
+	}
+
+	if single > double {
+		return '\''
+	}
+	return '"'
+}
+
+// detectHeaderRow returns the index of the row with the most known header names, the first one on a tie. When no
+// row has any, it is the first row as wide as most rows of the file, since preambles are usually narrower.
+func detectHeaderRow(records [][]string, headerNames []string) int {
+	best, bestMatches := 0, 0
+	for i, record := range records[:min(len(records), headerSearchRows)] {
+		matches := 0
+		for _, cell := range record {
+			if slices.Contains(headerNames, strings.TrimSpace(cell)) {
+				matches++
+			}
+		}
+		if matches > bestMatches {
+			best, bestMatches = i, matches
+		}
+	}
+	if bestMatches > 0 {
+		return best
+	}
+
+	widths := map[int]int{}
+	modalWidth := 0
+	for _, record := range records {
+		widths[len(record)]++
+		if widths[len(record)] > widths[modalWidth] || (widths[len(record)] == widths[modalWidth] && len(record) > modalWidth) {
+			modalWidth = len(record)
+		}
+	}
+	for i, record := range records[:min(len(records), headerSearchRows)] {
+		if len(record) == modalWidth {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// sniffSample returns the first non-blank lines of the text.
+func sniffSample(text string) []string {
+	lines := make([]string, 0, sniffLines)
+	for line := range strings.Lines(text) {
+		if line = strings.TrimRight(line, "\r\n"); strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+		if len(lines) == sniffLines {
+			break
+		}
+	}
+
+	return lines
+}
+
+// swapQuotes exchanges single and double quotes, so encoding/csv, which only knows double quotes, reads fields
+// quoted with single quotes. Swapping the parsed fields back restores their text.
+func swapQuotes(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '"':
+			return '\''
+		case '\'':
+			return '"'
+		default:
+			return r
+		}
+	}, s)
+}
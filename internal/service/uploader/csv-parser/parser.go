@@ -0,0 +1,289 @@
+package csv_parser
+
+import (
+	"context"
+	"fmt"
+	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parser turns CSV rows into transactions as described by the parsing profile of a bank.
+type Parser struct {
+	ruleService  *rule.Service
+	profile      *Profile
+	location     *time.Location
+	fieldFuncMap map[transaction.TransactionField]func(ctx context.Context, tr *transaction.Transaction, data []string) error
+}
+
+func NewParser(ruleService *rule.Service, profile *Profile) (*Parser, error) {
+	location, err := time.LoadLocation(profile.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", profile.Timezone, err)
+	}
+
+	p := &Parser{
+		ruleService: ruleService,
+		profile:     profile,
+		location:    location,
+	}
+
+	p.fieldFuncMap = map[transaction.TransactionField]func(ctx context.Context, tr *transaction.Transaction, data []string) error{
+		transaction.DateTransactionField:        p.parseDate,
+		transaction.DescriptionTransactionField: p.parseDescription,
+		transaction.CategoryTransactionField:    p.parseCategory,
+		transaction.ExternalIDTransactionField:  p.parseExternalID,
+		transaction.BalanceTransactionField:     p.parseBalance,
+	}
+	if profile.AmountMode == DebitCreditAmountMode {
+		p.fieldFuncMap[transaction.DebitTransactionField] = p.parseDebit
+		p.fieldFuncMap[transaction.CreditTransactionField] = p.parseCredit
+	} else {
+		p.fieldFuncMap[transaction.AmountTransactionField] = p.parseAmount
+	}
+
+	return p, nil
+}
+
+// fieldOrder is the order fields are parsed in. Category goes last, so rules see the parsed amount, date and description.
+var fieldOrder = []transaction.TransactionField{
+	transaction.DateTransactionField,
+	transaction.AmountTransactionField,
+	transaction.DebitTransactionField,
+	transaction.CreditTransactionField,
+	transaction.DescriptionTransactionField,
+	transaction.ExternalIDTransactionField,
+	transaction.BalanceTransactionField,
+	transaction.CategoryTransactionField,
+}
+
+// ParseRecords returns the transactions of the rows that parsed and the errors of the others, by row number
+// starting at 1.
+func (p *Parser) ParseRecords(
+	ctx context.Context,
+	records [][]string,
+	targetFieldIds map[transaction.TransactionField][]int,
+	bankID, userID int64,
+) ([]*transaction.Transaction, map[int64][]error) {
+	transactions := make([]*transaction.Transaction, 0, len(records))
+
+	processRecord := func(i int) (*transaction.Transaction, []error) {
+		tr := &transaction.Transaction{
+			BankID:     bankID,
+			UserID:     userID,
+			Type:       transaction.UnspecifiedTransactionType,
+			CategoryID: category.UncategorizedID,
+		}
+
+		record := records[i]
+		errs := []error{}
+		for _, field := range fieldOrder {
+			ids := targetFieldIds[field]
+			if len(ids) == 0 {
+				continue
+			}
+
+			recordLen := len(records[i])
+			if method, ok := p.fieldFuncMap[field]; ok {
+				data := make([]string, 0, len(ids))
+				for _, id := range ids {
+					if id < recordLen {
+						data = append(data, strings.TrimSpace(record[id]))
+					} else {
+						errs = append(errs, fmt.Errorf("missing field at index %d in row %d", id, i+1))
+					}
+				}
+				// Rows shorter than the header, such as footers, have no data for the field; the error is reported above.
+				if len(data) == 0 {
+					continue
+				}
+
+				err := method(ctx, tr, data)
+				if err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+
+		if p.profile.AmountMode == DebitCreditAmountMode && tr.Amount == "" {
+			errs = append(errs, fmt.Errorf("empty debit and credit data"))
+		}
+
+		return tr, errs
+	}
+
+	recordErrs := map[int64][]error{}
+	for i := 0; i < len(records); i++ {
+		tr, errs := processRecord(i)
+		// A row with errors is reported and not imported, it would be saved with zero values.
+		if len(errs) != 0 {
+			recordErrs[int64(i+1)] = errs
+			continue
+		}
+		transactions = append(transactions, tr)
+	}
+
+	return transactions, recordErrs
+}
+
+func (p *Parser) parseDate(_ context.Context, tr *transaction.Transaction, data []string) error {
+	if len(data) == 0 || data[0] == "" {
+		return fmt.Errorf("empty date data")
+	}
+
+	dateStr := data[0]
+	for _, layout := range p.profile.DateLayouts {
+		if t, err := time.ParseInLocation(layout, dateStr, p.location); err == nil {
+			tr.TransactionDate = t
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown date format: %s", dateStr)
+}
+
+func (p *Parser) parseCategory(_ context.Context, tr *transaction.Transaction, data []string) error {
+	p.ruleService.Categorize(tr, strings.Join(data, " "), data)
+
+	return nil
+}
+
+func (p *Parser) parseAmount(_ context.Context, tr *transaction.Transaction, data []string) error {
+	if len(data) == 0 || data[0] == "" {
+		return fmt.Errorf("empty amount data")
+	}
+
+	amountStr, err := p.normalizeAmount(data[0])
+	if err != nil {
+		return fmt.Errorf("invalid amount format: %s", data[0])
+	}
+
+	negative := amountStr[0] == '-'
+	switch p.profile.SignConvention {
+	case NegativeOutcomeConvention:
+		tr.Type = transaction.IncomeTransactionType
+		if negative {
+			tr.Type = transaction.OutcomeTransactionType
+		}
+	case PositiveOutcomeConvention:
+		tr.Type = transaction.OutcomeTransactionType
+		if negative {
+			tr.Type = transaction.IncomeTransactionType
+		}
+	case CategoryKindConvention:
+		tr.Type = transaction.UnspecifiedTransactionType
+	default:
+		switch amountStr[0] {
+		case '-':
+			tr.Type = transaction.OutcomeTransactionType
+		case '+':
+			tr.Type = transaction.IncomeTransactionType
+		default:
+			tr.Type = transaction.UnspecifiedTransactionType
+		}
+	}
+
+	tr.Amount = amountStr
+	return nil
+}
+
+// parseDebit records money out as a negative outcome. An empty or zero debit leaves the row to the credit column.
+func (p *Parser) parseDebit(_ context.Context, tr *transaction.Transaction, data []string) error {
+	amountStr, ok, err := p.columnAmount(data)
+	if err != nil || !ok {
+		return err
+	}
+
+	tr.Amount = "-" + amountStr
+	tr.Type = transaction.OutcomeTransactionType
+	return nil
+}
+
+// parseCredit records money in as income, unless the debit column already set the amount.
+func (p *Parser) parseCredit(_ context.Context, tr *transaction.Transaction, data []string) error {
+	amountStr, ok, err := p.columnAmount(data)
+	if err != nil || !ok || tr.Amount != "" {
+		return err
+	}
+
+	tr.Amount = amountStr
+	tr.Type = transaction.IncomeTransactionType
+	return nil
+}
+
+// columnAmount returns the unsigned amount of a debit or credit column, and false when the column is empty or zero.
+func (p *Parser) columnAmount(data []string) (string, bool, error) {
+	if len(data) == 0 || data[0] == "" {
+		return "", false, nil
+	}
+
+	amountStr, err := p.normalizeAmount(data[0])
+	if err != nil {
+		return "", false, fmt.Errorf("invalid amount format: %s", data[0])
+	}
+
+	amountStr = strings.TrimLeft(amountStr, "+-")
+	if amount, _ := strconv.ParseFloat(amountStr, 64); amount == 0 {
+		return "", false, nil
+	}
+
+	return amountStr, true, nil
+}
+
+// normalizeAmount removes thousands separators and turns the decimal separator into a point.
+func (p *Parser) normalizeAmount(s string) (string, error) {
+	if p.profile.ThousandsSeparator != "" {
+		s = strings.ReplaceAll(s, p.profile.ThousandsSeparator, "")
+	}
+	if p.profile.DecimalSeparator != "" && p.profile.DecimalSeparator != "." {
+		s = strings.ReplaceAll(s, p.profile.DecimalSeparator, ".")
+	}
+
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return "", err
+	}
+
+	return s, nil
+}
+
+func (p *Parser) parseDescription(_ context.Context, tr *transaction.Transaction, data []string) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	s := data[0]
+	for i := 1; i < len(data); i++ {
+		s = s + ", " + data[i]
+	}
+
+	tr.Description = s
+
+	return nil
+}
+
+func (p *Parser) parseExternalID(_ context.Context, tr *transaction.Transaction, data []string) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	tr.ExternalID = data[0]
+
+	return nil
+}
+
+func (p *Parser) parseBalance(_ context.Context, tr *transaction.Transaction, data []string) error {
+	if len(data) == 0 || data[0] == "" {
+		return nil
+	}
+
+	balanceStr, err := p.normalizeAmount(data[0])
+	if err != nil {
+		return fmt.Errorf("invalid balance format: %s", data[0])
+	}
+
+	tr.RunningBalance = &balanceStr
+	return nil
+}
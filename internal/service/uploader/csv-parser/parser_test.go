@@ -0,0 +1,50 @@
+package csv_parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+)
+
+func TestParseRecordsShortRows(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr []int64
+	}{
+		{name: "footer", csv: "Date,Description,Amount\n2024-01-02,Coffee,-3.50\nTotal\n", wantErr: []int64{2}},
+		{name: "ragged", csv: "Date,Description,Amount\n2024-01-02,Coffee\n2024-01-03,Tea,-2.10\n2024-01-04\n", wantErr: []int64{1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := DefaultProfile(1)
+			_, records, _, err := ReadRecords([]byte(tt.csv), profile, []string{"Date", "Description", "Amount"})
+			if err != nil {
+				t.Fatalf("ReadRecords: %v", err)
+			}
+
+			p, err := NewParser(nil, profile)
+			if err != nil {
+				t.Fatalf("NewParser: %v", err)
+			}
+			transactions, recordErrs := p.ParseRecords(context.Background(), records, map[transaction.TransactionField][]int{
+				transaction.DateTransactionField:        {0},
+				transaction.DescriptionTransactionField: {1},
+				transaction.AmountTransactionField:      {2},
+			}, 1, 1)
+
+			if len(transactions) != len(records)-len(tt.wantErr) {
+				t.Fatalf("parsed %d transactions, want %d", len(transactions), len(records)-len(tt.wantErr))
+			}
+			if len(recordErrs) != len(tt.wantErr) {
+				t.Fatalf("errors in rows %v, want %v", recordErrs, tt.wantErr)
+			}
+			for _, row := range tt.wantErr {
+				if len(recordErrs[row]) == 0 {
+					t.Fatalf("no error in row %d, errors %v", row, recordErrs)
+				}
+			}
+		})
+	}
+}
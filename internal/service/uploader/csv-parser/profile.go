@@ -0,0 +1,87 @@
+package csv_parser
+
+import "time"
+
+type SignConvention string
+
+const (
+	// SignedConvention takes the type from an explicit sign, "-" for outcome and "+" for income; unsigned amounts
+	// get their type from the category kind.
+	SignedConvention SignConvention = "SIGNED"
+	// NegativeOutcomeConvention treats negative amounts as outcome and all others as income.
+	NegativeOutcomeConvention SignConvention = "NEGATIVE_OUTCOME"
+	// PositiveOutcomeConvention is for card statements listing charges as positive amounts and refunds as negative.
+	PositiveOutcomeConvention SignConvention = "POSITIVE_OUTCOME"
+	// CategoryKindConvention ignores the sign and leaves the type to the category kind, for statements that sign
+	// amounts inconsistently.
+	CategoryKindConvention SignConvention = "CATEGORY_KIND"
+)
+
+type AmountMode string
+
+const (
+	// SingleAmountMode reads a signed amount from the AMOUNT column.
+	SingleAmountMode AmountMode = "SINGLE"
+	// DebitCreditAmountMode reads money out from the DEBIT column and money in from the CREDIT column, the sign
+	// convention does not apply.
+	DebitCreditAmountMode AmountMode = "DEBIT_CREDIT"
+)
+
+type Encoding string
+
+const (
+	UTF8Encoding        Encoding = "UTF-8"
+	UTF16LEEncoding     Encoding = "UTF-16LE"
+	UTF16BEEncoding     Encoding = "UTF-16BE"
+	Windows1252Encoding Encoding = "WINDOWS-1252"
+	ISO88591Encoding    Encoding = "ISO-8859-1"
+)
+
+// defaultDateLayouts are tried for banks without a profile, in the order they were always tried.
+var defaultDateLayouts = []string{
+	"2006-01-02 15:04:05",
+	"02/01/2006",
+	"2006-01-02",
+	"02.01.2006",
+	"2006/01/02",
+}
+
+// Profile describes the CSV export of a bank, so banks differ by stored settings instead of by parser.
+type Profile struct {
+	BankID int64
+	// DateLayouts are Go time layouts tried in order; the first one that parses the date wins.
+	DateLayouts []string
+	// Timezone is the IANA time zone of dates that carry no offset.
+	Timezone         string
+	DecimalSeparator string
+	// ThousandsSeparator is removed from amounts, empty when the bank uses none.
+	ThousandsSeparator string
+	SignConvention     SignConvention
+	AmountMode         AmountMode
+	// Delimiter is detected from the file when empty.
+	Delimiter string
+	// Encoding is detected from the file when empty. A byte order mark in the file always decides it.
+	Encoding Encoding
+	// HeaderRow is the number of rows above the header row, such as an account summary. Blank lines do not count.
+	// When nil, the header row is the row with the most header names of the bank.
+	HeaderRow *int32
+	// SkipRows is the number of rows right below the header row that are not transactions.
+	SkipRows  int32
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	// Default is set on the profile of banks without a stored one.
+	Default bool `db:"-"`
+}
+
+// DefaultProfile is used for banks without a stored profile.
+func DefaultProfile(bankID int64) *Profile {
+	return &Profile{
+		BankID:           bankID,
+		DateLayouts:      defaultDateLayouts,
+		Timezone:         "UTC",
+		DecimalSeparator: ".",
+		SignConvention:   SignedConvention,
+		AmountMode:       SingleAmountMode,
+		Default:          true,
+	}
+}
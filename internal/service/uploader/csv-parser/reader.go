@@ -0,0 +1,115 @@
+package csv_parser
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// ReadRecords decodes a CSV file as described by the profile and returns its header row and the transaction rows
+// below it. Rows may differ in length, so preambles above the header do not fail the file. The encoding,
+// delimiter and header row the profile leaves empty are detected, the header row by the bank's header names.
+func ReadRecords(data []byte, profile *Profile, headerNames []string) ([]string, [][]string, *Dialect, error) {
+	data, enc, bom := detectEncoding(data, profile.Encoding)
+	decoded, err := decode(data, enc)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	text := string(decoded)
+
+	dialect := &Dialect{
+		Encoding:  enc,
+		BOM:       bom,
+		Delimiter: profile.Delimiter,
+	}
+
+	sample := sniffSample(text)
+	if dialect.Delimiter == "" {
+		dialect.Delimiter = string(detectDelimiter(sample))
+	}
+	delimiter, _ := utf8.DecodeRuneInString(dialect.Delimiter)
+	quote := detectQuote(sample, delimiter)
+	dialect.Quote = string(quote)
+	if quote == '\'' {
+		text = swapQuotes(text)
+	}
+
+	records, err := readAll(text, delimiter, false)
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) && (errors.Is(parseErr.Err, csv.ErrBareQuote) || errors.Is(parseErr.Err, csv.ErrQuote)) {
+		dialect.LenientQuotes = true
+		records, err = readAll(text, delimiter, true)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if quote == '\'' {
+		for _, record := range records {
+			for i := range record {
+				record[i] = swapQuotes(record[i])
+			}
+		}
+	}
+
+	headerRow := 0
+	if profile.HeaderRow != nil {
+		headerRow = int(*profile.HeaderRow)
+	} else if len(records) > 0 {
+		headerRow = detectHeaderRow(records, headerNames)
+	}
+	dialect.HeaderRow = int32(headerRow)
+
+	if len(records) <= headerRow || len(records[headerRow]) == 0 {
+		return nil, nil, nil, fmt.Errorf("missing header row %d", headerRow+1)
+	}
+
+	header := records[headerRow]
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	rows := records[headerRow+1:]
+	rows = rows[min(int(profile.SkipRows), len(rows)):]
+
+	return header, rows, dialect, nil
+}
+
+func readAll(text string, delimiter rune, lazyQuotes bool) ([][]string, error) {
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.FieldsPerRecord = -1
+	reader.Comma = delimiter
+	reader.LazyQuotes = lazyQuotes
+
+	return reader.ReadAll()
+}
+
+func decode(data []byte, enc Encoding) ([]byte, error) {
+	var decoder *encoding.Decoder
+	switch enc {
+	case UTF8Encoding:
+		return data, nil
+	case UTF16LEEncoding:
+		decoder = unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewDecoder()
+	case UTF16BEEncoding:
+		decoder = unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewDecoder()
+	case Windows1252Encoding:
+		decoder = charmap.Windows1252.NewDecoder()
+	case ISO88591Encoding:
+		decoder = charmap.ISO8859_1.NewDecoder()
+	default:
+		return nil, fmt.Errorf("unsupported encoding: %s", enc)
+	}
+
+	decoded, err := decoder.Bytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", enc, err)
+	}
+
+	return decoded, nil
+}
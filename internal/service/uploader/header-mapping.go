@@ -0,0 +1,225 @@
+package uploader
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	csvParser "github.com/Everest13/fin-aggregator-service/internal/service/uploader/csv-parser"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HeaderMappingList returns the headers of a bank and the required fields they do not map yet under the amount
+// mode of the bank's parsing profile.
+func (s *Service) HeaderMappingList(ctx context.Context, bankID int64) ([]HeaderMapping, []transaction.TransactionField, error) {
+	profile, err := s.GetParsingProfile(ctx, bankID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headers, err := s.repo.bankHeaderList(ctx, bankID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get bank headers", err, "bank_id", bankID)
+		return nil, nil, psql.MapPostgresError("failed to get bank headers", err)
+	}
+
+	return headers, MissingRequiredFields(headers, profile.AmountMode), nil
+}
+
+func (s *Service) CreateHeaderMapping(ctx context.Context, header *HeaderMapping) (*HeaderMapping, error) {
+	if _, err := s.bankService.GetBank(ctx, header.BankID); err != nil {
+		return nil, err
+	}
+
+	header.Name = strings.TrimSpace(header.Name)
+	if err := validateHeaderMapping(header); err != nil {
+		return nil, err
+	}
+
+	id, err := s.repo.createBankHeader(ctx, header)
+	if err != nil {
+		logger.ErrorWithFields("failed to create bank header", err, "bank_id", header.BankID, "name", header.Name)
+		return nil, psql.MapPostgresError("failed to create bank header", err)
+	}
+
+	return s.headerMappingAfterChange(ctx, id)
+}
+
+// UpdateHeaderMapping renames a header, changes whether it is required or replaces its fields. A bank must keep
+// the fields required by the amount mode of its parsing profile mapped once it maps them.
+func (s *Service) UpdateHeaderMapping(ctx context.Context, data *HeaderMappingUpdateData) (*HeaderMapping, error) {
+	header, err := s.repo.getBankHeader(ctx, data.ID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get bank header", err, "header_id", data.ID)
+		return nil, psql.MapPostgresError("failed to get bank header", err)
+	}
+
+	updated := *header
+	if data.Name != nil {
+		updated.Name = strings.TrimSpace(*data.Name)
+	}
+	if data.Required != nil {
+		updated.Required = *data.Required
+	}
+	if len(data.TrFields) > 0 {
+		updated.TrFields = data.TrFields
+	}
+
+	if err = validateHeaderMapping(&updated); err != nil {
+		return nil, err
+	}
+	if err = s.validateRequiredFieldsKept(ctx, header.BankID, header.ID, &updated); err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.updateBankHeader(ctx, &updated); err != nil {
+		logger.ErrorWithFields("failed to update bank header", err, "header_id", data.ID)
+		return nil, psql.MapPostgresError("failed to update bank header", err)
+	}
+
+	return s.headerMappingAfterChange(ctx, data.ID)
+}
+
+// DeleteHeaderMapping deletes a header with its field mappings, unless it is the last one mapping a required field.
+func (s *Service) DeleteHeaderMapping(ctx context.Context, id int64) error {
+	header, err := s.repo.getBankHeader(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to get bank header", err, "header_id", id)
+		return psql.MapPostgresError("failed to get bank header", err)
+	}
+
+	if err = s.validateRequiredFieldsKept(ctx, header.BankID, header.ID, nil); err != nil {
+		return err
+	}
+
+	if err = s.repo.deleteBankHeader(ctx, id); err != nil {
+		logger.ErrorWithFields("failed to delete bank header", err, "header_id", id)
+		return psql.MapPostgresError("failed to delete bank header", err)
+	}
+
+	s.reloadHeaderMappingsAfterChange(ctx)
+
+	return nil
+}
+
+// MissingRequiredFields returns the transaction fields required by the amount mode that no header maps, in the
+// order they are required.
+func MissingRequiredFields(headers []HeaderMapping, amountMode csvParser.AmountMode) []transaction.TransactionField {
+	mapped := mappedFields(headers)
+
+	missing := make([]transaction.TransactionField, 0, len(requiredTransactionFields[amountMode]))
+	for _, field := range requiredTransactionFields[amountMode] {
+		if !mapped[field] {
+			missing = append(missing, field)
+		}
+	}
+
+	return missing
+}
+
+func (s *Service) headerMappingAfterChange(ctx context.Context, id int64) (*HeaderMapping, error) {
+	s.reloadHeaderMappingsAfterChange(ctx)
+
+	header, err := s.repo.getBankHeader(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to get bank header", err, "header_id", id)
+		return nil, psql.MapPostgresError("failed to get bank header", err)
+	}
+
+	return header, nil
+}
+
+// reloadHeaderMappingsAfterChange refreshes the store right away instead of waiting for the cache invalidation;
+// a failed reload leaves the previous mappings in use.
+func (s *Service) reloadHeaderMappingsAfterChange(ctx context.Context) {
+	if err := s.ReloadHeaderMappings(ctx); err != nil {
+		logger.Error("failed to reload header mapping store", err)
+	}
+}
+
+// validateRequiredFieldsKept checks that replacing the header with changed, or removing it when changed is nil,
+// does not unmap a required field the bank maps today.
+func (s *Service) validateRequiredFieldsKept(ctx context.Context, bankID, headerID int64, changed *HeaderMapping) error {
+	profile, err := s.GetParsingProfile(ctx, bankID)
+	if err != nil {
+		return err
+	}
+
+	headers, err := s.repo.bankHeaderList(ctx, bankID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get bank headers", err, "bank_id", bankID)
+		return psql.MapPostgresError("failed to get bank headers", err)
+	}
+
+	after := make([]HeaderMapping, 0, len(headers))
+	for _, header := range headers {
+		if header.ID != headerID {
+			after = append(after, header)
+		}
+	}
+	if changed != nil {
+		after = append(after, *changed)
+	}
+
+	before, remaining := mappedFields(headers), mappedFields(after)
+	for _, field := range requiredTransactionFields[profile.AmountMode] {
+		if before[field] && !remaining[field] {
+			return status.Errorf(codes.FailedPrecondition, "invalid header mapping: %s must stay mapped by a header of the bank", field)
+		}
+	}
+
+	return nil
+}
+
+func validateHeaderMapping(header *HeaderMapping) error {
+	if header.Name == "" || len(header.Name) > maxHeaderNameLen {
+		return status.Errorf(codes.InvalidArgument, "invalid header mapping: name must be 1-%d characters", maxHeaderNameLen)
+	}
+	if len(header.TrFields) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid header mapping: at least one transaction field is required")
+	}
+
+	for i, field := range header.TrFields {
+		if !transactionFields[field] {
+			return status.Errorf(codes.InvalidArgument, "invalid header mapping: unknown transaction field %q", field)
+		}
+		if slices.Contains(header.TrFields[:i], field) {
+			return status.Errorf(codes.InvalidArgument, "invalid header mapping: transaction field %s is listed twice", field)
+		}
+	}
+
+	return nil
+}
+
+func mappedFields(headers []HeaderMapping) map[transaction.TransactionField]bool {
+	res := map[transaction.TransactionField]bool{}
+	for _, header := range headers {
+		for _, field := range header.TrFields {
+			res[field] = true
+		}
+	}
+
+	return res
+}
+
+// validateAmountMode checks that a bank mapping an amount column maps the ones the amount mode reads, so switching
+// the mode does not import rows without amounts.
+func validateAmountMode(headers []HeaderMapping, amountMode csvParser.AmountMode) error {
+	mapped := mappedFields(headers)
+	if !slices.ContainsFunc(amountTransactionFields, func(field transaction.TransactionField) bool { return mapped[field] }) {
+		return nil
+	}
+
+	for _, field := range requiredTransactionFields[amountMode] {
+		if !mapped[field] {
+			return status.Errorf(codes.FailedPrecondition,
+				"invalid parsing profile: amount mode %s reads %s, which no header of the bank maps", amountMode, field)
+		}
+	}
+
+	return nil
+}
@@ -1,12 +1,72 @@
 package uploader
 
 import (
+	"time"
+
 	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	csvParser "github.com/Everest13/fin-aggregator-service/internal/service/uploader/csv-parser"
+)
+
+const (
+	maxHeaderNameLen = 50
+	maxDateLayouts   = 10
+	// maxSkippedRows bounds the rows above the header row and the rows skipped below it.
+	maxSkippedRows = 100
 )
 
+var (
+	decimalSeparators   = []string{".", ","}
+	thousandsSeparators = []string{",", ".", " ", "'"}
+	// layoutReferenceTime checks that a date layout formats and parses a date.
+	layoutReferenceTime = time.Date(2021, time.November, 23, 17, 8, 9, 0, time.UTC)
+)
+
+// requiredTransactionFields must be mapped by some header of a bank for its CSV files to import, by the amount
+// mode of its parsing profile.
+var requiredTransactionFields = map[csvParser.AmountMode][]transaction.TransactionField{
+	csvParser.SingleAmountMode: {
+		transaction.DateTransactionField,
+		transaction.AmountTransactionField,
+	},
+	csvParser.DebitCreditAmountMode: {
+		transaction.DateTransactionField,
+		transaction.DebitTransactionField,
+		transaction.CreditTransactionField,
+	},
+}
+
+// amountTransactionFields are the fields an amount is read from in any amount mode.
+var amountTransactionFields = []transaction.TransactionField{
+	transaction.AmountTransactionField,
+	transaction.DebitTransactionField,
+	transaction.CreditTransactionField,
+}
+
+var transactionFields = map[transaction.TransactionField]bool{
+	transaction.DateTransactionField:        true,
+	transaction.AmountTransactionField:      true,
+	transaction.CategoryTransactionField:    true,
+	transaction.ExternalIDTransactionField:  true,
+	transaction.DescriptionTransactionField: true,
+	transaction.BalanceTransactionField:     true,
+	transaction.DebitTransactionField:       true,
+	transaction.CreditTransactionField:      true,
+}
+
+// HeaderMapping is a CSV header of a bank and the transaction fields its column is parsed into. The import fails
+// when a required header is missing from the file.
 type HeaderMapping struct {
+	ID       int64
 	BankID   int64
 	Name     string
 	Required bool
 	TrFields []transaction.TransactionField
 }
+
+type HeaderMappingUpdateData struct {
+	ID       int64
+	Name     *string
+	Required *bool
+	// TrFields replaces the mapped fields when not empty.
+	TrFields []transaction.TransactionField
+}
@@ -0,0 +1,173 @@
+package uploader
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	csvParser "github.com/Everest13/fin-aggregator-service/internal/service/uploader/csv-parser"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetParsingProfile returns the stored parsing profile of a bank, or the default profile when it has none.
+func (s *Service) GetParsingProfile(ctx context.Context, bankID int64) (*csvParser.Profile, error) {
+	if _, err := s.bankService.GetBank(ctx, bankID); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.repo.getParsingProfile(ctx, bankID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return csvParser.DefaultProfile(bankID), nil
+	}
+	if err != nil {
+		logger.ErrorWithFields("failed to get bank parsing profile", err, "bank_id", bankID)
+		return nil, psql.MapPostgresError("failed to get bank parsing profile", err)
+	}
+
+	return profile, nil
+}
+
+// SaveParsingProfile stores the parsing profile of a bank, replacing the previous one. Settings left empty take
+// the default value; an empty delimiter, encoding or header row is detected from each file.
+func (s *Service) SaveParsingProfile(ctx context.Context, profile *csvParser.Profile) (*csvParser.Profile, error) {
+	if _, err := s.bankService.GetBank(ctx, profile.BankID); err != nil {
+		return nil, err
+	}
+
+	normalizeParsingProfile(profile)
+	if err := validateParsingProfile(profile); err != nil {
+		return nil, err
+	}
+
+	headers, err := s.repo.bankHeaderList(ctx, profile.BankID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get bank headers", err, "bank_id", profile.BankID)
+		return nil, psql.MapPostgresError("failed to save bank parsing profile", err)
+	}
+	if err = validateAmountMode(headers, profile.AmountMode); err != nil {
+		return nil, err
+	}
+
+	saved, err := s.repo.saveParsingProfile(ctx, profile)
+	if err != nil {
+		logger.ErrorWithFields("failed to save bank parsing profile", err, "bank_id", profile.BankID)
+		return nil, psql.MapPostgresError("failed to save bank parsing profile", err)
+	}
+
+	return saved, nil
+}
+
+// DeleteParsingProfile makes the bank use the default profile again.
+func (s *Service) DeleteParsingProfile(ctx context.Context, bankID int64) error {
+	headers, err := s.repo.bankHeaderList(ctx, bankID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get bank headers", err, "bank_id", bankID)
+		return psql.MapPostgresError("failed to delete bank parsing profile", err)
+	}
+	if err = validateAmountMode(headers, csvParser.DefaultProfile(bankID).AmountMode); err != nil {
+		return err
+	}
+
+	if err = s.repo.deleteParsingProfile(ctx, bankID); err != nil {
+		logger.ErrorWithFields("failed to delete bank parsing profile", err, "bank_id", bankID)
+		return psql.MapPostgresError("failed to delete bank parsing profile", err)
+	}
+
+	return nil
+}
+
+func normalizeParsingProfile(profile *csvParser.Profile) {
+	defaults := csvParser.DefaultProfile(profile.BankID)
+
+	layouts := make([]string, 0, len(profile.DateLayouts))
+	for _, layout := range profile.DateLayouts {
+		if layout = strings.TrimSpace(layout); layout != "" && !slices.Contains(layouts, layout) {
+			layouts = append(layouts, layout)
+		}
+	}
+	profile.DateLayouts = layouts
+	if len(profile.DateLayouts) == 0 {
+		profile.DateLayouts = defaults.DateLayouts
+	}
+
+	profile.Timezone = strings.TrimSpace(profile.Timezone)
+	if profile.Timezone == "" {
+		profile.Timezone = defaults.Timezone
+	}
+	if profile.DecimalSeparator == "" {
+		profile.DecimalSeparator = defaults.DecimalSeparator
+	}
+	if profile.SignConvention == "" {
+		profile.SignConvention = defaults.SignConvention
+	}
+	if profile.AmountMode == "" {
+		profile.AmountMode = defaults.AmountMode
+	}
+}
+
+func validateParsingProfile(profile *csvParser.Profile) error {
+	if len(profile.DateLayouts) > maxDateLayouts {
+		return status.Errorf(codes.InvalidArgument, "invalid parsing profile: at most %d date layouts", maxDateLayouts)
+	}
+	for _, layout := range profile.DateLayouts {
+		// A layout without any reference date element formats to itself and would only match its own text.
+		formatted := layoutReferenceTime.Format(layout)
+		if _, err := time.Parse(layout, formatted); err != nil || formatted == layout {
+			return status.Errorf(codes.InvalidArgument, "invalid parsing profile: %q is not a Go date layout", layout)
+		}
+	}
+
+	if _, err := time.LoadLocation(profile.Timezone); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid parsing profile: unknown timezone %q", profile.Timezone)
+	}
+
+	if !slices.Contains(decimalSeparators, profile.DecimalSeparator) {
+		return status.Errorf(codes.InvalidArgument, "invalid parsing profile: decimal separator must be one of %q", decimalSeparators)
+	}
+	if profile.ThousandsSeparator != "" &&
+		(!slices.Contains(thousandsSeparators, profile.ThousandsSeparator) || profile.ThousandsSeparator == profile.DecimalSeparator) {
+		return status.Errorf(codes.InvalidArgument,
+			"invalid parsing profile: thousands separator must be empty or one of %q, other than the decimal separator", thousandsSeparators)
+	}
+
+	switch profile.SignConvention {
+	case csvParser.SignedConvention, csvParser.NegativeOutcomeConvention, csvParser.PositiveOutcomeConvention, csvParser.CategoryKindConvention:
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid parsing profile: unknown sign convention %s", profile.SignConvention)
+	}
+
+	switch profile.AmountMode {
+	case csvParser.SingleAmountMode, csvParser.DebitCreditAmountMode:
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid parsing profile: unknown amount mode %s", profile.AmountMode)
+	}
+
+	switch profile.Encoding {
+	case "", csvParser.UTF8Encoding, csvParser.UTF16LEEncoding, csvParser.UTF16BEEncoding, csvParser.Windows1252Encoding,
+		csvParser.ISO88591Encoding:
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid parsing profile: unsupported encoding %s", profile.Encoding)
+	}
+
+	if profile.Delimiter != "" {
+		delimiter, size := utf8.DecodeRuneInString(profile.Delimiter)
+		if size != len(profile.Delimiter) || delimiter == '"' || delimiter == '\'' || delimiter == '\r' || delimiter == '\n' ||
+			delimiter == utf8.RuneError {
+			return status.Errorf(codes.InvalidArgument, "invalid parsing profile: delimiter must be a single character other than a quote or line break")
+		}
+	}
+
+	if profile.HeaderRow != nil && (*profile.HeaderRow < 0 || *profile.HeaderRow > maxSkippedRows) ||
+		profile.SkipRows < 0 || profile.SkipRows > maxSkippedRows {
+		return status.Errorf(codes.InvalidArgument, "invalid parsing profile: header row and rows to skip must be 0-%d", maxSkippedRows)
+	}
+
+	return nil
+}
@@ -2,12 +2,24 @@ package uploader
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
+	csvParser "github.com/Everest13/fin-aggregator-service/internal/service/uploader/csv-parser"
 	"github.com/Masterminds/squirrel"
 	"github.com/georgysavva/scany/v2/pgxscan"
-
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const parsingProfileTable = "bank_parsing_profile"
+
+var parsingProfileColumns = []string{
+	"bank_id", "date_layouts", "timezone", "decimal_separator", "thousands_separator", "sign_convention", "amount_mode",
+	"delimiter", "encoding", "header_row", "skip_rows", "created_at", "updated_at",
+}
+
 type repository struct {
 	dbPool *pgxpool.Pool
 }
@@ -21,6 +33,7 @@ func newRepository(dbPool *pgxpool.Pool) *repository {
 func (r *repository) getBankHeaderMappings(ctx context.Context) ([]HeaderMapping, error) {
 	query, args, err := squirrel.
 		Select(
+			"bh.id",
 			"bh.bank_id",
 			"bh.name",
 			"bh.required",
@@ -46,6 +59,7 @@ func (r *repository) getBankHeaderMappings(ctx context.Context) ([]HeaderMapping
 func (r *repository) getBankHeaderMappingsByBank(ctx context.Context, bankID int64) ([]HeaderMapping, error) {
 	query, args, err := squirrel.
 		Select(
+			"bh.id",
 			"bh.bank_id",
 			"bh.name",
 			"bh.required",
@@ -68,3 +82,224 @@ func (r *repository) getBankHeaderMappingsByBank(ctx context.Context, bankID int
 
 	return headerMapping, nil
 }
+
+// bankHeaderQuery selects header definitions with their mapped fields, including headers without any mapping.
+func bankHeaderQuery() squirrel.SelectBuilder {
+	return squirrel.
+		Select(
+			"bh.id",
+			"bh.bank_id",
+			"bh.name",
+			"bh.required",
+			"COALESCE(array_agg(bhm.transaction_field) FILTER (WHERE bhm.transaction_field IS NOT NULL), '{}') AS tr_fields",
+		).
+		From("bank_header bh").
+		LeftJoin("bank_header_mapping bhm ON bh.id = bhm.header_id").
+		GroupBy("bh.id").
+		OrderBy("bh.id").
+		PlaceholderFormat(squirrel.Dollar)
+}
+
+func (r *repository) bankHeaderList(ctx context.Context, bankID int64) ([]HeaderMapping, error) {
+	query, args, err := bankHeaderQuery().
+		Where(squirrel.Eq{"bh.bank_id": bankID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var headers []HeaderMapping
+	if err = pgxscan.Select(ctx, r.dbPool, &headers, query, args...); err != nil {
+		return nil, err
+	}
+
+	return headers, nil
+}
+
+func (r *repository) getBankHeader(ctx context.Context, id int64) (*HeaderMapping, error) {
+	query, args, err := bankHeaderQuery().
+		Where(squirrel.Eq{"bh.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var header HeaderMapping
+	if err = pgxscan.Get(ctx, r.dbPool, &header, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &header, nil
+}
+
+// createBankHeader inserts a header definition with its field mappings and returns the header id.
+func (r *repository) createBankHeader(ctx context.Context, header *HeaderMapping) (int64, error) {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var id int64
+	err = tx.QueryRow(ctx,
+		"INSERT INTO bank_header (bank_id, name, required) VALUES ($1, $2, $3) RETURNING id",
+		header.BankID, header.Name, header.Required,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = insertHeaderFields(ctx, tx, id, header.TrFields); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return id, nil
+}
+
+// updateBankHeader saves the header definition and replaces its field mappings.
+func (r *repository) updateBankHeader(ctx context.Context, header *HeaderMapping) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, "UPDATE bank_header SET name = $2, required = $3 WHERE id = $1", header.ID, header.Name, header.Required)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM bank_header_mapping WHERE header_id = $1", header.ID); err != nil {
+		return err
+	}
+
+	if err = insertHeaderFields(ctx, tx, header.ID, header.TrFields); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) deleteBankHeader(ctx context.Context, id int64) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, "DELETE FROM bank_header_mapping WHERE header_id = $1", id); err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, "DELETE FROM bank_header WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func insertHeaderFields(ctx context.Context, tx pgx.Tx, headerID int64, fields []transaction.TransactionField) error {
+	queryBuilder := squirrel.
+		Insert("bank_header_mapping").
+		Columns("header_id", "transaction_field").
+		PlaceholderFormat(squirrel.Dollar)
+	for _, field := range fields {
+		queryBuilder = queryBuilder.Values(headerID, field)
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *repository) getParsingProfile(ctx context.Context, bankID int64) (*csvParser.Profile, error) {
+	query, args, err := squirrel.
+		Select(parsingProfileColumns...).
+		From(parsingProfileTable).
+		Where(squirrel.Eq{"bank_id": bankID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var profile csvParser.Profile
+	if err = pgxscan.Get(ctx, r.dbPool, &profile, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+func (r *repository) saveParsingProfile(ctx context.Context, profile *csvParser.Profile) (*csvParser.Profile, error) {
+	query, args, err := squirrel.
+		Insert(parsingProfileTable).
+		Columns(
+			"bank_id", "date_layouts", "timezone", "decimal_separator", "thousands_separator", "sign_convention",
+			"amount_mode", "delimiter", "encoding", "header_row", "skip_rows",
+		).
+		Values(
+			profile.BankID, profile.DateLayouts, profile.Timezone, profile.DecimalSeparator, profile.ThousandsSeparator,
+			profile.SignConvention, profile.AmountMode, profile.Delimiter, profile.Encoding, profile.HeaderRow, profile.SkipRows,
+		).
+		Suffix(`ON CONFLICT (bank_id) DO UPDATE SET
+			date_layouts = EXCLUDED.date_layouts,
+			timezone = EXCLUDED.timezone,
+			decimal_separator = EXCLUDED.decimal_separator,
+			thousands_separator = EXCLUDED.thousands_separator,
+			sign_convention = EXCLUDED.sign_convention,
+			amount_mode = EXCLUDED.amount_mode,
+			delimiter = EXCLUDED.delimiter,
+			encoding = EXCLUDED.encoding,
+			header_row = EXCLUDED.header_row,
+			skip_rows = EXCLUDED.skip_rows,
+			updated_at = CURRENT_TIMESTAMP
+			RETURNING ` + strings.Join(parsingProfileColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var saved csvParser.Profile
+	if err = pgxscan.Get(ctx, r.dbPool, &saved, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+func (r *repository) deleteParsingProfile(ctx context.Context, bankID int64) error {
+	tag, err := r.dbPool.Exec(ctx, "DELETE FROM bank_parsing_profile WHERE bank_id = $1", bankID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
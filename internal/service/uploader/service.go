@@ -1,12 +1,15 @@
 package uploader
 
 import (
-	"bytes"
 	"context"
-	"encoding/csv"
+	"errors"
 	"fmt"
+	"github.com/Everest13/fin-aggregator-service/internal/service/account"
+	"github.com/Everest13/fin-aggregator-service/internal/service/balance"
 	"github.com/Everest13/fin-aggregator-service/internal/service/bank"
 	"github.com/Everest13/fin-aggregator-service/internal/service/category"
+	"github.com/Everest13/fin-aggregator-service/internal/service/event"
+	"github.com/Everest13/fin-aggregator-service/internal/service/rule"
 	"github.com/Everest13/fin-aggregator-service/internal/service/transaction"
 	csvParser "github.com/Everest13/fin-aggregator-service/internal/service/uploader/csv-parser"
 	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
@@ -18,38 +21,61 @@ import (
 
 var chunkSize = 100
 
+// errRowNotSaved is reported for the parsed rows of a chunk whose transactions failed to save.
+var errRowNotSaved = errors.New("failed to save transaction")
+
 type Service struct {
 	repo               *repository
 	headerMappingStore *HeaderMappingStore
-	csvParserFactory   *csvParser.Factory
 	bankService        *bank.Service
+	accountService     *account.Service
+	balanceService     *balance.Service
 	transactionService *transaction.Service
 	categoryService    *category.Service
+	eventService       *event.Service
+	ruleService        *rule.Service
 }
 
 func NewService(
 	dbPool *pgxpool.Pool,
 	bankService *bank.Service,
+	accountService *account.Service,
+	balanceService *balance.Service,
 	transactionService *transaction.Service,
 	categoryService *category.Service,
+	eventService *event.Service,
+	ruleService *rule.Service,
 ) *Service {
 	service := &Service{
 		repo:               newRepository(dbPool),
 		headerMappingStore: NewHeaderMappingStore(),
-		csvParserFactory:   csvParser.NewFactory(categoryService),
 		bankService:        bankService,
+		accountService:     accountService,
+		balanceService:     balanceService,
 		transactionService: transactionService,
 		categoryService:    categoryService,
+		eventService:       eventService,
+		ruleService:        ruleService,
 	}
 
 	return service
 }
 
 func (s *Service) Initialize(ctx context.Context) error {
+	if err := s.ReloadHeaderMappings(ctx); err != nil {
+		return fmt.Errorf("service initialization failed: %w", err)
+	}
+
+	return nil
+}
+
+// ReloadHeaderMappings loads the bank header mappings into the store again; on failure the store keeps the
+// previous mappings.
+func (s *Service) ReloadHeaderMappings(ctx context.Context) error {
 	headerMappings, err := s.repo.getBankHeaderMappings(ctx)
 	if err != nil {
 		logger.Error("failed to get header_mapping", err)
-		return fmt.Errorf("service initialization failed: %w", err)
+		return err
 	}
 
 	bankHeaderMapping := map[int64][]HeaderMapping{}
@@ -62,54 +88,119 @@ func (s *Service) Initialize(ctx context.Context) error {
 	return nil
 }
 
-func (s *Service) UploadCSV(ctx context.Context, bankID, userID int64, csvData []byte) (map[int64][]error, error) {
-	reader := csv.NewReader(bytes.NewReader(csvData))
-	records, err := reader.ReadAll()
+// UploadCSV imports a CSV file of a bank and returns the errors of rows that failed, by row number, and the
+// dialect the file was read with.
+func (s *Service) UploadCSV(ctx context.Context, bankID, userID int64, accountID *int64, csvData []byte) (map[int64][]error, *csvParser.Dialect, error) {
+	if accountID != nil {
+		if _, err := s.accountService.GetImportAccount(ctx, *accountID, bankID, userID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	profile, err := s.GetParsingProfile(ctx, bankID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headerMapping, err := s.getHeaderMapping(ctx, bankID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get bank headers")
+	}
+	// Headers are added one at a time, so a bank may not map every required field yet.
+	if missing := MissingRequiredFields(headerMapping, profile.AmountMode); len(missing) > 0 {
+		s.importFailed(ctx, bankID, userID, "bank header mapping is incomplete")
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "bank headers do not map the required fields %v", missing)
+	}
+	headerNames := make([]string, 0, len(headerMapping))
+	for _, hM := range headerMapping {
+		headerNames = append(headerNames, hM.Name)
+	}
+
+	header, records, dialect, err := csvParser.ReadRecords(csvData, profile, headerNames)
 	if err != nil {
 		logger.ErrorWithFields("CSV parsing error", err, "bank_id", bankID, "user_id", userID)
-		return nil, status.Errorf(codes.InvalidArgument, "invalid CSV format")
+		s.importFailed(ctx, bankID, userID, "invalid CSV format")
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid CSV format")
 	}
 
-	if len(records) == 0 || len(records[0]) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "invalid CSV format")
+	recordErrs, err := s.processTransactionBatches(ctx, header, records, profile, bankID, userID, accountID)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return s.processTransactionBatches(ctx, records, bankID, userID)
+	return recordErrs, dialect, nil
 }
 
-func (s *Service) processTransactionBatches(ctx context.Context, records [][]string, bankID, userID int64) (map[int64][]error, error) {
-	bankParser, err := s.getBankParser(ctx, bankID)
+func (s *Service) processTransactionBatches(
+	ctx context.Context,
+	header []string,
+	records [][]string,
+	profile *csvParser.Profile,
+	bankID, userID int64,
+	accountID *int64,
+) (map[int64][]error, error) {
+	bankParser, err := s.getBankParser(ctx, bankID, profile)
 	if err != nil {
 		return nil, err
 	}
 
-	transactionFieldColumns, err := s.getTransactionFieldColumns(ctx, records[0], bankID)
+	transactionFieldColumns, err := s.getTransactionFieldColumns(ctx, header, bankID)
 	if err != nil {
 		logger.ErrorWithFields("transaction fields columns getting failed", err, "bank_id", bankID, "user_id", userID)
+		s.importFailed(ctx, bankID, userID, "CSV headers do not match the bank header mapping")
 		return nil, status.Errorf(codes.InvalidArgument, "invalid CSV format")
 	}
 
 	var wg sync.WaitGroup
+	var balanceMu sync.Mutex
+	balancePoints := make([]balancePoint, 0)
 
-	records = records[1:]
 	errCh := make(chan map[int64][]error, (len(records)/chunkSize)+1)
 	processChunk := func(chunk [][]string, startRow int) {
 		defer wg.Done()
 
 		transactions, recordsErrs := bankParser.ParseRecords(ctx, chunk, transactionFieldColumns, bankID, userID)
-		if len(recordsErrs) > 0 {
-			mappedErrs := make(map[int64][]error)
-			for recordErrs, errs := range recordsErrs {
-				mappedErrs[recordErrs+int64(startRow)] = errs
+		mappedErrs := make(map[int64][]error, len(recordsErrs))
+		for recordErrs, errs := range recordsErrs {
+			mappedErrs[recordErrs+int64(startRow)] = errs
+		}
+		defer func() {
+			if len(mappedErrs) > 0 {
+				errCh <- mappedErrs
 			}
-			errCh <- mappedErrs
+		}()
+
+		chunkBalancePoints := make([]balancePoint, 0)
+		for i, tr := range transactions {
+			tr.AccountID = accountID
+			if tr.RunningBalance != nil && !tr.TransactionDate.IsZero() {
+				chunkBalancePoints = append(chunkBalancePoints, balancePoint{
+					date:    tr.TransactionDate,
+					row:     startRow + i,
+					balance: *tr.RunningBalance,
+				})
+			}
+		}
+
+		if len(transactions) == 0 {
+			return
 		}
 
 		saveErr := s.transactionService.SaveTransactions(ctx, transactions)
 		if saveErr != nil {
-			//todo handling err
-			logger.ErrorWithFields("transaction persistence error", err, "bank_id", bankID, "user_id", userID)
+			// The chunk is saved in one database transaction, so none of its parsed rows were imported.
+			logger.ErrorWithFields("transaction persistence error", saveErr, "bank_id", bankID, "user_id", userID)
+			for i := range chunk {
+				if _, ok := recordsErrs[int64(i+1)]; !ok {
+					mappedErrs[int64(startRow+i+1)] = []error{errRowNotSaved}
+				}
+			}
+			return
 		}
+
+		balanceMu.Lock()
+		balancePoints = append(balancePoints, chunkBalancePoints...)
+		balanceMu.Unlock()
 	}
 
 	for i := 0; i < len(records); i += chunkSize {
@@ -130,9 +221,34 @@ func (s *Service) processTransactionBatches(ctx context.Context, records [][]str
 		}
 	}
 
+	if accountID != nil {
+		err = s.balanceService.SaveImportedSnapshots(ctx, dailyBalanceSnapshots(*accountID, balancePoints))
+		if err != nil {
+			logger.ErrorWithFields("failed to save CSV balance snapshots", err, "bank_id", bankID, "account_id", *accountID)
+		}
+	}
+
+	s.eventService.Publish(ctx, event.ImportCompletedType, event.ImportCompleted{
+		Source:      event.CSVImportSource,
+		BankID:      bankID,
+		UserID:      userID,
+		AccountID:   accountID,
+		RecordCount: len(records),
+		ErrorCount:  len(allRecordErrs),
+	})
+
 	return allRecordErrs, nil
 }
 
+func (s *Service) importFailed(ctx context.Context, bankID, userID int64, reason string) {
+	s.eventService.Publish(ctx, event.ImportFailedType, event.ImportFailed{
+		Source: event.CSVImportSource,
+		BankID: bankID,
+		UserID: userID,
+		Reason: reason,
+	})
+}
+
 func getChunkEnd(a, b int) int {
 	if a > b {
 		return b
@@ -140,7 +256,7 @@ func getChunkEnd(a, b int) int {
 	return a
 }
 
-func (s *Service) getBankParser(ctx context.Context, bankID int64) (csvParser.Parser, error) {
+func (s *Service) getBankParser(ctx context.Context, bankID int64, profile *csvParser.Profile) (*csvParser.Parser, error) {
 	bankInfo, err := s.bankService.GetBank(ctx, bankID)
 	if err != nil {
 		logger.ErrorWithFields("failed to get bank", err, "bank_id", bankID)
@@ -149,7 +265,12 @@ func (s *Service) getBankParser(ctx context.Context, bankID int64) (csvParser.Pa
 
 	for _, importMethod := range bankInfo.ImportMethods {
 		if importMethod == bank.CSVImportMethod {
-			return s.csvParserFactory.GetParser(bank.BankName(bankInfo.Name)), nil
+			parser, err := csvParser.NewParser(s.ruleService, profile)
+			if err != nil {
+				logger.ErrorWithFields("invalid bank parsing profile", err, "bank_id", bankID)
+				return nil, status.Errorf(codes.FailedPrecondition, "invalid bank parsing profile: %v", err)
+			}
+			return parser, nil
 		}
 	}
 
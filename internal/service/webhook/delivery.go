@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/utils/retry"
+)
+
+// Sign returns the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription secret.
+// Receivers recompute it from the X-Webhook-Timestamp header and the raw body.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// send posts the delivery payload and returns the response status code, zero if no response was received.
+func (s *Service) send(ctx context.Context, subscription *Subscription, delivery *Delivery) (int32, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventHeader, string(delivery.EventType))
+	req.Header.Set(deliveryHeader, delivery.EventID)
+	req.Header.Set(timestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(signatureHeader, "sha256="+Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return int32(resp.StatusCode), fmt.Errorf("subscriber responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	return int32(resp.StatusCode), nil
+}
+
+// attempt sends one delivery and schedules a retry, or dead-letters it once maxAttempts is reached.
+// Deliveries of removed or disabled subscriptions are dead-lettered right away and can be redelivered later.
+func (s *Service) attempt(ctx context.Context, subscription *Subscription, delivery *Delivery) {
+	now := time.Now().UTC()
+	delivery.Attempts++
+
+	var code int32
+	var err error
+	retryable := true
+	switch {
+	case subscription == nil:
+		err = fmt.Errorf("subscription %d no longer exists", delivery.SubscriptionID)
+		retryable = false
+	case !subscription.Enabled:
+		err = fmt.Errorf("subscription %d is disabled", delivery.SubscriptionID)
+		retryable = false
+	default:
+		code, err = s.send(ctx, subscription, delivery)
+	}
+
+	if code != 0 {
+		delivery.LastStatusCode = &code
+	}
+
+	switch {
+	case err == nil:
+		delivery.Status = SucceededDeliveryStatus
+		delivery.LastError = nil
+		delivery.DeliveredAt = &now
+	case !retryable || delivery.Attempts >= maxAttempts:
+		msg := err.Error()
+		delivery.Status = DeadDeliveryStatus
+		delivery.LastError = &msg
+	default:
+		msg := err.Error()
+		delivery.Status = PendingDeliveryStatus
+		delivery.LastError = &msg
+		delivery.NextAttemptAt = now.Add(retry.Backoff(delivery.Attempts, baseBackoff, maxBackoff))
+	}
+}
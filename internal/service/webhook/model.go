@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	subscriptionTable = "webhook_subscription"
+	deliveryTable     = "webhook_delivery"
+)
+
+const (
+	secretLen = 32
+
+	// maxAttempts is the number of delivery attempts before a delivery is dead-lettered.
+	maxAttempts = 8
+	// baseBackoff doubles after every failed attempt up to maxBackoff.
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+	// claimLease keeps a claimed delivery from being picked up again while it is being sent.
+	claimLease = 5 * time.Minute
+
+	pollInterval     = 5 * time.Second
+	deliveryBatch    = 50
+	defaultListLimit = 100
+)
+
+const (
+	signatureHeader = "X-Webhook-Signature"
+	timestampHeader = "X-Webhook-Timestamp"
+	eventHeader     = "X-Webhook-Event"
+	deliveryHeader  = "X-Webhook-Delivery"
+)
+
+type EventType string
+
+const (
+	TransactionCreatedEventType EventType = "transaction.created"
+	TransactionUpdatedEventType EventType = "transaction.updated"
+	ImportCompletedEventType    EventType = "import.completed"
+	MonzoSyncFailedEventType    EventType = "monzo.sync.failed"
+
+	// AllEventType subscribes to every event.
+	AllEventType EventType = "*"
+)
+
+var knownEventTypes = map[EventType]bool{
+	TransactionCreatedEventType: true,
+	TransactionUpdatedEventType: true,
+	ImportCompletedEventType:    true,
+	MonzoSyncFailedEventType:    true,
+	AllEventType:                true,
+}
+
+type DeliveryStatus string
+
+const (
+	PendingDeliveryStatus   DeliveryStatus = "PENDING"
+	SucceededDeliveryStatus DeliveryStatus = "SUCCEEDED"
+	DeadDeliveryStatus      DeliveryStatus = "DEAD"
+)
+
+type Subscription struct {
+	ID          int64
+	URL         string
+	Secret      string
+	EventTypes  []string
+	Description *string
+	Enabled     bool
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+type SubscriptionUpdateData struct {
+	ID          int64
+	URL         *string
+	EventTypes  []string
+	Description *string
+	Enabled     *bool
+}
+
+type Delivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        string
+	EventType      EventType
+	Payload        json.RawMessage
+	Status         DeliveryStatus
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastStatusCode *int32
+	LastError      *string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+type DeliveryFilter struct {
+	SubscriptionID *int64
+	Status         *DeliveryStatus
+	Limit          uint64
+}
+
+// Event is the signed JSON body posted to subscribers.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      EventType `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// MonzoSyncFailed is the payload of monzo.sync.failed events.
+type MonzoSyncFailed struct {
+	BankID int64  `json:"bank_id"`
+	UserID int64  `json:"user_id"`
+	Reason string `json:"reason"`
+}
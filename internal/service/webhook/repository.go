@@ -0,0 +1,283 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var subscriptionColumns = []string{"id", "url", "secret", "event_types", "description", "enabled", "created_at", "updated_at"}
+
+const subscriptionReturning = "RETURNING id, url, secret, event_types, description, enabled, created_at, updated_at"
+
+var deliveryColumns = []string{
+	"id", "subscription_id", "event_id", "event_type", "payload", "status", "attempts", "next_attempt_at",
+	"last_status_code", "last_error", "created_at", "delivered_at",
+}
+
+type repository struct {
+	dbPool *pgxpool.Pool
+}
+
+func newRepository(dbPool *pgxpool.Pool) *repository {
+	return &repository{
+		dbPool: dbPool,
+	}
+}
+
+func (r *repository) getSubscription(ctx context.Context, id int64) (*Subscription, error) {
+	query, args, err := squirrel.
+		Select(subscriptionColumns...).
+		From(subscriptionTable).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var subscription Subscription
+	if err = pgxscan.Get(ctx, r.dbPool, &subscription, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (r *repository) subscriptionList(ctx context.Context) ([]Subscription, error) {
+	query, args, err := squirrel.
+		Select(subscriptionColumns...).
+		From(subscriptionTable).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var subscriptions []Subscription
+	if err = pgxscan.Select(ctx, r.dbPool, &subscriptions, query, args...); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// subscriptionsForEvent returns enabled subscriptions to the event type or to all events.
+func (r *repository) subscriptionsForEvent(ctx context.Context, eventType EventType) ([]Subscription, error) {
+	query, args, err := squirrel.
+		Select(subscriptionColumns...).
+		From(subscriptionTable).
+		Where(squirrel.Eq{"enabled": true}).
+		Where("(? = ANY(event_types) OR ? = ANY(event_types))", string(eventType), string(AllEventType)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var subscriptions []Subscription
+	if err = pgxscan.Select(ctx, r.dbPool, &subscriptions, query, args...); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (r *repository) createSubscription(ctx context.Context, subscription *Subscription) (*Subscription, error) {
+	query, args, err := squirrel.
+		Insert(subscriptionTable).
+		Columns("url", "secret", "event_types", "description", "enabled").
+		Values(subscription.URL, subscription.Secret, subscription.EventTypes, subscription.Description, subscription.Enabled).
+		Suffix(subscriptionReturning).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var created Subscription
+	if err = pgxscan.Get(ctx, r.dbPool, &created, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *repository) updateSubscription(ctx context.Context, subscription *Subscription) (*Subscription, error) {
+	query, args, err := squirrel.
+		Update(subscriptionTable).
+		Set("url", subscription.URL).
+		Set("event_types", subscription.EventTypes).
+		Set("description", subscription.Description).
+		Set("enabled", subscription.Enabled).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": subscription.ID}).
+		Suffix(subscriptionReturning).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var updated Subscription
+	if err = pgxscan.Get(ctx, r.dbPool, &updated, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (r *repository) deleteSubscription(ctx context.Context, id int64) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, "DELETE FROM webhook_subscription WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM webhook_delivery WHERE subscription_id = $1", id); err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *repository) createDeliveries(ctx context.Context, deliveries []Delivery) error {
+	builder := squirrel.
+		Insert(deliveryTable).
+		Columns("subscription_id", "event_id", "event_type", "payload").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, d := range deliveries {
+		builder = builder.Values(d.SubscriptionID, d.EventID, d.EventType, string(d.Payload))
+	}
+
+	query, args, err := builder.
+		Suffix("ON CONFLICT (subscription_id, event_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = r.dbPool.Exec(ctx, query, args...)
+	return err
+}
+
+// claimDueDeliveries leases pending deliveries that are due, so concurrent workers never send the same delivery twice.
+func (r *repository) claimDueDeliveries(ctx context.Context, limit uint64) ([]Delivery, error) {
+	due, dueArgs, err := squirrel.
+		Select("id").
+		From(deliveryTable).
+		Where(squirrel.Eq{"status": PendingDeliveryStatus}).
+		Where("next_attempt_at <= CURRENT_TIMESTAMP").
+		OrderBy("next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	query, args, err := squirrel.
+		Update(deliveryTable).
+		Set("next_attempt_at", time.Now().UTC().Add(claimLease)).
+		Where("id IN ("+due+")", dueArgs...).
+		Suffix("RETURNING " + strings.Join(deliveryColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var deliveries []Delivery
+	if err = pgxscan.Select(ctx, r.dbPool, &deliveries, query, args...); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *repository) saveAttempt(ctx context.Context, delivery *Delivery) error {
+	query, args, err := squirrel.
+		Update(deliveryTable).
+		Set("status", delivery.Status).
+		Set("attempts", delivery.Attempts).
+		Set("next_attempt_at", delivery.NextAttemptAt).
+		Set("last_status_code", delivery.LastStatusCode).
+		Set("last_error", delivery.LastError).
+		Set("delivered_at", delivery.DeliveredAt).
+		Where(squirrel.Eq{"id": delivery.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	_, err = r.dbPool.Exec(ctx, query, args...)
+	return err
+}
+
+// requeueDelivery gives a delivery a fresh set of attempts, typically after it was dead-lettered.
+func (r *repository) requeueDelivery(ctx context.Context, id int64) (*Delivery, error) {
+	query, args, err := squirrel.
+		Update(deliveryTable).
+		Set("status", PendingDeliveryStatus).
+		Set("attempts", 0).
+		Set("next_attempt_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": id}).
+		Suffix("RETURNING " + strings.Join(deliveryColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var delivery Delivery
+	if err = pgxscan.Get(ctx, r.dbPool, &delivery, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+func (r *repository) deliveryList(ctx context.Context, filter *DeliveryFilter) ([]Delivery, error) {
+	queryBuilder := squirrel.
+		Select(deliveryColumns...).
+		From(deliveryTable).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(filter.Limit).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.SubscriptionID != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"subscription_id": *filter.SubscriptionID})
+	}
+	if filter.Status != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"status": *filter.Status})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL: %w", err)
+	}
+
+	var deliveries []Delivery
+	if err = pgxscan.Select(ctx, r.dbPool, &deliveries, query, args...); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,279 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Everest13/fin-aggregator-service/internal/service/event"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/logger"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/psql"
+	"github.com/Everest13/fin-aggregator-service/internal/utils/random"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service struct {
+	repo       *repository
+	httpClient *http.Client
+}
+
+func NewService(dbPool *pgxpool.Pool, timeout time.Duration) *Service {
+	return &Service{
+		repo: newRepository(dbPool),
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (s *Service) CreateSubscription(ctx context.Context, subscription *Subscription) (*Subscription, error) {
+	subscription.URL = strings.TrimSpace(subscription.URL)
+	if err := validateSubscription(subscription); err != nil {
+		return nil, err
+	}
+
+	if subscription.Secret == "" {
+		secret, err := random.GenerateRandomString(secretLen)
+		if err != nil {
+			logger.Error("failed to generate webhook secret", err)
+			return nil, status.Errorf(codes.Internal, "failed to create webhook subscription")
+		}
+		subscription.Secret = secret
+	}
+
+	created, err := s.repo.createSubscription(ctx, subscription)
+	if err != nil {
+		logger.ErrorWithFields("failed to create webhook subscription", err, "url", subscription.URL)
+		return nil, psql.MapPostgresError("failed to create webhook subscription", err)
+	}
+
+	return created, nil
+}
+
+func (s *Service) UpdateSubscription(ctx context.Context, data *SubscriptionUpdateData) (*Subscription, error) {
+	subscription, err := s.repo.getSubscription(ctx, data.ID)
+	if err != nil {
+		logger.ErrorWithFields("failed to get webhook subscription", err, "subscription_id", data.ID)
+		return nil, psql.MapPostgresError("failed to get webhook subscription", err)
+	}
+
+	if data.URL != nil {
+		subscription.URL = strings.TrimSpace(*data.URL)
+	}
+	if len(data.EventTypes) > 0 {
+		subscription.EventTypes = data.EventTypes
+	}
+	if data.Description != nil {
+		subscription.Description = data.Description
+	}
+	if data.Enabled != nil {
+		subscription.Enabled = *data.Enabled
+	}
+
+	if err = validateSubscription(subscription); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.updateSubscription(ctx, subscription)
+	if err != nil {
+		logger.ErrorWithFields("failed to update webhook subscription", err, "subscription_id", data.ID)
+		return nil, psql.MapPostgresError("failed to update webhook subscription", err)
+	}
+
+	return updated, nil
+}
+
+func (s *Service) DeleteSubscription(ctx context.Context, id int64) error {
+	err := s.repo.deleteSubscription(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to delete webhook subscription", err, "subscription_id", id)
+		return psql.MapPostgresError("failed to delete webhook subscription", err)
+	}
+
+	return nil
+}
+
+func (s *Service) SubscriptionList(ctx context.Context) ([]Subscription, error) {
+	subscriptions, err := s.repo.subscriptionList(ctx)
+	if err != nil {
+		logger.Error("failed to get webhook subscriptions", err)
+		return nil, psql.MapPostgresError("failed to get webhook subscriptions", err)
+	}
+
+	return subscriptions, nil
+}
+
+func (s *Service) DeliveryList(ctx context.Context, filter *DeliveryFilter) ([]Delivery, error) {
+	if filter.Limit == 0 {
+		filter.Limit = defaultListLimit
+	}
+
+	deliveries, err := s.repo.deliveryList(ctx, filter)
+	if err != nil {
+		logger.Error("failed to get webhook deliveries", err)
+		return nil, psql.MapPostgresError("failed to get webhook deliveries", err)
+	}
+
+	return deliveries, nil
+}
+
+// Redeliver queues a delivery again with a fresh set of attempts, e.g. to replay a dead-lettered event.
+func (s *Service) Redeliver(ctx context.Context, id int64) (*Delivery, error) {
+	delivery, err := s.repo.requeueDelivery(ctx, id)
+	if err != nil {
+		logger.ErrorWithFields("failed to requeue webhook delivery", err, "delivery_id", id)
+		return nil, psql.MapPostgresError("failed to requeue webhook delivery", err)
+	}
+
+	return delivery, nil
+}
+
+// HandleEvent queues an internal event for every enabled subscription to its webhook event type.
+// Delivery happens in RunWorker, so handling never blocks on subscribers.
+func (s *Service) HandleEvent(ctx context.Context, e *event.Event) error {
+	eventType, data, ok, err := webhookEvent(e)
+	if err != nil || !ok {
+		return err
+	}
+
+	subscriptions, err := s.repo.subscriptionsForEvent(ctx, eventType)
+	if err != nil {
+		return fmt.Errorf("failed to get webhook subscriptions: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	// The outbox event id keeps the webhook event id stable if the event is handled again.
+	eventID := strconv.FormatInt(e.ID, 10)
+	payload, err := json.Marshal(Event{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: e.CreatedAt,
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook event: %w", err)
+	}
+
+	deliveries := make([]Delivery, len(subscriptions))
+	for i, subscription := range subscriptions {
+		deliveries[i] = Delivery{
+			SubscriptionID: subscription.ID,
+			EventID:        eventID,
+			EventType:      eventType,
+			Payload:        payload,
+		}
+	}
+
+	if err = s.repo.createDeliveries(ctx, deliveries); err != nil {
+		return fmt.Errorf("failed to queue webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// webhookEvent maps an internal event to the webhook event type and data sent to subscribers.
+// ok is false for events that are not exposed as webhooks.
+func webhookEvent(e *event.Event) (EventType, any, bool, error) {
+	switch e.Type {
+	case event.TransactionsCreatedType:
+		return TransactionCreatedEventType, e.Payload, true, nil
+	case event.TransactionUpdatedType:
+		return TransactionUpdatedEventType, e.Payload, true, nil
+	case event.ImportCompletedType:
+		return ImportCompletedEventType, e.Payload, true, nil
+	case event.ImportFailedType:
+		var failure event.ImportFailed
+		if err := json.Unmarshal(e.Payload, &failure); err != nil {
+			return "", nil, false, fmt.Errorf("failed to decode %s event %d: %w", e.Type, e.ID, err)
+		}
+		if failure.Source != event.MonzoImportSource {
+			return "", nil, false, nil
+		}
+
+		return MonzoSyncFailedEventType, MonzoSyncFailed{
+			BankID: failure.BankID,
+			UserID: failure.UserID,
+			Reason: failure.Reason,
+		}, true, nil
+	default:
+		return "", nil, false, nil
+	}
+}
+
+// RunWorker sends due deliveries until the context is cancelled.
+func (s *Service) RunWorker(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.deliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) deliverDue(ctx context.Context) {
+	deliveries, err := s.repo.claimDueDeliveries(ctx, deliveryBatch)
+	if err != nil {
+		logger.Error("failed to claim webhook deliveries", err)
+		return
+	}
+
+	subscriptions := map[int64]*Subscription{}
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = s.repo.getSubscription(ctx, delivery.SubscriptionID)
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				// The lease expires and the delivery is retried on a later poll.
+				logger.ErrorWithFields("failed to get webhook subscription", err, "subscription_id", delivery.SubscriptionID)
+				continue
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		s.attempt(ctx, subscription, delivery)
+		if delivery.Status == DeadDeliveryStatus {
+			logger.ErrorWithFields("webhook delivery dead-lettered", nil, "delivery_id", delivery.ID, "event_type", delivery.EventType)
+		}
+
+		if err = s.repo.saveAttempt(ctx, delivery); err != nil {
+			logger.ErrorWithFields("failed to save webhook delivery attempt", err, "delivery_id", delivery.ID)
+		}
+	}
+}
+
+func validateSubscription(subscription *Subscription) error {
+	target, err := url.Parse(subscription.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return status.Errorf(codes.InvalidArgument, "invalid webhook subscription: url %q", subscription.URL)
+	}
+
+	if len(subscription.EventTypes) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid webhook subscription: event types are required")
+	}
+	for _, eventType := range subscription.EventTypes {
+		if !knownEventTypes[EventType(eventType)] {
+			return status.Errorf(codes.InvalidArgument, "invalid webhook subscription: unknown event type %q", eventType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TryAdvisoryLock takes the session advisory lock named by key on a connection of the pool, so work it guards runs
+// on one instance of the service at a time. It returns false when another session holds the lock. Otherwise the
+// returned unlock must be called to release the lock and the connection.
+func TryAdvisoryLock(ctx context.Context, dbPool *pgxpool.Pool, key string) (func(), bool, error) {
+	conn, err := dbPool.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var locked bool
+	if err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", key).Scan(&locked); err != nil || !locked {
+		conn.Release()
+		return nil, false, err
+	}
+
+	unlock := func() {
+		// A session that failed to unlock still holds the lock, so its connection must not go back to the pool.
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", key); err != nil {
+			_ = conn.Conn().Close(context.Background())
+		}
+		conn.Release()
+	}
+
+	return unlock, true, nil
+}
@@ -0,0 +1,17 @@
+package retry
+
+import "time"
+
+// Backoff returns the delay before the next attempt after the given number of failed attempts. The delay starts at
+// base and doubles after every failed attempt up to max.
+func Backoff(attempts int32, base, max time.Duration) time.Duration {
+	delay := base
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+
+	return delay
+}
@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS shared_expense (
+    id SERIAL PRIMARY KEY,
+    transaction_id INT NOT NULL,
+    payer_user_id INT NOT NULL,
+    total_amount NUMERIC(12, 2) NOT NULL,
+    split_method VARCHAR(20) NOT NULL,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (transaction_id)
+);
+
+CREATE TABLE IF NOT EXISTS shared_expense_share (
+    shared_expense_id INT NOT NULL,
+    user_id INT NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL,
+    percentage NUMERIC(5, 2),
+    UNIQUE (shared_expense_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS settlement (
+    id SERIAL PRIMARY KEY,
+    from_user_id INT NOT NULL,
+    to_user_id INT NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL,
+    transaction_id INT,
+    note TEXT,
+    settled_at DATE NOT NULL,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS shared_expense;
+DROP TABLE IF EXISTS shared_expense_share;
+DROP TABLE IF EXISTS settlement;
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS account (
+    id SERIAL PRIMARY KEY,
+    bank_id INT NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    currency CHAR(3) NOT NULL DEFAULT 'GBP',
+    external_id VARCHAR(100),
+    display_name VARCHAR(100) NOT NULL,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp,
+    UNIQUE (bank_id, external_id)
+);
+
+CREATE TABLE IF NOT EXISTS account_owner (
+    account_id INT NOT NULL,
+    user_id INT NOT NULL,
+    UNIQUE (account_id, user_id)
+);
+
+ALTER TABLE transaction ADD COLUMN IF NOT EXISTS account_id INT;
+
+-- +goose Down
+ALTER TABLE transaction DROP COLUMN IF EXISTS account_id;
+DROP TABLE IF EXISTS account_owner;
+DROP TABLE IF EXISTS account;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS account_balance_snapshot (
+    id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    balance NUMERIC(14, 2) NOT NULL,
+    balance_date DATE NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    note TEXT,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (account_id, balance_date, source)
+);
+
+INSERT INTO bank_header (bank_id, name, required)
+SELECT id, 'Balance', false FROM bank WHERE name = 'Revolut'
+ON CONFLICT (bank_id, name) DO NOTHING;
+
+INSERT INTO bank_header_mapping (header_id, transaction_field)
+SELECT bh.id, 'BALANCE' FROM bank_header bh JOIN bank b ON bh.bank_id = b.id
+WHERE b.name = 'Revolut' AND bh.name = 'Balance'
+ON CONFLICT (header_id, transaction_field) DO NOTHING;
+
+-- +goose Down
+DELETE FROM bank_header_mapping WHERE transaction_field = 'BALANCE';
+DROP TABLE IF EXISTS account_balance_snapshot;
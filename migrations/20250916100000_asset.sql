@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS asset (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    asset_class VARCHAR(30) NOT NULL,
+    currency CHAR(3) NOT NULL DEFAULT 'GBP',
+    owner_user_id INT,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp
+);
+
+CREATE TABLE IF NOT EXISTS asset_valuation (
+    id SERIAL PRIMARY KEY,
+    asset_id INT NOT NULL,
+    value NUMERIC(14, 2) NOT NULL,
+    valuation_date DATE NOT NULL,
+    note TEXT,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (asset_id, valuation_date)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS asset_valuation;
+DROP TABLE IF EXISTS asset;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS savings_goal (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    target_amount NUMERIC(14, 2) NOT NULL,
+    target_date DATE NOT NULL,
+    start_date DATE NOT NULL DEFAULT CURRENT_DATE,
+    account_id INT,
+    category_id INT,
+    owner_user_id INT,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp,
+    CHECK (account_id IS NOT NULL OR category_id IS NOT NULL)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS savings_goal;
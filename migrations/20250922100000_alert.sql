@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS alert_rule (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    rule_type VARCHAR(30) NOT NULL,
+    user_id INT,
+    account_id INT,
+    category_id INT,
+    threshold NUMERIC(14, 2),
+    period VARCHAR(10),
+    channel VARCHAR(20) NOT NULL,
+    target TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp
+);
+
+CREATE TABLE IF NOT EXISTS alert (
+    id SERIAL PRIMARY KEY,
+    rule_id INT NOT NULL,
+    message TEXT NOT NULL,
+    dedup_key VARCHAR(255) NOT NULL,
+    transaction_id INT,
+    delivered BOOLEAN NOT NULL DEFAULT FALSE,
+    delivery_error TEXT,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (rule_id, dedup_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_created_at ON alert (created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS alert;
+DROP TABLE IF EXISTS alert_rule;
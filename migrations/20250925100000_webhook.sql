@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS webhook_subscription (
+    id SERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    event_types TEXT[] NOT NULL,
+    description TEXT,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp
+);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id SERIAL PRIMARY KEY,
+    subscription_id INT NOT NULL,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_status_code INT,
+    last_error TEXT,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    delivered_at timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_pending ON webhook_delivery (next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_subscription ON webhook_delivery (subscription_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook_subscription;
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS event_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_type ON event_outbox (type, id);
+CREATE INDEX IF NOT EXISTS idx_event_outbox_created_at ON event_outbox (created_at);
+
+CREATE TABLE IF NOT EXISTS event_subscriber (
+    name VARCHAR(100) PRIMARY KEY,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS event_consumption (
+    event_id BIGINT NOT NULL REFERENCES event_outbox(id) ON DELETE CASCADE,
+    subscriber VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_error TEXT,
+    updated_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (event_id, subscriber)
+);
+
+-- Events are now queued for webhooks by an outbox subscriber that may see the same event twice.
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_webhook_delivery_event ON webhook_delivery (subscription_id, event_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS uniq_webhook_delivery_event;
+DROP TABLE IF EXISTS event_consumption;
+DROP TABLE IF EXISTS event_subscriber;
+DROP TABLE IF EXISTS event_outbox;
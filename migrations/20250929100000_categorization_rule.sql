@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS categorization_rule (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    priority INT NOT NULL DEFAULT 100,
+    conditions JSONB NOT NULL,
+    actions JSONB NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp
+);
+
+ALTER TABLE transaction ADD COLUMN IF NOT EXISTS merchant VARCHAR(100);
+ALTER TABLE transaction ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE transaction DROP COLUMN IF EXISTS tags;
+ALTER TABLE transaction DROP COLUMN IF EXISTS merchant;
+DROP TABLE IF EXISTS categorization_rule;
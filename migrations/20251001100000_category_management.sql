@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE category ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE category DROP COLUMN IF EXISTS archived;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE category ADD COLUMN IF NOT EXISTS parent_id INT;
+
+CREATE INDEX IF NOT EXISTS idx_category_parent ON category(parent_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_category_parent;
+ALTER TABLE category DROP COLUMN IF EXISTS parent_id;
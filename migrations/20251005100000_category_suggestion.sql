@@ -0,0 +1,26 @@
+-- +goose Up
+ALTER TABLE categorization_rule ADD COLUMN IF NOT EXISTS hit_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE categorization_rule ADD COLUMN IF NOT EXISTS last_hit_at timestamp;
+
+CREATE TABLE IF NOT EXISTS category_suggestion (
+    id SERIAL PRIMARY KEY,
+    pattern VARCHAR(30) NOT NULL,
+    category_id INT NOT NULL,
+    transaction_id INT NOT NULL,
+    description TEXT NOT NULL,
+    occurrences INT NOT NULL DEFAULT 1,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    kind VARCHAR(20),
+    keyword_id INT,
+    rule_id INT,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_category_suggestion_pending
+    ON category_suggestion(pattern, category_id) WHERE status = 'PENDING';
+
+-- +goose Down
+DROP TABLE IF EXISTS category_suggestion;
+ALTER TABLE categorization_rule DROP COLUMN IF EXISTS last_hit_at;
+ALTER TABLE categorization_rule DROP COLUMN IF EXISTS hit_count;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS categorization_model (
+    id SERIAL PRIMARY KEY,
+    model JSONB NOT NULL,
+    sample_count INT NOT NULL,
+    category_count INT NOT NULL,
+    trained_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS categorization_model;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE transaction ADD COLUMN IF NOT EXISTS category_edited_at timestamp;
+
+-- +goose Down
+ALTER TABLE transaction DROP COLUMN IF EXISTS category_edited_at;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE category ADD COLUMN IF NOT EXISTS kind VARCHAR(10) NOT NULL DEFAULT 'EXPENSE';
+
+UPDATE category SET kind = 'TRANSFER' WHERE name = 'Transfer';
+
+-- +goose Down
+ALTER TABLE category DROP COLUMN IF EXISTS kind;
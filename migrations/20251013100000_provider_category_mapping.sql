@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS provider_category_mapping (
+    id SERIAL PRIMARY KEY,
+    bank_id INT NOT NULL REFERENCES bank(id),
+    external_category VARCHAR(50) NOT NULL,
+    category_id INT NOT NULL REFERENCES category(id),
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_provider_category_mapping ON provider_category_mapping(bank_id, lower(external_category));
+
+-- +goose Down
+DROP TABLE IF EXISTS provider_category_mapping;
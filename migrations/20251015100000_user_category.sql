@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE category ADD COLUMN IF NOT EXISTS user_id INT REFERENCES users(id);
+ALTER TABLE category_keyword ADD COLUMN IF NOT EXISTS user_id INT REFERENCES users(id);
+
+CREATE INDEX IF NOT EXISTS idx_category_user ON category(user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_category_user;
+ALTER TABLE category_keyword DROP COLUMN IF EXISTS user_id;
+ALTER TABLE category DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE transaction ADD COLUMN IF NOT EXISTS category_source VARCHAR(20);
+ALTER TABLE transaction ADD COLUMN IF NOT EXISTS category_rule_id INT;
+ALTER TABLE transaction ADD COLUMN IF NOT EXISTS category_match VARCHAR(100);
+ALTER TABLE transaction ADD COLUMN IF NOT EXISTS category_confidence DOUBLE PRECISION;
+
+-- +goose Down
+ALTER TABLE transaction DROP COLUMN IF EXISTS category_confidence;
+ALTER TABLE transaction DROP COLUMN IF EXISTS category_match;
+ALTER TABLE transaction DROP COLUMN IF EXISTS category_rule_id;
+ALTER TABLE transaction DROP COLUMN IF EXISTS category_source;
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_cache_invalidation() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('cache_invalidation', TG_TABLE_NAME);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER bank_cache_invalidation
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON bank
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_cache_invalidation();
+
+CREATE TRIGGER category_cache_invalidation
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON category
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_cache_invalidation();
+
+CREATE TRIGGER category_keyword_cache_invalidation
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON category_keyword
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_cache_invalidation();
+
+CREATE TRIGGER provider_category_mapping_cache_invalidation
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON provider_category_mapping
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_cache_invalidation();
+
+CREATE TRIGGER bank_header_cache_invalidation
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON bank_header
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_cache_invalidation();
+
+CREATE TRIGGER bank_header_mapping_cache_invalidation
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON bank_header_mapping
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_cache_invalidation();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS bank_header_mapping_cache_invalidation ON bank_header_mapping;
+DROP TRIGGER IF EXISTS bank_header_cache_invalidation ON bank_header;
+DROP TRIGGER IF EXISTS provider_category_mapping_cache_invalidation ON provider_category_mapping;
+DROP TRIGGER IF EXISTS category_keyword_cache_invalidation ON category_keyword;
+DROP TRIGGER IF EXISTS category_cache_invalidation ON category;
+DROP TRIGGER IF EXISTS bank_cache_invalidation ON bank;
+DROP FUNCTION IF EXISTS notify_cache_invalidation();
@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS bank_parsing_profile (
+    bank_id INT PRIMARY KEY REFERENCES bank(id),
+    date_layouts TEXT[] NOT NULL,
+    timezone VARCHAR(50) NOT NULL DEFAULT 'UTC',
+    decimal_separator VARCHAR(1) NOT NULL DEFAULT '.',
+    thousands_separator VARCHAR(1) NOT NULL DEFAULT '',
+    sign_convention VARCHAR(20) NOT NULL DEFAULT 'SIGNED',
+    amount_mode VARCHAR(20) NOT NULL DEFAULT 'SINGLE',
+    delimiter VARCHAR(1) NOT NULL DEFAULT ',',
+    encoding VARCHAR(20) NOT NULL DEFAULT 'UTF-8',
+    header_row INT NOT NULL DEFAULT 0,
+    skip_rows INT NOT NULL DEFAULT 0,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp
+);
+
+-- Amex and Revolut had their own parsers; their sign conventions become profiles. Amex moves to CATEGORY_KIND
+-- in 20251104100000, which is what its parser did.
+INSERT INTO bank_parsing_profile (bank_id, date_layouts, sign_convention)
+SELECT id, ARRAY['2006-01-02 15:04:05', '02/01/2006', '2006-01-02', '02.01.2006', '2006/01/02'], 'POSITIVE_OUTCOME'
+FROM bank WHERE name = 'American express'
+ON CONFLICT (bank_id) DO NOTHING;
+
+INSERT INTO bank_parsing_profile (bank_id, date_layouts, sign_convention)
+SELECT id, ARRAY['2006-01-02 15:04:05', '02/01/2006', '2006-01-02', '02.01.2006', '2006/01/02'], 'NEGATIVE_OUTCOME'
+FROM bank WHERE name = 'Revolut'
+ON CONFLICT (bank_id) DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS bank_parsing_profile;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- An empty delimiter or encoding and a NULL header row are detected from each uploaded file.
+ALTER TABLE bank_parsing_profile ALTER COLUMN delimiter SET DEFAULT '';
+ALTER TABLE bank_parsing_profile ALTER COLUMN encoding SET DEFAULT '';
+ALTER TABLE bank_parsing_profile ALTER COLUMN header_row DROP NOT NULL;
+ALTER TABLE bank_parsing_profile ALTER COLUMN header_row DROP DEFAULT;
+
+-- The defaults stored so far were never chosen for the bank, so profiles still holding all of them detect instead.
+UPDATE bank_parsing_profile
+SET delimiter = '', encoding = '', header_row = NULL
+WHERE delimiter = ',' AND encoding = 'UTF-8' AND header_row = 0;
+
+-- +goose Down
+UPDATE bank_parsing_profile SET delimiter = ',' WHERE delimiter = '';
+UPDATE bank_parsing_profile SET encoding = 'UTF-8' WHERE encoding = '' OR encoding = 'UTF-16BE';
+UPDATE bank_parsing_profile SET header_row = 0 WHERE header_row IS NULL;
+
+ALTER TABLE bank_parsing_profile ALTER COLUMN header_row SET DEFAULT 0;
+ALTER TABLE bank_parsing_profile ALTER COLUMN header_row SET NOT NULL;
+ALTER TABLE bank_parsing_profile ALTER COLUMN encoding SET DEFAULT 'UTF-8';
+ALTER TABLE bank_parsing_profile ALTER COLUMN delimiter SET DEFAULT ',';
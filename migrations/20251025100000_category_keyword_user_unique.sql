@@ -0,0 +1,11 @@
+-- +goose Up
+-- Users add their own keywords to global categories, so a name is unique per category and owner.
+ALTER TABLE category_keyword DROP CONSTRAINT IF EXISTS category_keyword_category_id_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_category_keyword ON category_keyword (category_id, name, COALESCE(user_id, 0));
+
+-- +goose Down
+DROP INDEX IF EXISTS uniq_category_keyword;
+DELETE FROM category_keyword k
+USING category_keyword other
+WHERE k.category_id = other.category_id AND k.name = other.name AND k.id > other.id;
+ALTER TABLE category_keyword ADD CONSTRAINT category_keyword_category_id_name_key UNIQUE (category_id, name);
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Hit counts are flushed to categorization_rule all the time and do not change what the rules match.
+CREATE TRIGGER categorization_rule_cache_invalidation
+    AFTER INSERT OR UPDATE OF name, priority, conditions, actions, enabled OR DELETE OR TRUNCATE ON categorization_rule
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_cache_invalidation();
+
+CREATE TRIGGER categorization_model_cache_invalidation
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON categorization_model
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_cache_invalidation();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS categorization_model_cache_invalidation ON categorization_model;
+DROP TRIGGER IF EXISTS categorization_rule_cache_invalidation ON categorization_rule;
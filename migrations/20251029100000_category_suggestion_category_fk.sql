@@ -0,0 +1,9 @@
+-- +goose Up
+-- Suggestions of categories deleted so far can no longer be accepted.
+DELETE FROM category_suggestion WHERE category_id NOT IN (SELECT id FROM category);
+
+ALTER TABLE category_suggestion
+    ADD CONSTRAINT fk_category_suggestion_category FOREIGN KEY (category_id) REFERENCES category(id);
+
+-- +goose Down
+ALTER TABLE category_suggestion DROP CONSTRAINT IF EXISTS fk_category_suggestion_category;
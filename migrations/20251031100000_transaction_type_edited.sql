@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE transaction ADD COLUMN IF NOT EXISTS type_edited_at timestamp;
+
+-- +goose Down
+ALTER TABLE transaction DROP COLUMN IF EXISTS type_edited_at;
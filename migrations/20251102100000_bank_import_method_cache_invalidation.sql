@@ -0,0 +1,8 @@
+-- +goose Up
+-- Banks are cached together with their import methods.
+CREATE TRIGGER bank_import_method_cache_invalidation
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON bank_import_method
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_cache_invalidation();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS bank_import_method_cache_invalidation ON bank_import_method;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- The Amex parser left the type unset so the category kind decided it, POSITIVE_OUTCOME also typed uncategorised
+-- and transfer rows by their sign.
+UPDATE bank_parsing_profile
+SET sign_convention = 'CATEGORY_KIND', updated_at = CURRENT_TIMESTAMP
+WHERE sign_convention = 'POSITIVE_OUTCOME'
+  AND bank_id = (SELECT id FROM bank WHERE name = 'American express');
+
+-- +goose Down
+UPDATE bank_parsing_profile
+SET sign_convention = 'POSITIVE_OUTCOME', updated_at = CURRENT_TIMESTAMP
+WHERE sign_convention = 'CATEGORY_KIND'
+  AND bank_id = (SELECT id FROM bank WHERE name = 'American express');
@@ -8,6 +8,7 @@ package fin_aggregator_service
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/known/emptypb"
@@ -73,6 +74,64 @@ func (TransactionType) EnumDescriptor() ([]byte, []int) {
 	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{0}
 }
 
+type CategorySource int32
+
+const (
+	CategorySource_CATEGORY_SOURCE_UNSPECIFIED      CategorySource = 0
+	CategorySource_CATEGORY_SOURCE_RULE             CategorySource = 1
+	CategorySource_CATEGORY_SOURCE_PROVIDER_MAPPING CategorySource = 2
+	CategorySource_CATEGORY_SOURCE_KEYWORD          CategorySource = 3
+	CategorySource_CATEGORY_SOURCE_CLASSIFIER       CategorySource = 4
+	CategorySource_CATEGORY_SOURCE_MANUAL           CategorySource = 5
+)
+
+// Enum value maps for CategorySource.
+var (
+	CategorySource_name = map[int32]string{
+		0: "CATEGORY_SOURCE_UNSPECIFIED",
+		1: "CATEGORY_SOURCE_RULE",
+		2: "CATEGORY_SOURCE_PROVIDER_MAPPING",
+		3: "CATEGORY_SOURCE_KEYWORD",
+		4: "CATEGORY_SOURCE_CLASSIFIER",
+		5: "CATEGORY_SOURCE_MANUAL",
+	}
+	CategorySource_value = map[string]int32{
+		"CATEGORY_SOURCE_UNSPECIFIED":      0,
+		"CATEGORY_SOURCE_RULE":             1,
+		"CATEGORY_SOURCE_PROVIDER_MAPPING": 2,
+		"CATEGORY_SOURCE_KEYWORD":          3,
+		"CATEGORY_SOURCE_CLASSIFIER":       4,
+		"CATEGORY_SOURCE_MANUAL":           5,
+	}
+)
+
+func (x CategorySource) Enum() *CategorySource {
+	p := new(CategorySource)
+	*p = x
+	return p
+}
+
+func (x CategorySource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CategorySource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[1].Descriptor()
+}
+
+func (CategorySource) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[1]
+}
+
+func (x CategorySource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CategorySource.Descriptor instead.
+func (CategorySource) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{1}
+}
+
 type BankImportMethod int32
 
 const (
@@ -106,56 +165,12058 @@ func (x BankImportMethod) String() string {
 }
 
 func (BankImportMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[1].Descriptor()
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[2].Descriptor()
 }
 
 func (BankImportMethod) Type() protoreflect.EnumType {
-	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[1]
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[2]
 }
 
 func (x BankImportMethod) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use BankImportMethod.Descriptor instead.
-func (BankImportMethod) EnumDescriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use BankImportMethod.Descriptor instead.
+func (BankImportMethod) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{2}
+}
+
+type CategoryKind int32
+
+const (
+	CategoryKind_CATEGORY_KIND_UNSPECIFIED CategoryKind = 0
+	CategoryKind_CATEGORY_KIND_EXPENSE     CategoryKind = 1
+	CategoryKind_CATEGORY_KIND_INCOME      CategoryKind = 2
+	CategoryKind_CATEGORY_KIND_TRANSFER    CategoryKind = 3
+	CategoryKind_CATEGORY_KIND_EXCLUDED    CategoryKind = 4
+	CategoryKind_CATEGORY_KIND_SAVINGS     CategoryKind = 5
+)
+
+// Enum value maps for CategoryKind.
+var (
+	CategoryKind_name = map[int32]string{
+		0: "CATEGORY_KIND_UNSPECIFIED",
+		1: "CATEGORY_KIND_EXPENSE",
+		2: "CATEGORY_KIND_INCOME",
+		3: "CATEGORY_KIND_TRANSFER",
+		4: "CATEGORY_KIND_EXCLUDED",
+		5: "CATEGORY_KIND_SAVINGS",
+	}
+	CategoryKind_value = map[string]int32{
+		"CATEGORY_KIND_UNSPECIFIED": 0,
+		"CATEGORY_KIND_EXPENSE":     1,
+		"CATEGORY_KIND_INCOME":      2,
+		"CATEGORY_KIND_TRANSFER":    3,
+		"CATEGORY_KIND_EXCLUDED":    4,
+		"CATEGORY_KIND_SAVINGS":     5,
+	}
+)
+
+func (x CategoryKind) Enum() *CategoryKind {
+	p := new(CategoryKind)
+	*p = x
+	return p
+}
+
+func (x CategoryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CategoryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[3].Descriptor()
+}
+
+func (CategoryKind) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[3]
+}
+
+func (x CategoryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CategoryKind.Descriptor instead.
+func (CategoryKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{3}
+}
+
+type InsightBaselineSource int32
+
+const (
+	InsightBaselineSource_BASELINE_UNSPECIFIED InsightBaselineSource = 0
+	InsightBaselineSource_BASELINE_MERCHANT    InsightBaselineSource = 1
+	InsightBaselineSource_BASELINE_CATEGORY    InsightBaselineSource = 2
+)
+
+// Enum value maps for InsightBaselineSource.
+var (
+	InsightBaselineSource_name = map[int32]string{
+		0: "BASELINE_UNSPECIFIED",
+		1: "BASELINE_MERCHANT",
+		2: "BASELINE_CATEGORY",
+	}
+	InsightBaselineSource_value = map[string]int32{
+		"BASELINE_UNSPECIFIED": 0,
+		"BASELINE_MERCHANT":    1,
+		"BASELINE_CATEGORY":    2,
+	}
+)
+
+func (x InsightBaselineSource) Enum() *InsightBaselineSource {
+	p := new(InsightBaselineSource)
+	*p = x
+	return p
+}
+
+func (x InsightBaselineSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InsightBaselineSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[4].Descriptor()
+}
+
+func (InsightBaselineSource) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[4]
+}
+
+func (x InsightBaselineSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InsightBaselineSource.Descriptor instead.
+func (InsightBaselineSource) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{4}
+}
+
+type SplitMethod int32
+
+const (
+	SplitMethod_SPLIT_METHOD_UNSPECIFIED SplitMethod = 0
+	SplitMethod_EQUAL                    SplitMethod = 1
+	SplitMethod_PERCENTAGE               SplitMethod = 2
+	SplitMethod_EXACT                    SplitMethod = 3
+)
+
+// Enum value maps for SplitMethod.
+var (
+	SplitMethod_name = map[int32]string{
+		0: "SPLIT_METHOD_UNSPECIFIED",
+		1: "EQUAL",
+		2: "PERCENTAGE",
+		3: "EXACT",
+	}
+	SplitMethod_value = map[string]int32{
+		"SPLIT_METHOD_UNSPECIFIED": 0,
+		"EQUAL":                    1,
+		"PERCENTAGE":               2,
+		"EXACT":                    3,
+	}
+)
+
+func (x SplitMethod) Enum() *SplitMethod {
+	p := new(SplitMethod)
+	*p = x
+	return p
+}
+
+func (x SplitMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[5].Descriptor()
+}
+
+func (SplitMethod) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[5]
+}
+
+func (x SplitMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitMethod.Descriptor instead.
+func (SplitMethod) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{5}
+}
+
+type AccountType int32
+
+const (
+	AccountType_ACCOUNT_TYPE_UNSPECIFIED AccountType = 0
+	AccountType_CURRENT                  AccountType = 1
+	AccountType_SAVINGS                  AccountType = 2
+	AccountType_CREDIT_CARD              AccountType = 3
+	AccountType_LOAN                     AccountType = 4
+	AccountType_OTHER                    AccountType = 5
+)
+
+// Enum value maps for AccountType.
+var (
+	AccountType_name = map[int32]string{
+		0: "ACCOUNT_TYPE_UNSPECIFIED",
+		1: "CURRENT",
+		2: "SAVINGS",
+		3: "CREDIT_CARD",
+		4: "LOAN",
+		5: "OTHER",
+	}
+	AccountType_value = map[string]int32{
+		"ACCOUNT_TYPE_UNSPECIFIED": 0,
+		"CURRENT":                  1,
+		"SAVINGS":                  2,
+		"CREDIT_CARD":              3,
+		"LOAN":                     4,
+		"OTHER":                    5,
+	}
+)
+
+func (x AccountType) Enum() *AccountType {
+	p := new(AccountType)
+	*p = x
+	return p
+}
+
+func (x AccountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[6].Descriptor()
+}
+
+func (AccountType) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[6]
+}
+
+func (x AccountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountType.Descriptor instead.
+func (AccountType) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{6}
+}
+
+type BalanceSnapshotSource int32
+
+const (
+	BalanceSnapshotSource_BALANCE_SOURCE_UNSPECIFIED BalanceSnapshotSource = 0
+	BalanceSnapshotSource_BALANCE_SOURCE_CSV         BalanceSnapshotSource = 1
+	BalanceSnapshotSource_BALANCE_SOURCE_API         BalanceSnapshotSource = 2
+	BalanceSnapshotSource_BALANCE_SOURCE_MANUAL      BalanceSnapshotSource = 3
+)
+
+// Enum value maps for BalanceSnapshotSource.
+var (
+	BalanceSnapshotSource_name = map[int32]string{
+		0: "BALANCE_SOURCE_UNSPECIFIED",
+		1: "BALANCE_SOURCE_CSV",
+		2: "BALANCE_SOURCE_API",
+		3: "BALANCE_SOURCE_MANUAL",
+	}
+	BalanceSnapshotSource_value = map[string]int32{
+		"BALANCE_SOURCE_UNSPECIFIED": 0,
+		"BALANCE_SOURCE_CSV":         1,
+		"BALANCE_SOURCE_API":         2,
+		"BALANCE_SOURCE_MANUAL":      3,
+	}
+)
+
+func (x BalanceSnapshotSource) Enum() *BalanceSnapshotSource {
+	p := new(BalanceSnapshotSource)
+	*p = x
+	return p
+}
+
+func (x BalanceSnapshotSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BalanceSnapshotSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[7].Descriptor()
+}
+
+func (BalanceSnapshotSource) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[7]
+}
+
+func (x BalanceSnapshotSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BalanceSnapshotSource.Descriptor instead.
+func (BalanceSnapshotSource) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ReconciliationStatus int32
+
+const (
+	ReconciliationStatus_RECONCILIATION_STATUS_UNSPECIFIED ReconciliationStatus = 0
+	ReconciliationStatus_RECONCILIATION_OK                 ReconciliationStatus = 1
+	ReconciliationStatus_RECONCILIATION_MISMATCH           ReconciliationStatus = 2
+	ReconciliationStatus_RECONCILIATION_GAP                ReconciliationStatus = 3
+)
+
+// Enum value maps for ReconciliationStatus.
+var (
+	ReconciliationStatus_name = map[int32]string{
+		0: "RECONCILIATION_STATUS_UNSPECIFIED",
+		1: "RECONCILIATION_OK",
+		2: "RECONCILIATION_MISMATCH",
+		3: "RECONCILIATION_GAP",
+	}
+	ReconciliationStatus_value = map[string]int32{
+		"RECONCILIATION_STATUS_UNSPECIFIED": 0,
+		"RECONCILIATION_OK":                 1,
+		"RECONCILIATION_MISMATCH":           2,
+		"RECONCILIATION_GAP":                3,
+	}
+)
+
+func (x ReconciliationStatus) Enum() *ReconciliationStatus {
+	p := new(ReconciliationStatus)
+	*p = x
+	return p
+}
+
+func (x ReconciliationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[8].Descriptor()
+}
+
+func (ReconciliationStatus) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[8]
+}
+
+func (x ReconciliationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationStatus.Descriptor instead.
+func (ReconciliationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{8}
+}
+
+type AssetKind int32
+
+const (
+	AssetKind_ASSET_KIND_UNSPECIFIED AssetKind = 0
+	AssetKind_ASSET                  AssetKind = 1
+	AssetKind_LIABILITY              AssetKind = 2
+)
+
+// Enum value maps for AssetKind.
+var (
+	AssetKind_name = map[int32]string{
+		0: "ASSET_KIND_UNSPECIFIED",
+		1: "ASSET",
+		2: "LIABILITY",
+	}
+	AssetKind_value = map[string]int32{
+		"ASSET_KIND_UNSPECIFIED": 0,
+		"ASSET":                  1,
+		"LIABILITY":              2,
+	}
+)
+
+func (x AssetKind) Enum() *AssetKind {
+	p := new(AssetKind)
+	*p = x
+	return p
+}
+
+func (x AssetKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[9].Descriptor()
+}
+
+func (AssetKind) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[9]
+}
+
+func (x AssetKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetKind.Descriptor instead.
+func (AssetKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{9}
+}
+
+type AssetClass int32
+
+const (
+	AssetClass_ASSET_CLASS_UNSPECIFIED AssetClass = 0
+	AssetClass_ASSET_CLASS_CASH        AssetClass = 1
+	AssetClass_ASSET_CLASS_SAVINGS     AssetClass = 2
+	AssetClass_ASSET_CLASS_INVESTMENT  AssetClass = 3
+	AssetClass_ASSET_CLASS_PENSION     AssetClass = 4
+	AssetClass_ASSET_CLASS_PROPERTY    AssetClass = 5
+	AssetClass_ASSET_CLASS_VEHICLE     AssetClass = 6
+	AssetClass_ASSET_CLASS_MORTGAGE    AssetClass = 7
+	AssetClass_ASSET_CLASS_LOAN        AssetClass = 8
+	AssetClass_ASSET_CLASS_CREDIT_CARD AssetClass = 9
+	AssetClass_ASSET_CLASS_OTHER       AssetClass = 10
+)
+
+// Enum value maps for AssetClass.
+var (
+	AssetClass_name = map[int32]string{
+		0:  "ASSET_CLASS_UNSPECIFIED",
+		1:  "ASSET_CLASS_CASH",
+		2:  "ASSET_CLASS_SAVINGS",
+		3:  "ASSET_CLASS_INVESTMENT",
+		4:  "ASSET_CLASS_PENSION",
+		5:  "ASSET_CLASS_PROPERTY",
+		6:  "ASSET_CLASS_VEHICLE",
+		7:  "ASSET_CLASS_MORTGAGE",
+		8:  "ASSET_CLASS_LOAN",
+		9:  "ASSET_CLASS_CREDIT_CARD",
+		10: "ASSET_CLASS_OTHER",
+	}
+	AssetClass_value = map[string]int32{
+		"ASSET_CLASS_UNSPECIFIED": 0,
+		"ASSET_CLASS_CASH":        1,
+		"ASSET_CLASS_SAVINGS":     2,
+		"ASSET_CLASS_INVESTMENT":  3,
+		"ASSET_CLASS_PENSION":     4,
+		"ASSET_CLASS_PROPERTY":    5,
+		"ASSET_CLASS_VEHICLE":     6,
+		"ASSET_CLASS_MORTGAGE":    7,
+		"ASSET_CLASS_LOAN":        8,
+		"ASSET_CLASS_CREDIT_CARD": 9,
+		"ASSET_CLASS_OTHER":       10,
+	}
+)
+
+func (x AssetClass) Enum() *AssetClass {
+	p := new(AssetClass)
+	*p = x
+	return p
+}
+
+func (x AssetClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[10].Descriptor()
+}
+
+func (AssetClass) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[10]
+}
+
+func (x AssetClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetClass.Descriptor instead.
+func (AssetClass) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{10}
+}
+
+type SavingsGoalStatus int32
+
+const (
+	SavingsGoalStatus_SAVINGS_GOAL_STATUS_UNSPECIFIED SavingsGoalStatus = 0
+	SavingsGoalStatus_SAVINGS_GOAL_STATUS_ACHIEVED    SavingsGoalStatus = 1
+	SavingsGoalStatus_SAVINGS_GOAL_STATUS_ON_TRACK    SavingsGoalStatus = 2
+	SavingsGoalStatus_SAVINGS_GOAL_STATUS_BEHIND      SavingsGoalStatus = 3
+	SavingsGoalStatus_SAVINGS_GOAL_STATUS_OVERDUE     SavingsGoalStatus = 4
+)
+
+// Enum value maps for SavingsGoalStatus.
+var (
+	SavingsGoalStatus_name = map[int32]string{
+		0: "SAVINGS_GOAL_STATUS_UNSPECIFIED",
+		1: "SAVINGS_GOAL_STATUS_ACHIEVED",
+		2: "SAVINGS_GOAL_STATUS_ON_TRACK",
+		3: "SAVINGS_GOAL_STATUS_BEHIND",
+		4: "SAVINGS_GOAL_STATUS_OVERDUE",
+	}
+	SavingsGoalStatus_value = map[string]int32{
+		"SAVINGS_GOAL_STATUS_UNSPECIFIED": 0,
+		"SAVINGS_GOAL_STATUS_ACHIEVED":    1,
+		"SAVINGS_GOAL_STATUS_ON_TRACK":    2,
+		"SAVINGS_GOAL_STATUS_BEHIND":      3,
+		"SAVINGS_GOAL_STATUS_OVERDUE":     4,
+	}
+)
+
+func (x SavingsGoalStatus) Enum() *SavingsGoalStatus {
+	p := new(SavingsGoalStatus)
+	*p = x
+	return p
+}
+
+func (x SavingsGoalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SavingsGoalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[11].Descriptor()
+}
+
+func (SavingsGoalStatus) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[11]
+}
+
+func (x SavingsGoalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SavingsGoalStatus.Descriptor instead.
+func (SavingsGoalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{11}
+}
+
+type ReportPeriod int32
+
+const (
+	ReportPeriod_REPORT_PERIOD_UNSPECIFIED ReportPeriod = 0
+	ReportPeriod_REPORT_PERIOD_MONTHLY     ReportPeriod = 1
+	ReportPeriod_REPORT_PERIOD_ANNUAL      ReportPeriod = 2
+)
+
+// Enum value maps for ReportPeriod.
+var (
+	ReportPeriod_name = map[int32]string{
+		0: "REPORT_PERIOD_UNSPECIFIED",
+		1: "REPORT_PERIOD_MONTHLY",
+		2: "REPORT_PERIOD_ANNUAL",
+	}
+	ReportPeriod_value = map[string]int32{
+		"REPORT_PERIOD_UNSPECIFIED": 0,
+		"REPORT_PERIOD_MONTHLY":     1,
+		"REPORT_PERIOD_ANNUAL":      2,
+	}
+)
+
+func (x ReportPeriod) Enum() *ReportPeriod {
+	p := new(ReportPeriod)
+	*p = x
+	return p
+}
+
+func (x ReportPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[12].Descriptor()
+}
+
+func (ReportPeriod) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[12]
+}
+
+func (x ReportPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportPeriod.Descriptor instead.
+func (ReportPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{12}
+}
+
+type ReportFormat int32
+
+const (
+	ReportFormat_REPORT_FORMAT_UNSPECIFIED ReportFormat = 0
+	ReportFormat_REPORT_FORMAT_HTML        ReportFormat = 1
+	ReportFormat_REPORT_FORMAT_PDF         ReportFormat = 2
+)
+
+// Enum value maps for ReportFormat.
+var (
+	ReportFormat_name = map[int32]string{
+		0: "REPORT_FORMAT_UNSPECIFIED",
+		1: "REPORT_FORMAT_HTML",
+		2: "REPORT_FORMAT_PDF",
+	}
+	ReportFormat_value = map[string]int32{
+		"REPORT_FORMAT_UNSPECIFIED": 0,
+		"REPORT_FORMAT_HTML":        1,
+		"REPORT_FORMAT_PDF":         2,
+	}
+)
+
+func (x ReportFormat) Enum() *ReportFormat {
+	p := new(ReportFormat)
+	*p = x
+	return p
+}
+
+func (x ReportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[13].Descriptor()
+}
+
+func (ReportFormat) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[13]
+}
+
+func (x ReportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportFormat.Descriptor instead.
+func (ReportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{13}
+}
+
+type AlertRuleType int32
+
+const (
+	AlertRuleType_ALERT_RULE_TYPE_UNSPECIFIED       AlertRuleType = 0
+	AlertRuleType_ALERT_RULE_TYPE_LARGE_TRANSACTION AlertRuleType = 1
+	AlertRuleType_ALERT_RULE_TYPE_CATEGORY_SPEND    AlertRuleType = 2
+	AlertRuleType_ALERT_RULE_TYPE_NEW_MERCHANT      AlertRuleType = 3
+	AlertRuleType_ALERT_RULE_TYPE_LOW_BALANCE       AlertRuleType = 4
+	AlertRuleType_ALERT_RULE_TYPE_IMPORT_FAILED     AlertRuleType = 5
+)
+
+// Enum value maps for AlertRuleType.
+var (
+	AlertRuleType_name = map[int32]string{
+		0: "ALERT_RULE_TYPE_UNSPECIFIED",
+		1: "ALERT_RULE_TYPE_LARGE_TRANSACTION",
+		2: "ALERT_RULE_TYPE_CATEGORY_SPEND",
+		3: "ALERT_RULE_TYPE_NEW_MERCHANT",
+		4: "ALERT_RULE_TYPE_LOW_BALANCE",
+		5: "ALERT_RULE_TYPE_IMPORT_FAILED",
+	}
+	AlertRuleType_value = map[string]int32{
+		"ALERT_RULE_TYPE_UNSPECIFIED":       0,
+		"ALERT_RULE_TYPE_LARGE_TRANSACTION": 1,
+		"ALERT_RULE_TYPE_CATEGORY_SPEND":    2,
+		"ALERT_RULE_TYPE_NEW_MERCHANT":      3,
+		"ALERT_RULE_TYPE_LOW_BALANCE":       4,
+		"ALERT_RULE_TYPE_IMPORT_FAILED":     5,
+	}
+)
+
+func (x AlertRuleType) Enum() *AlertRuleType {
+	p := new(AlertRuleType)
+	*p = x
+	return p
+}
+
+func (x AlertRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[14].Descriptor()
+}
+
+func (AlertRuleType) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[14]
+}
+
+func (x AlertRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertRuleType.Descriptor instead.
+func (AlertRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{14}
+}
+
+type AlertPeriod int32
+
+const (
+	AlertPeriod_ALERT_PERIOD_UNSPECIFIED AlertPeriod = 0
+	AlertPeriod_ALERT_PERIOD_WEEK        AlertPeriod = 1
+	AlertPeriod_ALERT_PERIOD_MONTH       AlertPeriod = 2
+)
+
+// Enum value maps for AlertPeriod.
+var (
+	AlertPeriod_name = map[int32]string{
+		0: "ALERT_PERIOD_UNSPECIFIED",
+		1: "ALERT_PERIOD_WEEK",
+		2: "ALERT_PERIOD_MONTH",
+	}
+	AlertPeriod_value = map[string]int32{
+		"ALERT_PERIOD_UNSPECIFIED": 0,
+		"ALERT_PERIOD_WEEK":        1,
+		"ALERT_PERIOD_MONTH":       2,
+	}
+)
+
+func (x AlertPeriod) Enum() *AlertPeriod {
+	p := new(AlertPeriod)
+	*p = x
+	return p
+}
+
+func (x AlertPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[15].Descriptor()
+}
+
+func (AlertPeriod) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[15]
+}
+
+func (x AlertPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertPeriod.Descriptor instead.
+func (AlertPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{15}
+}
+
+type AlertChannel int32
+
+const (
+	AlertChannel_ALERT_CHANNEL_UNSPECIFIED AlertChannel = 0
+	AlertChannel_ALERT_CHANNEL_EMAIL       AlertChannel = 1
+	AlertChannel_ALERT_CHANNEL_WEBHOOK     AlertChannel = 2
+)
+
+// Enum value maps for AlertChannel.
+var (
+	AlertChannel_name = map[int32]string{
+		0: "ALERT_CHANNEL_UNSPECIFIED",
+		1: "ALERT_CHANNEL_EMAIL",
+		2: "ALERT_CHANNEL_WEBHOOK",
+	}
+	AlertChannel_value = map[string]int32{
+		"ALERT_CHANNEL_UNSPECIFIED": 0,
+		"ALERT_CHANNEL_EMAIL":       1,
+		"ALERT_CHANNEL_WEBHOOK":     2,
+	}
+)
+
+func (x AlertChannel) Enum() *AlertChannel {
+	p := new(AlertChannel)
+	*p = x
+	return p
+}
+
+func (x AlertChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[16].Descriptor()
+}
+
+func (AlertChannel) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[16]
+}
+
+func (x AlertChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertChannel.Descriptor instead.
+func (AlertChannel) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{16}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED   WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD        WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":        3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[17].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[17]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{17}
+}
+
+type CategorizationConditionType int32
+
+const (
+	CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_UNSPECIFIED  CategorizationConditionType = 0
+	CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_CONTAINS     CategorizationConditionType = 1
+	CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_REGEX        CategorizationConditionType = 2
+	CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_EXACT        CategorizationConditionType = 3
+	CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_AMOUNT_RANGE CategorizationConditionType = 4
+	CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_BANK         CategorizationConditionType = 5
+	CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_USER         CategorizationConditionType = 6
+	CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_SIGN         CategorizationConditionType = 7
+	CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_DAY_OF_MONTH CategorizationConditionType = 8
+)
+
+// Enum value maps for CategorizationConditionType.
+var (
+	CategorizationConditionType_name = map[int32]string{
+		0: "CATEGORIZATION_CONDITION_TYPE_UNSPECIFIED",
+		1: "CATEGORIZATION_CONDITION_TYPE_CONTAINS",
+		2: "CATEGORIZATION_CONDITION_TYPE_REGEX",
+		3: "CATEGORIZATION_CONDITION_TYPE_EXACT",
+		4: "CATEGORIZATION_CONDITION_TYPE_AMOUNT_RANGE",
+		5: "CATEGORIZATION_CONDITION_TYPE_BANK",
+		6: "CATEGORIZATION_CONDITION_TYPE_USER",
+		7: "CATEGORIZATION_CONDITION_TYPE_SIGN",
+		8: "CATEGORIZATION_CONDITION_TYPE_DAY_OF_MONTH",
+	}
+	CategorizationConditionType_value = map[string]int32{
+		"CATEGORIZATION_CONDITION_TYPE_UNSPECIFIED":  0,
+		"CATEGORIZATION_CONDITION_TYPE_CONTAINS":     1,
+		"CATEGORIZATION_CONDITION_TYPE_REGEX":        2,
+		"CATEGORIZATION_CONDITION_TYPE_EXACT":        3,
+		"CATEGORIZATION_CONDITION_TYPE_AMOUNT_RANGE": 4,
+		"CATEGORIZATION_CONDITION_TYPE_BANK":         5,
+		"CATEGORIZATION_CONDITION_TYPE_USER":         6,
+		"CATEGORIZATION_CONDITION_TYPE_SIGN":         7,
+		"CATEGORIZATION_CONDITION_TYPE_DAY_OF_MONTH": 8,
+	}
+)
+
+func (x CategorizationConditionType) Enum() *CategorizationConditionType {
+	p := new(CategorizationConditionType)
+	*p = x
+	return p
+}
+
+func (x CategorizationConditionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CategorizationConditionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[18].Descriptor()
+}
+
+func (CategorizationConditionType) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[18]
+}
+
+func (x CategorizationConditionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CategorizationConditionType.Descriptor instead.
+func (CategorizationConditionType) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{18}
+}
+
+type AmountSign int32
+
+const (
+	AmountSign_AMOUNT_SIGN_UNSPECIFIED AmountSign = 0
+	AmountSign_AMOUNT_SIGN_POSITIVE    AmountSign = 1
+	AmountSign_AMOUNT_SIGN_NEGATIVE    AmountSign = 2
+)
+
+// Enum value maps for AmountSign.
+var (
+	AmountSign_name = map[int32]string{
+		0: "AMOUNT_SIGN_UNSPECIFIED",
+		1: "AMOUNT_SIGN_POSITIVE",
+		2: "AMOUNT_SIGN_NEGATIVE",
+	}
+	AmountSign_value = map[string]int32{
+		"AMOUNT_SIGN_UNSPECIFIED": 0,
+		"AMOUNT_SIGN_POSITIVE":    1,
+		"AMOUNT_SIGN_NEGATIVE":    2,
+	}
+)
+
+func (x AmountSign) Enum() *AmountSign {
+	p := new(AmountSign)
+	*p = x
+	return p
+}
+
+func (x AmountSign) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AmountSign) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[19].Descriptor()
+}
+
+func (AmountSign) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[19]
+}
+
+func (x AmountSign) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AmountSign.Descriptor instead.
+func (AmountSign) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{19}
+}
+
+type CategorySuggestionStatus int32
+
+const (
+	CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_UNSPECIFIED CategorySuggestionStatus = 0
+	CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_PENDING     CategorySuggestionStatus = 1
+	CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_ACCEPTED    CategorySuggestionStatus = 2
+	CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_REJECTED    CategorySuggestionStatus = 3
+)
+
+// Enum value maps for CategorySuggestionStatus.
+var (
+	CategorySuggestionStatus_name = map[int32]string{
+		0: "CATEGORY_SUGGESTION_STATUS_UNSPECIFIED",
+		1: "CATEGORY_SUGGESTION_STATUS_PENDING",
+		2: "CATEGORY_SUGGESTION_STATUS_ACCEPTED",
+		3: "CATEGORY_SUGGESTION_STATUS_REJECTED",
+	}
+	CategorySuggestionStatus_value = map[string]int32{
+		"CATEGORY_SUGGESTION_STATUS_UNSPECIFIED": 0,
+		"CATEGORY_SUGGESTION_STATUS_PENDING":     1,
+		"CATEGORY_SUGGESTION_STATUS_ACCEPTED":    2,
+		"CATEGORY_SUGGESTION_STATUS_REJECTED":    3,
+	}
+)
+
+func (x CategorySuggestionStatus) Enum() *CategorySuggestionStatus {
+	p := new(CategorySuggestionStatus)
+	*p = x
+	return p
+}
+
+func (x CategorySuggestionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CategorySuggestionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[20].Descriptor()
+}
+
+func (CategorySuggestionStatus) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[20]
+}
+
+func (x CategorySuggestionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CategorySuggestionStatus.Descriptor instead.
+func (CategorySuggestionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{20}
+}
+
+type CategorySuggestionKind int32
+
+const (
+	CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_UNSPECIFIED CategorySuggestionKind = 0
+	CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_KEYWORD     CategorySuggestionKind = 1
+	CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_RULE        CategorySuggestionKind = 2
+)
+
+// Enum value maps for CategorySuggestionKind.
+var (
+	CategorySuggestionKind_name = map[int32]string{
+		0: "CATEGORY_SUGGESTION_KIND_UNSPECIFIED",
+		1: "CATEGORY_SUGGESTION_KIND_KEYWORD",
+		2: "CATEGORY_SUGGESTION_KIND_RULE",
+	}
+	CategorySuggestionKind_value = map[string]int32{
+		"CATEGORY_SUGGESTION_KIND_UNSPECIFIED": 0,
+		"CATEGORY_SUGGESTION_KIND_KEYWORD":     1,
+		"CATEGORY_SUGGESTION_KIND_RULE":        2,
+	}
+)
+
+func (x CategorySuggestionKind) Enum() *CategorySuggestionKind {
+	p := new(CategorySuggestionKind)
+	*p = x
+	return p
+}
+
+func (x CategorySuggestionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CategorySuggestionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[21].Descriptor()
+}
+
+func (CategorySuggestionKind) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[21]
+}
+
+func (x CategorySuggestionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CategorySuggestionKind.Descriptor instead.
+func (CategorySuggestionKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{21}
+}
+
+type TransactionField int32
+
+const (
+	TransactionField_TRANSACTION_FIELD_UNSPECIFIED TransactionField = 0
+	TransactionField_TRANSACTION_FIELD_DATE        TransactionField = 1
+	TransactionField_TRANSACTION_FIELD_AMOUNT      TransactionField = 2
+	TransactionField_TRANSACTION_FIELD_DESCRIPTION TransactionField = 3
+	TransactionField_TRANSACTION_FIELD_CATEGORY    TransactionField = 4
+	TransactionField_TRANSACTION_FIELD_EXTERNAL_ID TransactionField = 5
+	TransactionField_TRANSACTION_FIELD_BALANCE     TransactionField = 6
+	TransactionField_TRANSACTION_FIELD_DEBIT       TransactionField = 7
+	TransactionField_TRANSACTION_FIELD_CREDIT      TransactionField = 8
+)
+
+// Enum value maps for TransactionField.
+var (
+	TransactionField_name = map[int32]string{
+		0: "TRANSACTION_FIELD_UNSPECIFIED",
+		1: "TRANSACTION_FIELD_DATE",
+		2: "TRANSACTION_FIELD_AMOUNT",
+		3: "TRANSACTION_FIELD_DESCRIPTION",
+		4: "TRANSACTION_FIELD_CATEGORY",
+		5: "TRANSACTION_FIELD_EXTERNAL_ID",
+		6: "TRANSACTION_FIELD_BALANCE",
+		7: "TRANSACTION_FIELD_DEBIT",
+		8: "TRANSACTION_FIELD_CREDIT",
+	}
+	TransactionField_value = map[string]int32{
+		"TRANSACTION_FIELD_UNSPECIFIED": 0,
+		"TRANSACTION_FIELD_DATE":        1,
+		"TRANSACTION_FIELD_AMOUNT":      2,
+		"TRANSACTION_FIELD_DESCRIPTION": 3,
+		"TRANSACTION_FIELD_CATEGORY":    4,
+		"TRANSACTION_FIELD_EXTERNAL_ID": 5,
+		"TRANSACTION_FIELD_BALANCE":     6,
+		"TRANSACTION_FIELD_DEBIT":       7,
+		"TRANSACTION_FIELD_CREDIT":      8,
+	}
+)
+
+func (x TransactionField) Enum() *TransactionField {
+	p := new(TransactionField)
+	*p = x
+	return p
+}
+
+func (x TransactionField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionField) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[22].Descriptor()
+}
+
+func (TransactionField) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[22]
+}
+
+func (x TransactionField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionField.Descriptor instead.
+func (TransactionField) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{22}
+}
+
+type SignConvention int32
+
+const (
+	SignConvention_SIGN_CONVENTION_UNSPECIFIED      SignConvention = 0
+	SignConvention_SIGN_CONVENTION_SIGNED           SignConvention = 1
+	SignConvention_SIGN_CONVENTION_NEGATIVE_OUTCOME SignConvention = 2
+	SignConvention_SIGN_CONVENTION_POSITIVE_OUTCOME SignConvention = 3
+	SignConvention_SIGN_CONVENTION_CATEGORY_KIND    SignConvention = 4
+)
+
+// Enum value maps for SignConvention.
+var (
+	SignConvention_name = map[int32]string{
+		0: "SIGN_CONVENTION_UNSPECIFIED",
+		1: "SIGN_CONVENTION_SIGNED",
+		2: "SIGN_CONVENTION_NEGATIVE_OUTCOME",
+		3: "SIGN_CONVENTION_POSITIVE_OUTCOME",
+		4: "SIGN_CONVENTION_CATEGORY_KIND",
+	}
+	SignConvention_value = map[string]int32{
+		"SIGN_CONVENTION_UNSPECIFIED":      0,
+		"SIGN_CONVENTION_SIGNED":           1,
+		"SIGN_CONVENTION_NEGATIVE_OUTCOME": 2,
+		"SIGN_CONVENTION_POSITIVE_OUTCOME": 3,
+		"SIGN_CONVENTION_CATEGORY_KIND":    4,
+	}
+)
+
+func (x SignConvention) Enum() *SignConvention {
+	p := new(SignConvention)
+	*p = x
+	return p
+}
+
+func (x SignConvention) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignConvention) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[23].Descriptor()
+}
+
+func (SignConvention) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[23]
+}
+
+func (x SignConvention) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignConvention.Descriptor instead.
+func (SignConvention) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{23}
+}
+
+type AmountMode int32
+
+const (
+	AmountMode_AMOUNT_MODE_UNSPECIFIED  AmountMode = 0
+	AmountMode_AMOUNT_MODE_SINGLE       AmountMode = 1
+	AmountMode_AMOUNT_MODE_DEBIT_CREDIT AmountMode = 2
+)
+
+// Enum value maps for AmountMode.
+var (
+	AmountMode_name = map[int32]string{
+		0: "AMOUNT_MODE_UNSPECIFIED",
+		1: "AMOUNT_MODE_SINGLE",
+		2: "AMOUNT_MODE_DEBIT_CREDIT",
+	}
+	AmountMode_value = map[string]int32{
+		"AMOUNT_MODE_UNSPECIFIED":  0,
+		"AMOUNT_MODE_SINGLE":       1,
+		"AMOUNT_MODE_DEBIT_CREDIT": 2,
+	}
+)
+
+func (x AmountMode) Enum() *AmountMode {
+	p := new(AmountMode)
+	*p = x
+	return p
+}
+
+func (x AmountMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AmountMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[24].Descriptor()
+}
+
+func (AmountMode) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[24]
+}
+
+func (x AmountMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AmountMode.Descriptor instead.
+func (AmountMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{24}
+}
+
+type CsvEncoding int32
+
+const (
+	CsvEncoding_CSV_ENCODING_UNSPECIFIED  CsvEncoding = 0
+	CsvEncoding_CSV_ENCODING_UTF8         CsvEncoding = 1
+	CsvEncoding_CSV_ENCODING_UTF16LE      CsvEncoding = 2
+	CsvEncoding_CSV_ENCODING_WINDOWS_1252 CsvEncoding = 3
+	CsvEncoding_CSV_ENCODING_ISO_8859_1   CsvEncoding = 4
+	CsvEncoding_CSV_ENCODING_UTF16BE      CsvEncoding = 5
+)
+
+// Enum value maps for CsvEncoding.
+var (
+	CsvEncoding_name = map[int32]string{
+		0: "CSV_ENCODING_UNSPECIFIED",
+		1: "CSV_ENCODING_UTF8",
+		2: "CSV_ENCODING_UTF16LE",
+		3: "CSV_ENCODING_WINDOWS_1252",
+		4: "CSV_ENCODING_ISO_8859_1",
+		5: "CSV_ENCODING_UTF16BE",
+	}
+	CsvEncoding_value = map[string]int32{
+		"CSV_ENCODING_UNSPECIFIED":  0,
+		"CSV_ENCODING_UTF8":         1,
+		"CSV_ENCODING_UTF16LE":      2,
+		"CSV_ENCODING_WINDOWS_1252": 3,
+		"CSV_ENCODING_ISO_8859_1":   4,
+		"CSV_ENCODING_UTF16BE":      5,
+	}
+)
+
+func (x CsvEncoding) Enum() *CsvEncoding {
+	p := new(CsvEncoding)
+	*p = x
+	return p
+}
+
+func (x CsvEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CsvEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[25].Descriptor()
+}
+
+func (CsvEncoding) Type() protoreflect.EnumType {
+	return &file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes[25]
+}
+
+func (x CsvEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CsvEncoding.Descriptor instead.
+func (CsvEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{25}
+}
+
+type Transaction struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BankId             int64                  `protobuf:"varint,2,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	ExternalId         string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	UserId             int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	Amount             string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId         int64                  `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Description        string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Type               TransactionType        `protobuf:"varint,9,opt,name=type,proto3,enum=fin_aggregator_service.TransactionType" json:"type,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	BankName           string                 `protobuf:"bytes,11,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	CategoryName       string                 `protobuf:"bytes,12,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	UserName           string                 `protobuf:"bytes,13,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	AccountId          *int64                 `protobuf:"varint,14,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	AccountName        *string                `protobuf:"bytes,15,opt,name=account_name,json=accountName,proto3,oneof" json:"account_name,omitempty"`
+	Merchant           *string                `protobuf:"bytes,16,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	Tags               []string               `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
+	CategoryProvenance *CategoryProvenance    `protobuf:"bytes,18,opt,name=category_provenance,json=categoryProvenance,proto3" json:"category_provenance,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Transaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Transaction) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *Transaction) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *Transaction) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Transaction) GetTransactionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return nil
+}
+
+func (x *Transaction) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Transaction) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Transaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transaction) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_UNSPECIFIED
+}
+
+func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *Transaction) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *Transaction) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Transaction) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *Transaction) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+func (x *Transaction) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+func (x *Transaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Transaction) GetCategoryProvenance() *CategoryProvenance {
+	if x != nil {
+		return x.CategoryProvenance
+	}
+	return nil
+}
+
+type CategoryProvenance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        CategorySource         `protobuf:"varint,1,opt,name=source,proto3,enum=fin_aggregator_service.CategorySource" json:"source,omitempty"`
+	RuleId        *int64                 `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"`
+	RuleName      *string                `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3,oneof" json:"rule_name,omitempty"`
+	MatchedText   *string                `protobuf:"bytes,4,opt,name=matched_text,json=matchedText,proto3,oneof" json:"matched_text,omitempty"`
+	Confidence    *float64               `protobuf:"fixed64,5,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryProvenance) Reset() {
+	*x = CategoryProvenance{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryProvenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryProvenance) ProtoMessage() {}
+
+func (x *CategoryProvenance) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryProvenance.ProtoReflect.Descriptor instead.
+func (*CategoryProvenance) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CategoryProvenance) GetSource() CategorySource {
+	if x != nil {
+		return x.Source
+	}
+	return CategorySource_CATEGORY_SOURCE_UNSPECIFIED
+}
+
+func (x *CategoryProvenance) GetRuleId() int64 {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return 0
+}
+
+func (x *CategoryProvenance) GetRuleName() string {
+	if x != nil && x.RuleName != nil {
+		return *x.RuleName
+	}
+	return ""
+}
+
+func (x *CategoryProvenance) GetMatchedText() string {
+	if x != nil && x.MatchedText != nil {
+		return *x.MatchedText
+	}
+	return ""
+}
+
+func (x *CategoryProvenance) GetConfidence() float64 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+type GetTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         int32                  `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsRequest) Reset() {
+	*x = GetTransactionsRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTransactionsRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalIncome   string                 `protobuf:"bytes,3,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
+	TotalOutcome  string                 `protobuf:"bytes,4,opt,name=total_outcome,json=totalOutcome,proto3" json:"total_outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsResponse) Reset() {
+	*x = GetTransactionsResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetTransactionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetTransactionsResponse) GetTotalIncome() string {
+	if x != nil {
+		return x.TotalIncome
+	}
+	return ""
+}
+
+func (x *GetTransactionsResponse) GetTotalOutcome() string {
+	if x != nil {
+		return x.TotalOutcome
+	}
+	return ""
+}
+
+type UpdateTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Type          *TransactionType       `protobuf:"varint,3,opt,name=type,proto3,enum=fin_aggregator_service.TransactionType,oneof" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionRequest) Reset() {
+	*x = UpdateTransactionRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateTransactionRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetType() TransactionType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return TransactionType_UNSPECIFIED
+}
+
+type UpdateTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionResponse) Reset() {
+	*x = UpdateTransactionResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionResponse) ProtoMessage() {}
+
+func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type MonzoCallbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonzoCallbackRequest) Reset() {
+	*x = MonzoCallbackRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonzoCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonzoCallbackRequest) ProtoMessage() {}
+
+func (x *MonzoCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonzoCallbackRequest.ProtoReflect.Descriptor instead.
+func (*MonzoCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MonzoCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *MonzoCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type MonzoCallbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonzoCallbackResponse) Reset() {
+	*x = MonzoCallbackResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonzoCallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonzoCallbackResponse) ProtoMessage() {}
+
+func (x *MonzoCallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonzoCallbackResponse.ProtoReflect.Descriptor instead.
+func (*MonzoCallbackResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MonzoCallbackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type MonzoAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonzoAccountRequest) Reset() {
+	*x = MonzoAccountRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonzoAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonzoAccountRequest) ProtoMessage() {}
+
+func (x *MonzoAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonzoAccountRequest.ProtoReflect.Descriptor instead.
+func (*MonzoAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{8}
+}
+
+type MonzoAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Accounts      []*MonzoAccount        `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonzoAccountResponse) Reset() {
+	*x = MonzoAccountResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonzoAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonzoAccountResponse) ProtoMessage() {}
+
+func (x *MonzoAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonzoAccountResponse.ProtoReflect.Descriptor instead.
+func (*MonzoAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MonzoAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MonzoAccountResponse) GetAccounts() []*MonzoAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type MonzoAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Closed        bool                   `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonzoAccount) Reset() {
+	*x = MonzoAccount{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonzoAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonzoAccount) ProtoMessage() {}
+
+func (x *MonzoAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonzoAccount.ProtoReflect.Descriptor instead.
+func (*MonzoAccount) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MonzoAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MonzoAccount) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MonzoAccount) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MonzoAccount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *MonzoAccount) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+type GetMonzoAuthURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonzoAuthURLRequest) Reset() {
+	*x = GetMonzoAuthURLRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonzoAuthURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonzoAuthURLRequest) ProtoMessage() {}
+
+func (x *GetMonzoAuthURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonzoAuthURLRequest.ProtoReflect.Descriptor instead.
+func (*GetMonzoAuthURLRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{11}
+}
+
+type GetMonzoAuthURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthUrl       string                 `protobuf:"bytes,1,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonzoAuthURLResponse) Reset() {
+	*x = GetMonzoAuthURLResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonzoAuthURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonzoAuthURLResponse) ProtoMessage() {}
+
+func (x *GetMonzoAuthURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonzoAuthURLResponse.ProtoReflect.Descriptor instead.
+func (*GetMonzoAuthURLResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMonzoAuthURLResponse) GetAuthUrl() string {
+	if x != nil {
+		return x.AuthUrl
+	}
+	return ""
+}
+
+type LoadMonzoTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BankId        int64                  `protobuf:"varint,4,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadMonzoTransactionsRequest) Reset() {
+	*x = LoadMonzoTransactionsRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadMonzoTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadMonzoTransactionsRequest) ProtoMessage() {}
+
+func (x *LoadMonzoTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadMonzoTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*LoadMonzoTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LoadMonzoTransactionsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *LoadMonzoTransactionsRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *LoadMonzoTransactionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LoadMonzoTransactionsRequest) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *LoadMonzoTransactionsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+type LoadMonzoTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadMonzoTransactionsResponse) Reset() {
+	*x = LoadMonzoTransactionsResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadMonzoTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadMonzoTransactionsResponse) ProtoMessage() {}
+
+func (x *LoadMonzoTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadMonzoTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*LoadMonzoTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LoadMonzoTransactionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UploadCSVRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CsvData       []byte                 `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	BankId        int64                  `protobuf:"varint,3,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCSVRequest) Reset() {
+	*x = UploadCSVRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCSVRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCSVRequest) ProtoMessage() {}
+
+func (x *UploadCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCSVRequest.ProtoReflect.Descriptor instead.
+func (*UploadCSVRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UploadCSVRequest) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+func (x *UploadCSVRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadCSVRequest) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *UploadCSVRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UploadCSVRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+type UploadCSVResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RecordError   []*RecordError         `protobuf:"bytes,2,rep,name=record_error,json=recordError,proto3" json:"record_error,omitempty"`
+	Dialect       *CsvDialect            `protobuf:"bytes,3,opt,name=dialect,proto3" json:"dialect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCSVResponse) Reset() {
+	*x = UploadCSVResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCSVResponse) ProtoMessage() {}
+
+func (x *UploadCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCSVResponse.ProtoReflect.Descriptor instead.
+func (*UploadCSVResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UploadCSVResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UploadCSVResponse) GetRecordError() []*RecordError {
+	if x != nil {
+		return x.RecordError
+	}
+	return nil
+}
+
+func (x *UploadCSVResponse) GetDialect() *CsvDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return nil
+}
+
+type CsvDialect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Encoding      CsvEncoding            `protobuf:"varint,1,opt,name=encoding,proto3,enum=fin_aggregator_service.CsvEncoding" json:"encoding,omitempty"`
+	Bom           bool                   `protobuf:"varint,2,opt,name=bom,proto3" json:"bom,omitempty"`
+	Delimiter     string                 `protobuf:"bytes,3,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	Quote         string                 `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	LenientQuotes bool                   `protobuf:"varint,5,opt,name=lenient_quotes,json=lenientQuotes,proto3" json:"lenient_quotes,omitempty"`
+	HeaderRow     int32                  `protobuf:"varint,6,opt,name=header_row,json=headerRow,proto3" json:"header_row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CsvDialect) Reset() {
+	*x = CsvDialect{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvDialect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvDialect) ProtoMessage() {}
+
+func (x *CsvDialect) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvDialect.ProtoReflect.Descriptor instead.
+func (*CsvDialect) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CsvDialect) GetEncoding() CsvEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return CsvEncoding_CSV_ENCODING_UNSPECIFIED
+}
+
+func (x *CsvDialect) GetBom() bool {
+	if x != nil {
+		return x.Bom
+	}
+	return false
+}
+
+func (x *CsvDialect) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *CsvDialect) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *CsvDialect) GetLenientQuotes() bool {
+	if x != nil {
+		return x.LenientQuotes
+	}
+	return false
+}
+
+func (x *CsvDialect) GetHeaderRow() int32 {
+	if x != nil {
+		return x.HeaderRow
+	}
+	return 0
+}
+
+type RecordError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowId         int64                  `protobuf:"varint,1,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordError) Reset() {
+	*x = RecordError{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordError) ProtoMessage() {}
+
+func (x *RecordError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordError.ProtoReflect.Descriptor instead.
+func (*RecordError) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecordError) GetRowId() int64 {
+	if x != nil {
+		return x.RowId
+	}
+	return 0
+}
+
+func (x *RecordError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListBankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBankRequest) Reset() {
+	*x = ListBankRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankRequest) ProtoMessage() {}
+
+func (x *ListBankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBankRequest.ProtoReflect.Descriptor instead.
+func (*ListBankRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{19}
+}
+
+type ListBankResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Banks         []*Bank                `protobuf:"bytes,1,rep,name=banks,proto3" json:"banks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBankResponse) Reset() {
+	*x = ListBankResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankResponse) ProtoMessage() {}
+
+func (x *ListBankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBankResponse.ProtoReflect.Descriptor instead.
+func (*ListBankResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListBankResponse) GetBanks() []*Bank {
+	if x != nil {
+		return x.Banks
+	}
+	return nil
+}
+
+type Bank struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ImportMethod  []BankImportMethod     `protobuf:"varint,3,rep,packed,name=import_method,json=importMethod,proto3,enum=fin_aggregator_service.BankImportMethod" json:"import_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bank) Reset() {
+	*x = Bank{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bank) ProtoMessage() {}
+
+func (x *Bank) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bank.ProtoReflect.Descriptor instead.
+func (*Bank) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Bank) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Bank) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bank) GetImportMethod() []BankImportMethod {
+	if x != nil {
+		return x.ImportMethod
+	}
+	return nil
+}
+
+type ListUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserRequest) Reset() {
+	*x = ListUserRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRequest) ProtoMessage() {}
+
+func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{22}
+}
+
+type ListUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserResponse) Reset() {
+	*x = ListUserResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserResponse) ProtoMessage() {}
+
+func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
+func (*ListUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListUserResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Banks         []int64                `protobuf:"varint,3,rep,packed,name=banks,proto3" json:"banks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetBanks() []int64 {
+	if x != nil {
+		return x.Banks
+	}
+	return nil
+}
+
+type ListCategoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived bool                   `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// tree nests subcategories under their parents instead of returning a flat list.
+	Tree bool `protobuf:"varint,2,opt,name=tree,proto3" json:"tree,omitempty"`
+	// user_id adds the categories of the user to the global ones.
+	UserId        *int64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryRequest) Reset() {
+	*x = ListCategoryRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryRequest) ProtoMessage() {}
+
+func (x *ListCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListCategoryRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListCategoryRequest) GetTree() bool {
+	if x != nil {
+		return x.Tree
+	}
+	return false
+}
+
+func (x *ListCategoryRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type ListCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      []*Category            `protobuf:"bytes,1,rep,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryResponse) Reset() {
+	*x = ListCategoryResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryResponse) ProtoMessage() {}
+
+func (x *ListCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCategoryResponse) GetCategory() []*Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type Category struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Archived    bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	ParentId    *int64                 `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Children    []*Category            `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	Kind        CategoryKind           `protobuf:"varint,7,opt,name=kind,proto3,enum=fin_aggregator_service.CategoryKind" json:"kind,omitempty"`
+	// user_id is set for user categories, global categories have none.
+	UserId        *int64 `protobuf:"varint,8,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Category) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *Category) GetChildren() []*Category {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Category) GetKind() CategoryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CategoryKind_CATEGORY_KIND_UNSPECIFIED
+}
+
+func (x *Category) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type ListTransactionTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionTypeRequest) Reset() {
+	*x = ListTransactionTypeRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionTypeRequest) ProtoMessage() {}
+
+func (x *ListTransactionTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionTypeRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{28}
+}
+
+type ListTransactionTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          []TransactionType      `protobuf:"varint,1,rep,packed,name=type,proto3,enum=fin_aggregator_service.TransactionType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionTypeResponse) Reset() {
+	*x = ListTransactionTypeResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionTypeResponse) ProtoMessage() {}
+
+func (x *ListTransactionTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionTypeResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTransactionTypeResponse) GetType() []TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+type GetSpendingInsightsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Month            int32                  `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	Year             int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	UserId           *int64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	ParentCategoryId *int64                 `protobuf:"varint,4,opt,name=parent_category_id,json=parentCategoryId,proto3,oneof" json:"parent_category_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetSpendingInsightsRequest) Reset() {
+	*x = GetSpendingInsightsRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendingInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingInsightsRequest) ProtoMessage() {}
+
+func (x *GetSpendingInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingInsightsRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSpendingInsightsRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *GetSpendingInsightsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetSpendingInsightsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetSpendingInsightsRequest) GetParentCategoryId() int64 {
+	if x != nil && x.ParentCategoryId != nil {
+		return *x.ParentCategoryId
+	}
+	return 0
+}
+
+type GetSpendingInsightsResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Month         int32                         `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	Year          int32                         `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	TotalSpend    string                        `protobuf:"bytes,3,opt,name=total_spend,json=totalSpend,proto3" json:"total_spend,omitempty"`
+	Categories    []*CategorySpendingInsight    `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	LargeExpenses []*TransactionSpendingInsight `protobuf:"bytes,5,rep,name=large_expenses,json=largeExpenses,proto3" json:"large_expenses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpendingInsightsResponse) Reset() {
+	*x = GetSpendingInsightsResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendingInsightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingInsightsResponse) ProtoMessage() {}
+
+func (x *GetSpendingInsightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingInsightsResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendingInsightsResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSpendingInsightsResponse) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *GetSpendingInsightsResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetSpendingInsightsResponse) GetTotalSpend() string {
+	if x != nil {
+		return x.TotalSpend
+	}
+	return ""
+}
+
+func (x *GetSpendingInsightsResponse) GetCategories() []*CategorySpendingInsight {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetSpendingInsightsResponse) GetLargeExpenses() []*TransactionSpendingInsight {
+	if x != nil {
+		return x.LargeExpenses
+	}
+	return nil
+}
+
+type CategorySpendingInsight struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId                  int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName                string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Amount                      string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	PreviousMonthAmount         string                 `protobuf:"bytes,4,opt,name=previous_month_amount,json=previousMonthAmount,proto3" json:"previous_month_amount,omitempty"`
+	MonthOverMonthChangePercent *float64               `protobuf:"fixed64,5,opt,name=month_over_month_change_percent,json=monthOverMonthChangePercent,proto3,oneof" json:"month_over_month_change_percent,omitempty"`
+	LastYearAmount              string                 `protobuf:"bytes,6,opt,name=last_year_amount,json=lastYearAmount,proto3" json:"last_year_amount,omitempty"`
+	YearOverYearChangePercent   *float64               `protobuf:"fixed64,7,opt,name=year_over_year_change_percent,json=yearOverYearChangePercent,proto3,oneof" json:"year_over_year_change_percent,omitempty"`
+	RollingMean                 string                 `protobuf:"bytes,8,opt,name=rolling_mean,json=rollingMean,proto3" json:"rolling_mean,omitempty"`
+	RollingStdDev               string                 `protobuf:"bytes,9,opt,name=rolling_std_dev,json=rollingStdDev,proto3" json:"rolling_std_dev,omitempty"`
+	ZScore                      *float64               `protobuf:"fixed64,10,opt,name=z_score,json=zScore,proto3,oneof" json:"z_score,omitempty"`
+	IsAnomaly                   bool                   `protobuf:"varint,11,opt,name=is_anomaly,json=isAnomaly,proto3" json:"is_anomaly,omitempty"`
+	HasSubcategories            bool                   `protobuf:"varint,12,opt,name=has_subcategories,json=hasSubcategories,proto3" json:"has_subcategories,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *CategorySpendingInsight) Reset() {
+	*x = CategorySpendingInsight{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorySpendingInsight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorySpendingInsight) ProtoMessage() {}
+
+func (x *CategorySpendingInsight) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorySpendingInsight.ProtoReflect.Descriptor instead.
+func (*CategorySpendingInsight) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CategorySpendingInsight) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategorySpendingInsight) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategorySpendingInsight) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CategorySpendingInsight) GetPreviousMonthAmount() string {
+	if x != nil {
+		return x.PreviousMonthAmount
+	}
+	return ""
+}
+
+func (x *CategorySpendingInsight) GetMonthOverMonthChangePercent() float64 {
+	if x != nil && x.MonthOverMonthChangePercent != nil {
+		return *x.MonthOverMonthChangePercent
+	}
+	return 0
+}
+
+func (x *CategorySpendingInsight) GetLastYearAmount() string {
+	if x != nil {
+		return x.LastYearAmount
+	}
+	return ""
+}
+
+func (x *CategorySpendingInsight) GetYearOverYearChangePercent() float64 {
+	if x != nil && x.YearOverYearChangePercent != nil {
+		return *x.YearOverYearChangePercent
+	}
+	return 0
+}
+
+func (x *CategorySpendingInsight) GetRollingMean() string {
+	if x != nil {
+		return x.RollingMean
+	}
+	return ""
+}
+
+func (x *CategorySpendingInsight) GetRollingStdDev() string {
+	if x != nil {
+		return x.RollingStdDev
+	}
+	return ""
+}
+
+func (x *CategorySpendingInsight) GetZScore() float64 {
+	if x != nil && x.ZScore != nil {
+		return *x.ZScore
+	}
+	return 0
+}
+
+func (x *CategorySpendingInsight) GetIsAnomaly() bool {
+	if x != nil {
+		return x.IsAnomaly
+	}
+	return false
+}
+
+func (x *CategorySpendingInsight) GetHasSubcategories() bool {
+	if x != nil {
+		return x.HasSubcategories
+	}
+	return false
+}
+
+type TransactionSpendingInsight struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId   int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BankId          int64                  `protobuf:"varint,3,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	CategoryId      int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName    string                 `protobuf:"bytes,5,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Amount          string                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	BaselineSource  InsightBaselineSource  `protobuf:"varint,9,opt,name=baseline_source,json=baselineSource,proto3,enum=fin_aggregator_service.InsightBaselineSource" json:"baseline_source,omitempty"`
+	BaselineMean    string                 `protobuf:"bytes,10,opt,name=baseline_mean,json=baselineMean,proto3" json:"baseline_mean,omitempty"`
+	BaselineStdDev  string                 `protobuf:"bytes,11,opt,name=baseline_std_dev,json=baselineStdDev,proto3" json:"baseline_std_dev,omitempty"`
+	ZScore          float64                `protobuf:"fixed64,12,opt,name=z_score,json=zScore,proto3" json:"z_score,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransactionSpendingInsight) Reset() {
+	*x = TransactionSpendingInsight{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSpendingInsight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSpendingInsight) ProtoMessage() {}
+
+func (x *TransactionSpendingInsight) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSpendingInsight.ProtoReflect.Descriptor instead.
+func (*TransactionSpendingInsight) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TransactionSpendingInsight) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *TransactionSpendingInsight) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TransactionSpendingInsight) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *TransactionSpendingInsight) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *TransactionSpendingInsight) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *TransactionSpendingInsight) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TransactionSpendingInsight) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransactionSpendingInsight) GetTransactionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return nil
+}
+
+func (x *TransactionSpendingInsight) GetBaselineSource() InsightBaselineSource {
+	if x != nil {
+		return x.BaselineSource
+	}
+	return InsightBaselineSource_BASELINE_UNSPECIFIED
+}
+
+func (x *TransactionSpendingInsight) GetBaselineMean() string {
+	if x != nil {
+		return x.BaselineMean
+	}
+	return ""
+}
+
+func (x *TransactionSpendingInsight) GetBaselineStdDev() string {
+	if x != nil {
+		return x.BaselineStdDev
+	}
+	return ""
+}
+
+func (x *TransactionSpendingInsight) GetZScore() float64 {
+	if x != nil {
+		return x.ZScore
+	}
+	return 0
+}
+
+type ExpenseShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Percentage    *float64               `protobuf:"fixed64,2,opt,name=percentage,proto3,oneof" json:"percentage,omitempty"`
+	Amount        *string                `protobuf:"bytes,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseShare) Reset() {
+	*x = ExpenseShare{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseShare) ProtoMessage() {}
+
+func (x *ExpenseShare) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseShare.ProtoReflect.Descriptor instead.
+func (*ExpenseShare) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExpenseShare) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExpenseShare) GetPercentage() float64 {
+	if x != nil && x.Percentage != nil {
+		return *x.Percentage
+	}
+	return 0
+}
+
+func (x *ExpenseShare) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+type SharedExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PayerUserId   int64                  `protobuf:"varint,3,opt,name=payer_user_id,json=payerUserId,proto3" json:"payer_user_id,omitempty"`
+	TotalAmount   string                 `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	SplitMethod   SplitMethod            `protobuf:"varint,5,opt,name=split_method,json=splitMethod,proto3,enum=fin_aggregator_service.SplitMethod" json:"split_method,omitempty"`
+	Shares        []*ExpenseShare        `protobuf:"bytes,6,rep,name=shares,proto3" json:"shares,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedExpense) Reset() {
+	*x = SharedExpense{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedExpense) ProtoMessage() {}
+
+func (x *SharedExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedExpense.ProtoReflect.Descriptor instead.
+func (*SharedExpense) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SharedExpense) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SharedExpense) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SharedExpense) GetPayerUserId() int64 {
+	if x != nil {
+		return x.PayerUserId
+	}
+	return 0
+}
+
+func (x *SharedExpense) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *SharedExpense) GetSplitMethod() SplitMethod {
+	if x != nil {
+		return x.SplitMethod
+	}
+	return SplitMethod_SPLIT_METHOD_UNSPECIFIED
+}
+
+func (x *SharedExpense) GetShares() []*ExpenseShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *SharedExpense) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type MarkSharedExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PayerUserId   *int64                 `protobuf:"varint,2,opt,name=payer_user_id,json=payerUserId,proto3,oneof" json:"payer_user_id,omitempty"`
+	SplitMethod   SplitMethod            `protobuf:"varint,3,opt,name=split_method,json=splitMethod,proto3,enum=fin_aggregator_service.SplitMethod" json:"split_method,omitempty"`
+	Shares        []*ExpenseShare        `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkSharedExpenseRequest) Reset() {
+	*x = MarkSharedExpenseRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkSharedExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSharedExpenseRequest) ProtoMessage() {}
+
+func (x *MarkSharedExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSharedExpenseRequest.ProtoReflect.Descriptor instead.
+func (*MarkSharedExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MarkSharedExpenseRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *MarkSharedExpenseRequest) GetPayerUserId() int64 {
+	if x != nil && x.PayerUserId != nil {
+		return *x.PayerUserId
+	}
+	return 0
+}
+
+func (x *MarkSharedExpenseRequest) GetSplitMethod() SplitMethod {
+	if x != nil {
+		return x.SplitMethod
+	}
+	return SplitMethod_SPLIT_METHOD_UNSPECIFIED
+}
+
+func (x *MarkSharedExpenseRequest) GetShares() []*ExpenseShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type MarkSharedExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharedExpense *SharedExpense         `protobuf:"bytes,1,opt,name=shared_expense,json=sharedExpense,proto3" json:"shared_expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkSharedExpenseResponse) Reset() {
+	*x = MarkSharedExpenseResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkSharedExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSharedExpenseResponse) ProtoMessage() {}
+
+func (x *MarkSharedExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSharedExpenseResponse.ProtoReflect.Descriptor instead.
+func (*MarkSharedExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MarkSharedExpenseResponse) GetSharedExpense() *SharedExpense {
+	if x != nil {
+		return x.SharedExpense
+	}
+	return nil
+}
+
+type UnmarkSharedExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmarkSharedExpenseRequest) Reset() {
+	*x = UnmarkSharedExpenseRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmarkSharedExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmarkSharedExpenseRequest) ProtoMessage() {}
+
+func (x *UnmarkSharedExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmarkSharedExpenseRequest.ProtoReflect.Descriptor instead.
+func (*UnmarkSharedExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UnmarkSharedExpenseRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type UnmarkSharedExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmarkSharedExpenseResponse) Reset() {
+	*x = UnmarkSharedExpenseResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmarkSharedExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmarkSharedExpenseResponse) ProtoMessage() {}
+
+func (x *UnmarkSharedExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmarkSharedExpenseResponse.ProtoReflect.Descriptor instead.
+func (*UnmarkSharedExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnmarkSharedExpenseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListSharedExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedExpenseRequest) Reset() {
+	*x = ListSharedExpenseRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedExpenseRequest) ProtoMessage() {}
+
+func (x *ListSharedExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedExpenseRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListSharedExpenseRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type ListSharedExpenseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SharedExpenses []*SharedExpense       `protobuf:"bytes,1,rep,name=shared_expenses,json=sharedExpenses,proto3" json:"shared_expenses,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSharedExpenseResponse) Reset() {
+	*x = ListSharedExpenseResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedExpenseResponse) ProtoMessage() {}
+
+func (x *ListSharedExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedExpenseResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSharedExpenseResponse) GetSharedExpenses() []*SharedExpense {
+	if x != nil {
+		return x.SharedExpenses
+	}
+	return nil
+}
+
+type UserBalance struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DebtorUserId   int64                  `protobuf:"varint,1,opt,name=debtor_user_id,json=debtorUserId,proto3" json:"debtor_user_id,omitempty"`
+	CreditorUserId int64                  `protobuf:"varint,2,opt,name=creditor_user_id,json=creditorUserId,proto3" json:"creditor_user_id,omitempty"`
+	Amount         string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserBalance) Reset() {
+	*x = UserBalance{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBalance) ProtoMessage() {}
+
+func (x *UserBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBalance.ProtoReflect.Descriptor instead.
+func (*UserBalance) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UserBalance) GetDebtorUserId() int64 {
+	if x != nil {
+		return x.DebtorUserId
+	}
+	return 0
+}
+
+func (x *UserBalance) GetCreditorUserId() int64 {
+	if x != nil {
+		return x.CreditorUserId
+	}
+	return 0
+}
+
+func (x *UserBalance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetUserBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalancesRequest) Reset() {
+	*x = GetUserBalancesRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalancesRequest) ProtoMessage() {}
+
+func (x *GetUserBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetUserBalancesRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type GetUserBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*UserBalance         `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalancesResponse) Reset() {
+	*x = GetUserBalancesResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalancesResponse) ProtoMessage() {}
+
+func (x *GetUserBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetUserBalancesResponse) GetBalances() []*UserBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type SettlementSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    int64                  `protobuf:"varint,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      int64                  `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementSuggestion) Reset() {
+	*x = SettlementSuggestion{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementSuggestion) ProtoMessage() {}
+
+func (x *SettlementSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementSuggestion.ProtoReflect.Descriptor instead.
+func (*SettlementSuggestion) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SettlementSuggestion) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *SettlementSuggestion) GetToUserId() int64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *SettlementSuggestion) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetSettleUpSuggestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettleUpSuggestionsRequest) Reset() {
+	*x = GetSettleUpSuggestionsRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettleUpSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettleUpSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetSettleUpSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettleUpSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettleUpSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{46}
+}
+
+type GetSettleUpSuggestionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Suggestions   []*SettlementSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettleUpSuggestionsResponse) Reset() {
+	*x = GetSettleUpSuggestionsResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettleUpSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettleUpSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetSettleUpSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettleUpSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSettleUpSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSettleUpSuggestionsResponse) GetSuggestions() []*SettlementSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type Settlement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromUserId    int64                  `protobuf:"varint,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      int64                  `protobuf:"varint,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,5,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Note          *string                `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	SettledAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Settlement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Settlement) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *Settlement) GetToUserId() int64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *Settlement) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Settlement) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *Settlement) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *Settlement) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+func (x *Settlement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RecordSettlementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    int64                  `protobuf:"varint,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      int64                  `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Amount        *string                `protobuf:"bytes,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,4,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Note          *string                `protobuf:"bytes,5,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	SettledAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSettlementRequest) Reset() {
+	*x = RecordSettlementRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSettlementRequest) ProtoMessage() {}
+
+func (x *RecordSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSettlementRequest.ProtoReflect.Descriptor instead.
+func (*RecordSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RecordSettlementRequest) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *RecordSettlementRequest) GetToUserId() int64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *RecordSettlementRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+func (x *RecordSettlementRequest) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *RecordSettlementRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *RecordSettlementRequest) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+type RecordSettlementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlement    *Settlement            `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSettlementResponse) Reset() {
+	*x = RecordSettlementResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSettlementResponse) ProtoMessage() {}
+
+func (x *RecordSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSettlementResponse.ProtoReflect.Descriptor instead.
+func (*RecordSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RecordSettlementResponse) GetSettlement() *Settlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+type ListSettlementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettlementRequest) Reset() {
+	*x = ListSettlementRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettlementRequest) ProtoMessage() {}
+
+func (x *ListSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettlementRequest.ProtoReflect.Descriptor instead.
+func (*ListSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListSettlementRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type ListSettlementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlements   []*Settlement          `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettlementResponse) Reset() {
+	*x = ListSettlementResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettlementResponse) ProtoMessage() {}
+
+func (x *ListSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettlementResponse.ProtoReflect.Descriptor instead.
+func (*ListSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListSettlementResponse) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
+}
+
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BankId        int64                  `protobuf:"varint,2,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	Type          AccountType            `protobuf:"varint,3,opt,name=type,proto3,enum=fin_aggregator_service.AccountType" json:"type,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExternalId    *string                `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	OwnerIds      []int64                `protobuf:"varint,7,rep,packed,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Account) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Account) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *Account) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *Account) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Account) GetOwnerIds() []int64 {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankId        int64                  `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	Type          AccountType            `protobuf:"varint,2,opt,name=type,proto3,enum=fin_aggregator_service.AccountType" json:"type,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExternalId    *string                `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	OwnerIds      []int64                `protobuf:"varint,6,rep,packed,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateAccountRequest) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetOwnerIds() []int64 {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type UpdateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Type          *AccountType           `protobuf:"varint,2,opt,name=type,proto3,enum=fin_aggregator_service.AccountType,oneof" json:"type,omitempty"`
+	Currency      *string                `protobuf:"bytes,3,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	ExternalId    *string                `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	DisplayName   *string                `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	OwnerIds      []int64                `protobuf:"varint,6,rep,packed,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateAccountRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetType() AccountType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateAccountRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetOwnerIds() []int64 {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+type UpdateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountResponse) Reset() {
+	*x = UpdateAccountResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountResponse) ProtoMessage() {}
+
+func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpdateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteAccountRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetAccountRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type GetAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type ListAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	BankId        *int64                 `protobuf:"varint,2,opt,name=bank_id,json=bankId,proto3,oneof" json:"bank_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountRequest) Reset() {
+	*x = ListAccountRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountRequest) ProtoMessage() {}
+
+func (x *ListAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListAccountRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ListAccountRequest) GetBankId() int64 {
+	if x != nil && x.BankId != nil {
+		return *x.BankId
+	}
+	return 0
+}
+
+type ListAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountResponse) Reset() {
+	*x = ListAccountResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountResponse) ProtoMessage() {}
+
+func (x *ListAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListAccountResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type ListAccountTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountTypeRequest) Reset() {
+	*x = ListAccountTypeRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountTypeRequest) ProtoMessage() {}
+
+func (x *ListAccountTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountTypeRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{64}
+}
+
+type ListAccountTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          []AccountType          `protobuf:"varint,1,rep,packed,name=type,proto3,enum=fin_aggregator_service.AccountType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountTypeResponse) Reset() {
+	*x = ListAccountTypeResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountTypeResponse) ProtoMessage() {}
+
+func (x *ListAccountTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountTypeResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListAccountTypeResponse) GetType() []AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+type BalanceSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Balance       string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	BalanceDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=balance_date,json=balanceDate,proto3" json:"balance_date,omitempty"`
+	Source        BalanceSnapshotSource  `protobuf:"varint,5,opt,name=source,proto3,enum=fin_aggregator_service.BalanceSnapshotSource" json:"source,omitempty"`
+	Note          *string                `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceSnapshot) Reset() {
+	*x = BalanceSnapshot{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceSnapshot) ProtoMessage() {}
+
+func (x *BalanceSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceSnapshot.ProtoReflect.Descriptor instead.
+func (*BalanceSnapshot) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BalanceSnapshot) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BalanceSnapshot) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *BalanceSnapshot) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *BalanceSnapshot) GetBalanceDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BalanceDate
+	}
+	return nil
+}
+
+func (x *BalanceSnapshot) GetSource() BalanceSnapshotSource {
+	if x != nil {
+		return x.Source
+	}
+	return BalanceSnapshotSource_BALANCE_SOURCE_UNSPECIFIED
+}
+
+func (x *BalanceSnapshot) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *BalanceSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateBalanceSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	BalanceDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=balance_date,json=balanceDate,proto3" json:"balance_date,omitempty"`
+	Note          *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBalanceSnapshotRequest) Reset() {
+	*x = CreateBalanceSnapshotRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBalanceSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBalanceSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateBalanceSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBalanceSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateBalanceSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateBalanceSnapshotRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CreateBalanceSnapshotRequest) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *CreateBalanceSnapshotRequest) GetBalanceDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BalanceDate
+	}
+	return nil
+}
+
+func (x *CreateBalanceSnapshotRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type CreateBalanceSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *BalanceSnapshot       `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBalanceSnapshotResponse) Reset() {
+	*x = CreateBalanceSnapshotResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBalanceSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBalanceSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateBalanceSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBalanceSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateBalanceSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateBalanceSnapshotResponse) GetSnapshot() *BalanceSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ListBalanceSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBalanceSnapshotRequest) Reset() {
+	*x = ListBalanceSnapshotRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBalanceSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalanceSnapshotRequest) ProtoMessage() {}
+
+func (x *ListBalanceSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalanceSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*ListBalanceSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListBalanceSnapshotRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ListBalanceSnapshotRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListBalanceSnapshotRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListBalanceSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*BalanceSnapshot     `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBalanceSnapshotResponse) Reset() {
+	*x = ListBalanceSnapshotResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBalanceSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalanceSnapshotResponse) ProtoMessage() {}
+
+func (x *ListBalanceSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalanceSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*ListBalanceSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListBalanceSnapshotResponse) GetSnapshots() []*BalanceSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type DeleteBalanceSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId    int64                  `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBalanceSnapshotRequest) Reset() {
+	*x = DeleteBalanceSnapshotRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBalanceSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBalanceSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteBalanceSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBalanceSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteBalanceSnapshotRequest) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+type DeleteBalanceSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBalanceSnapshotResponse) Reset() {
+	*x = DeleteBalanceSnapshotResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBalanceSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBalanceSnapshotResponse) ProtoMessage() {}
+
+func (x *DeleteBalanceSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBalanceSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteBalanceSnapshotResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReconciliationPeriod struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	StartDate              *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OpeningBalance         string                 `protobuf:"bytes,3,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	ExpectedClosingBalance string                 `protobuf:"bytes,4,opt,name=expected_closing_balance,json=expectedClosingBalance,proto3" json:"expected_closing_balance,omitempty"`
+	ActualClosingBalance   string                 `protobuf:"bytes,5,opt,name=actual_closing_balance,json=actualClosingBalance,proto3" json:"actual_closing_balance,omitempty"`
+	Difference             string                 `protobuf:"bytes,6,opt,name=difference,proto3" json:"difference,omitempty"`
+	TransactionCount       int32                  `protobuf:"varint,7,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	Status                 ReconciliationStatus   `protobuf:"varint,8,opt,name=status,proto3,enum=fin_aggregator_service.ReconciliationStatus" json:"status,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReconciliationPeriod) Reset() {
+	*x = ReconciliationPeriod{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationPeriod) ProtoMessage() {}
+
+func (x *ReconciliationPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationPeriod.ProtoReflect.Descriptor instead.
+func (*ReconciliationPeriod) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReconciliationPeriod) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ReconciliationPeriod) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ReconciliationPeriod) GetOpeningBalance() string {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return ""
+}
+
+func (x *ReconciliationPeriod) GetExpectedClosingBalance() string {
+	if x != nil {
+		return x.ExpectedClosingBalance
+	}
+	return ""
+}
+
+func (x *ReconciliationPeriod) GetActualClosingBalance() string {
+	if x != nil {
+		return x.ActualClosingBalance
+	}
+	return ""
+}
+
+func (x *ReconciliationPeriod) GetDifference() string {
+	if x != nil {
+		return x.Difference
+	}
+	return ""
+}
+
+func (x *ReconciliationPeriod) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *ReconciliationPeriod) GetStatus() ReconciliationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReconciliationStatus_RECONCILIATION_STATUS_UNSPECIFIED
+}
+
+type ReconcileAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileAccountRequest) Reset() {
+	*x = ReconcileAccountRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileAccountRequest) ProtoMessage() {}
+
+func (x *ReconcileAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileAccountRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReconcileAccountRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ReconcileAccountRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReconcileAccountRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ReconcileAccountResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	AccountId     int64                   `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	SnapshotCount int32                   `protobuf:"varint,2,opt,name=snapshot_count,json=snapshotCount,proto3" json:"snapshot_count,omitempty"`
+	IsReconciled  bool                    `protobuf:"varint,3,opt,name=is_reconciled,json=isReconciled,proto3" json:"is_reconciled,omitempty"`
+	Periods       []*ReconciliationPeriod `protobuf:"bytes,4,rep,name=periods,proto3" json:"periods,omitempty"`
+	FirstIssue    *ReconciliationPeriod   `protobuf:"bytes,5,opt,name=first_issue,json=firstIssue,proto3" json:"first_issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileAccountResponse) Reset() {
+	*x = ReconcileAccountResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileAccountResponse) ProtoMessage() {}
+
+func (x *ReconcileAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileAccountResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReconcileAccountResponse) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ReconcileAccountResponse) GetSnapshotCount() int32 {
+	if x != nil {
+		return x.SnapshotCount
+	}
+	return 0
+}
+
+func (x *ReconcileAccountResponse) GetIsReconciled() bool {
+	if x != nil {
+		return x.IsReconciled
+	}
+	return false
+}
+
+func (x *ReconcileAccountResponse) GetPeriods() []*ReconciliationPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *ReconcileAccountResponse) GetFirstIssue() *ReconciliationPeriod {
+	if x != nil {
+		return x.FirstIssue
+	}
+	return nil
+}
+
+type Asset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          AssetKind              `protobuf:"varint,3,opt,name=kind,proto3,enum=fin_aggregator_service.AssetKind" json:"kind,omitempty"`
+	AssetClass    AssetClass             `protobuf:"varint,4,opt,name=asset_class,json=assetClass,proto3,enum=fin_aggregator_service.AssetClass" json:"asset_class,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	OwnerUserId   *int64                 `protobuf:"varint,6,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Asset) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Asset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Asset) GetKind() AssetKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AssetKind_ASSET_KIND_UNSPECIFIED
+}
+
+func (x *Asset) GetAssetClass() AssetClass {
+	if x != nil {
+		return x.AssetClass
+	}
+	return AssetClass_ASSET_CLASS_UNSPECIFIED
+}
+
+func (x *Asset) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Asset) GetOwnerUserId() int64 {
+	if x != nil && x.OwnerUserId != nil {
+		return *x.OwnerUserId
+	}
+	return 0
+}
+
+func (x *Asset) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          AssetKind              `protobuf:"varint,2,opt,name=kind,proto3,enum=fin_aggregator_service.AssetKind" json:"kind,omitempty"`
+	AssetClass    AssetClass             `protobuf:"varint,3,opt,name=asset_class,json=assetClass,proto3,enum=fin_aggregator_service.AssetClass" json:"asset_class,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	OwnerUserId   *int64                 `protobuf:"varint,5,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssetRequest) Reset() {
+	*x = CreateAssetRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssetRequest) ProtoMessage() {}
+
+func (x *CreateAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssetRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssetRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateAssetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAssetRequest) GetKind() AssetKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AssetKind_ASSET_KIND_UNSPECIFIED
+}
+
+func (x *CreateAssetRequest) GetAssetClass() AssetClass {
+	if x != nil {
+		return x.AssetClass
+	}
+	return AssetClass_ASSET_CLASS_UNSPECIFIED
+}
+
+func (x *CreateAssetRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateAssetRequest) GetOwnerUserId() int64 {
+	if x != nil && x.OwnerUserId != nil {
+		return *x.OwnerUserId
+	}
+	return 0
+}
+
+type CreateAssetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *Asset                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssetResponse) Reset() {
+	*x = CreateAssetResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssetResponse) ProtoMessage() {}
+
+func (x *CreateAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssetResponse.ProtoReflect.Descriptor instead.
+func (*CreateAssetResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type UpdateAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       int64                  `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	AssetClass    *AssetClass            `protobuf:"varint,3,opt,name=asset_class,json=assetClass,proto3,enum=fin_aggregator_service.AssetClass,oneof" json:"asset_class,omitempty"`
+	OwnerUserId   *int64                 `protobuf:"varint,4,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssetRequest) Reset() {
+	*x = UpdateAssetRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssetRequest) ProtoMessage() {}
+
+func (x *UpdateAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssetRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateAssetRequest) GetAssetId() int64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+func (x *UpdateAssetRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAssetRequest) GetAssetClass() AssetClass {
+	if x != nil && x.AssetClass != nil {
+		return *x.AssetClass
+	}
+	return AssetClass_ASSET_CLASS_UNSPECIFIED
+}
+
+func (x *UpdateAssetRequest) GetOwnerUserId() int64 {
+	if x != nil && x.OwnerUserId != nil {
+		return *x.OwnerUserId
+	}
+	return 0
+}
+
+type UpdateAssetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *Asset                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssetResponse) Reset() {
+	*x = UpdateAssetResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssetResponse) ProtoMessage() {}
+
+func (x *UpdateAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAssetResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type DeleteAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       int64                  `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssetRequest) Reset() {
+	*x = DeleteAssetRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssetRequest) ProtoMessage() {}
+
+func (x *DeleteAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssetRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteAssetRequest) GetAssetId() int64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+type DeleteAssetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssetResponse) Reset() {
+	*x = DeleteAssetResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssetResponse) ProtoMessage() {}
+
+func (x *DeleteAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAssetResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteAssetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetRequest) Reset() {
+	*x = ListAssetRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetRequest) ProtoMessage() {}
+
+func (x *ListAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetRequest.ProtoReflect.Descriptor instead.
+func (*ListAssetRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListAssetRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type ListAssetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assets        []*Asset               `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetResponse) Reset() {
+	*x = ListAssetResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetResponse) ProtoMessage() {}
+
+func (x *ListAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetResponse.ProtoReflect.Descriptor instead.
+func (*ListAssetResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListAssetResponse) GetAssets() []*Asset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type AssetValuation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssetId       int64                  `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ValuationDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valuation_date,json=valuationDate,proto3" json:"valuation_date,omitempty"`
+	Note          *string                `protobuf:"bytes,5,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetValuation) Reset() {
+	*x = AssetValuation{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetValuation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetValuation) ProtoMessage() {}
+
+func (x *AssetValuation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetValuation.ProtoReflect.Descriptor instead.
+func (*AssetValuation) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AssetValuation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssetValuation) GetAssetId() int64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+func (x *AssetValuation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AssetValuation) GetValuationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValuationDate
+	}
+	return nil
+}
+
+func (x *AssetValuation) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type AddAssetValuationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       int64                  `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ValuationDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valuation_date,json=valuationDate,proto3" json:"valuation_date,omitempty"`
+	Note          *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAssetValuationRequest) Reset() {
+	*x = AddAssetValuationRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAssetValuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAssetValuationRequest) ProtoMessage() {}
+
+func (x *AddAssetValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAssetValuationRequest.ProtoReflect.Descriptor instead.
+func (*AddAssetValuationRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AddAssetValuationRequest) GetAssetId() int64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+func (x *AddAssetValuationRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AddAssetValuationRequest) GetValuationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValuationDate
+	}
+	return nil
+}
+
+func (x *AddAssetValuationRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type AddAssetValuationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valuation     *AssetValuation        `protobuf:"bytes,1,opt,name=valuation,proto3" json:"valuation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAssetValuationResponse) Reset() {
+	*x = AddAssetValuationResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAssetValuationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAssetValuationResponse) ProtoMessage() {}
+
+func (x *AddAssetValuationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAssetValuationResponse.ProtoReflect.Descriptor instead.
+func (*AddAssetValuationResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AddAssetValuationResponse) GetValuation() *AssetValuation {
+	if x != nil {
+		return x.Valuation
+	}
+	return nil
+}
+
+type ListAssetValuationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       int64                  `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetValuationRequest) Reset() {
+	*x = ListAssetValuationRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetValuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetValuationRequest) ProtoMessage() {}
+
+func (x *ListAssetValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetValuationRequest.ProtoReflect.Descriptor instead.
+func (*ListAssetValuationRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListAssetValuationRequest) GetAssetId() int64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+type ListAssetValuationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valuations    []*AssetValuation      `protobuf:"bytes,1,rep,name=valuations,proto3" json:"valuations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetValuationResponse) Reset() {
+	*x = ListAssetValuationResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetValuationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetValuationResponse) ProtoMessage() {}
+
+func (x *ListAssetValuationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetValuationResponse.ProtoReflect.Descriptor instead.
+func (*ListAssetValuationResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListAssetValuationResponse) GetValuations() []*AssetValuation {
+	if x != nil {
+		return x.Valuations
+	}
+	return nil
+}
+
+type AssetClassValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetClass    AssetClass             `protobuf:"varint,1,opt,name=asset_class,json=assetClass,proto3,enum=fin_aggregator_service.AssetClass" json:"asset_class,omitempty"`
+	Kind          AssetKind              `protobuf:"varint,2,opt,name=kind,proto3,enum=fin_aggregator_service.AssetKind" json:"kind,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetClassValue) Reset() {
+	*x = AssetClassValue{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetClassValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetClassValue) ProtoMessage() {}
+
+func (x *AssetClassValue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetClassValue.ProtoReflect.Descriptor instead.
+func (*AssetClassValue) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AssetClassValue) GetAssetClass() AssetClass {
+	if x != nil {
+		return x.AssetClass
+	}
+	return AssetClass_ASSET_CLASS_UNSPECIFIED
+}
+
+func (x *AssetClassValue) GetKind() AssetKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AssetKind_ASSET_KIND_UNSPECIFIED
+}
+
+func (x *AssetClassValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type NetWorthPoint struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Date             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	TotalAssets      string                 `protobuf:"bytes,2,opt,name=total_assets,json=totalAssets,proto3" json:"total_assets,omitempty"`
+	TotalLiabilities string                 `protobuf:"bytes,3,opt,name=total_liabilities,json=totalLiabilities,proto3" json:"total_liabilities,omitempty"`
+	NetWorth         string                 `protobuf:"bytes,4,opt,name=net_worth,json=netWorth,proto3" json:"net_worth,omitempty"`
+	Breakdown        []*AssetClassValue     `protobuf:"bytes,5,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NetWorthPoint) Reset() {
+	*x = NetWorthPoint{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetWorthPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetWorthPoint) ProtoMessage() {}
+
+func (x *NetWorthPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetWorthPoint.ProtoReflect.Descriptor instead.
+func (*NetWorthPoint) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *NetWorthPoint) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *NetWorthPoint) GetTotalAssets() string {
+	if x != nil {
+		return x.TotalAssets
+	}
+	return ""
+}
+
+func (x *NetWorthPoint) GetTotalLiabilities() string {
+	if x != nil {
+		return x.TotalLiabilities
+	}
+	return ""
+}
+
+func (x *NetWorthPoint) GetNetWorth() string {
+	if x != nil {
+		return x.NetWorth
+	}
+	return ""
+}
+
+func (x *NetWorthPoint) GetBreakdown() []*AssetClassValue {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type GetNetWorthHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	UserId        *int64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetWorthHistoryRequest) Reset() {
+	*x = GetNetWorthHistoryRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetWorthHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetWorthHistoryRequest) ProtoMessage() {}
+
+func (x *GetNetWorthHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetWorthHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetNetWorthHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetNetWorthHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetNetWorthHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetNetWorthHistoryRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type GetNetWorthHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*NetWorthPoint       `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetWorthHistoryResponse) Reset() {
+	*x = GetNetWorthHistoryResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetWorthHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetWorthHistoryResponse) ProtoMessage() {}
+
+func (x *GetNetWorthHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetWorthHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetNetWorthHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetNetWorthHistoryResponse) GetPoints() []*NetWorthPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type SavingsGoal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount  string                 `protobuf:"bytes,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	TargetDate    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=target_date,json=targetDate,proto3" json:"target_date,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,6,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	OwnerUserId   *int64                 `protobuf:"varint,8,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavingsGoal) Reset() {
+	*x = SavingsGoal{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavingsGoal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavingsGoal) ProtoMessage() {}
+
+func (x *SavingsGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavingsGoal.ProtoReflect.Descriptor instead.
+func (*SavingsGoal) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SavingsGoal) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavingsGoal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavingsGoal) GetTargetAmount() string {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return ""
+}
+
+func (x *SavingsGoal) GetTargetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetDate
+	}
+	return nil
+}
+
+func (x *SavingsGoal) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *SavingsGoal) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *SavingsGoal) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *SavingsGoal) GetOwnerUserId() int64 {
+	if x != nil && x.OwnerUserId != nil {
+		return *x.OwnerUserId
+	}
+	return 0
+}
+
+func (x *SavingsGoal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateSavingsGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount  string                 `protobuf:"bytes,2,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	TargetDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=target_date,json=targetDate,proto3" json:"target_date,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	OwnerUserId   *int64                 `protobuf:"varint,7,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavingsGoalRequest) Reset() {
+	*x = CreateSavingsGoalRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavingsGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavingsGoalRequest) ProtoMessage() {}
+
+func (x *CreateSavingsGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavingsGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavingsGoalRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateSavingsGoalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavingsGoalRequest) GetTargetAmount() string {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return ""
+}
+
+func (x *CreateSavingsGoalRequest) GetTargetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetDate
+	}
+	return nil
+}
+
+func (x *CreateSavingsGoalRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateSavingsGoalRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *CreateSavingsGoalRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateSavingsGoalRequest) GetOwnerUserId() int64 {
+	if x != nil && x.OwnerUserId != nil {
+		return *x.OwnerUserId
+	}
+	return 0
+}
+
+type CreateSavingsGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *SavingsGoal           `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavingsGoalResponse) Reset() {
+	*x = CreateSavingsGoalResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavingsGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavingsGoalResponse) ProtoMessage() {}
+
+func (x *CreateSavingsGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavingsGoalResponse.ProtoReflect.Descriptor instead.
+func (*CreateSavingsGoalResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreateSavingsGoalResponse) GetGoal() *SavingsGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type UpdateSavingsGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        int64                  `protobuf:"varint,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	TargetAmount  *string                `protobuf:"bytes,3,opt,name=target_amount,json=targetAmount,proto3,oneof" json:"target_amount,omitempty"`
+	TargetDate    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=target_date,json=targetDate,proto3,oneof" json:"target_date,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	OwnerUserId   *int64                 `protobuf:"varint,7,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavingsGoalRequest) Reset() {
+	*x = UpdateSavingsGoalRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavingsGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavingsGoalRequest) ProtoMessage() {}
+
+func (x *UpdateSavingsGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavingsGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavingsGoalRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateSavingsGoalRequest) GetGoalId() int64 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+func (x *UpdateSavingsGoalRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSavingsGoalRequest) GetTargetAmount() string {
+	if x != nil && x.TargetAmount != nil {
+		return *x.TargetAmount
+	}
+	return ""
+}
+
+func (x *UpdateSavingsGoalRequest) GetTargetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetDate
+	}
+	return nil
+}
+
+func (x *UpdateSavingsGoalRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateSavingsGoalRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateSavingsGoalRequest) GetOwnerUserId() int64 {
+	if x != nil && x.OwnerUserId != nil {
+		return *x.OwnerUserId
+	}
+	return 0
+}
+
+type UpdateSavingsGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *SavingsGoal           `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavingsGoalResponse) Reset() {
+	*x = UpdateSavingsGoalResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavingsGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavingsGoalResponse) ProtoMessage() {}
+
+func (x *UpdateSavingsGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavingsGoalResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSavingsGoalResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateSavingsGoalResponse) GetGoal() *SavingsGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type DeleteSavingsGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        int64                  `protobuf:"varint,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavingsGoalRequest) Reset() {
+	*x = DeleteSavingsGoalRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavingsGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavingsGoalRequest) ProtoMessage() {}
+
+func (x *DeleteSavingsGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavingsGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavingsGoalRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DeleteSavingsGoalRequest) GetGoalId() int64 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+type DeleteSavingsGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavingsGoalResponse) Reset() {
+	*x = DeleteSavingsGoalResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavingsGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavingsGoalResponse) ProtoMessage() {}
+
+func (x *DeleteSavingsGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavingsGoalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavingsGoalResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DeleteSavingsGoalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListSavingsGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavingsGoalRequest) Reset() {
+	*x = ListSavingsGoalRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavingsGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavingsGoalRequest) ProtoMessage() {}
+
+func (x *ListSavingsGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavingsGoalRequest.ProtoReflect.Descriptor instead.
+func (*ListSavingsGoalRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListSavingsGoalRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type ListSavingsGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*SavingsGoal         `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavingsGoalResponse) Reset() {
+	*x = ListSavingsGoalResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavingsGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavingsGoalResponse) ProtoMessage() {}
+
+func (x *ListSavingsGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavingsGoalResponse.ProtoReflect.Descriptor instead.
+func (*ListSavingsGoalResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListSavingsGoalResponse) GetGoals() []*SavingsGoal {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type GetSavingsGoalStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        int64                  `protobuf:"varint,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavingsGoalStatusRequest) Reset() {
+	*x = GetSavingsGoalStatusRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavingsGoalStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavingsGoalStatusRequest) ProtoMessage() {}
+
+func (x *GetSavingsGoalStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavingsGoalStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSavingsGoalStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetSavingsGoalStatusRequest) GetGoalId() int64 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+type GetSavingsGoalStatusResponse struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	Goal                        *SavingsGoal           `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	SavedAmount                 string                 `protobuf:"bytes,2,opt,name=saved_amount,json=savedAmount,proto3" json:"saved_amount,omitempty"`
+	RemainingAmount             string                 `protobuf:"bytes,3,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	PercentComplete             float64                `protobuf:"fixed64,4,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	MonthsRemaining             int32                  `protobuf:"varint,5,opt,name=months_remaining,json=monthsRemaining,proto3" json:"months_remaining,omitempty"`
+	RequiredMonthlyContribution string                 `protobuf:"bytes,6,opt,name=required_monthly_contribution,json=requiredMonthlyContribution,proto3" json:"required_monthly_contribution,omitempty"`
+	AverageMonthlyContribution  string                 `protobuf:"bytes,7,opt,name=average_monthly_contribution,json=averageMonthlyContribution,proto3" json:"average_monthly_contribution,omitempty"`
+	ProjectedAmount             string                 `protobuf:"bytes,8,opt,name=projected_amount,json=projectedAmount,proto3" json:"projected_amount,omitempty"`
+	Status                      SavingsGoalStatus      `protobuf:"varint,9,opt,name=status,proto3,enum=fin_aggregator_service.SavingsGoalStatus" json:"status,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *GetSavingsGoalStatusResponse) Reset() {
+	*x = GetSavingsGoalStatusResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavingsGoalStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavingsGoalStatusResponse) ProtoMessage() {}
+
+func (x *GetSavingsGoalStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavingsGoalStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSavingsGoalStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetSavingsGoalStatusResponse) GetGoal() *SavingsGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *GetSavingsGoalStatusResponse) GetSavedAmount() string {
+	if x != nil {
+		return x.SavedAmount
+	}
+	return ""
+}
+
+func (x *GetSavingsGoalStatusResponse) GetRemainingAmount() string {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return ""
+}
+
+func (x *GetSavingsGoalStatusResponse) GetPercentComplete() float64 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+func (x *GetSavingsGoalStatusResponse) GetMonthsRemaining() int32 {
+	if x != nil {
+		return x.MonthsRemaining
+	}
+	return 0
+}
+
+func (x *GetSavingsGoalStatusResponse) GetRequiredMonthlyContribution() string {
+	if x != nil {
+		return x.RequiredMonthlyContribution
+	}
+	return ""
+}
+
+func (x *GetSavingsGoalStatusResponse) GetAverageMonthlyContribution() string {
+	if x != nil {
+		return x.AverageMonthlyContribution
+	}
+	return ""
+}
+
+func (x *GetSavingsGoalStatusResponse) GetProjectedAmount() string {
+	if x != nil {
+		return x.ProjectedAmount
+	}
+	return ""
+}
+
+func (x *GetSavingsGoalStatusResponse) GetStatus() SavingsGoalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SavingsGoalStatus_SAVINGS_GOAL_STATUS_UNSPECIFIED
+}
+
+type GenerateReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        ReportPeriod           `protobuf:"varint,1,opt,name=period,proto3,enum=fin_aggregator_service.ReportPeriod" json:"period,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	Format        ReportFormat           `protobuf:"varint,4,opt,name=format,proto3,enum=fin_aggregator_service.ReportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateReportRequest) Reset() {
+	*x = GenerateReportRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateReportRequest) ProtoMessage() {}
+
+func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GenerateReportRequest) GetPeriod() ReportPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ReportPeriod_REPORT_PERIOD_UNSPECIFIED
+}
+
+func (x *GenerateReportRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GenerateReportRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *GenerateReportRequest) GetFormat() ReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReportFormat_REPORT_FORMAT_UNSPECIFIED
+}
+
+type AlertRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RuleType      AlertRuleType          `protobuf:"varint,3,opt,name=rule_type,json=ruleType,proto3,enum=fin_aggregator_service.AlertRuleType" json:"rule_type,omitempty"`
+	UserId        *int64                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Threshold     *string                `protobuf:"bytes,7,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	Period        AlertPeriod            `protobuf:"varint,8,opt,name=period,proto3,enum=fin_aggregator_service.AlertPeriod" json:"period,omitempty"`
+	Channel       AlertChannel           `protobuf:"varint,9,opt,name=channel,proto3,enum=fin_aggregator_service.AlertChannel" json:"channel,omitempty"`
+	Target        string                 `protobuf:"bytes,10,opt,name=target,proto3" json:"target,omitempty"`
+	Enabled       bool                   `protobuf:"varint,11,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AlertRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetRuleType() AlertRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return AlertRuleType_ALERT_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *AlertRule) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *AlertRule) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *AlertRule) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *AlertRule) GetThreshold() string {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return ""
+}
+
+func (x *AlertRule) GetPeriod() AlertPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return AlertPeriod_ALERT_PERIOD_UNSPECIFIED
+}
+
+func (x *AlertRule) GetChannel() AlertChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return AlertChannel_ALERT_CHANNEL_UNSPECIFIED
+}
+
+func (x *AlertRule) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AlertRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AlertRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Alert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        int64                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,4,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Delivered     bool                   `protobuf:"varint,5,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	DeliveryError *string                `protobuf:"bytes,6,opt,name=delivery_error,json=deliveryError,proto3,oneof" json:"delivery_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Alert) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Alert) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *Alert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alert) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *Alert) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *Alert) GetDeliveryError() string {
+	if x != nil && x.DeliveryError != nil {
+		return *x.DeliveryError
+	}
+	return ""
+}
+
+func (x *Alert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RuleType      AlertRuleType          `protobuf:"varint,2,opt,name=rule_type,json=ruleType,proto3,enum=fin_aggregator_service.AlertRuleType" json:"rule_type,omitempty"`
+	UserId        *int64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,4,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Threshold     *string                `protobuf:"bytes,6,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	Period        AlertPeriod            `protobuf:"varint,7,opt,name=period,proto3,enum=fin_aggregator_service.AlertPeriod" json:"period,omitempty"`
+	Channel       AlertChannel           `protobuf:"varint,8,opt,name=channel,proto3,enum=fin_aggregator_service.AlertChannel" json:"channel,omitempty"`
+	Target        string                 `protobuf:"bytes,9,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *CreateAlertRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetRuleType() AlertRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return AlertRuleType_ALERT_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAlertRuleRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetThreshold() string {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetPeriod() AlertPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return AlertPeriod_ALERT_PERIOD_UNSPECIFIED
+}
+
+func (x *CreateAlertRuleRequest) GetChannel() AlertChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return AlertChannel_ALERT_CHANNEL_UNSPECIFIED
+}
+
+func (x *CreateAlertRuleRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type CreateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleResponse) Reset() {
+	*x = CreateAlertRuleResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleResponse) ProtoMessage() {}
+
+func (x *CreateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Threshold     *string                `protobuf:"bytes,3,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	Period        *AlertPeriod           `protobuf:"varint,4,opt,name=period,proto3,enum=fin_aggregator_service.AlertPeriod,oneof" json:"period,omitempty"`
+	Channel       *AlertChannel          `protobuf:"varint,5,opt,name=channel,proto3,enum=fin_aggregator_service.AlertChannel,oneof" json:"channel,omitempty"`
+	Target        *string                `protobuf:"bytes,6,opt,name=target,proto3,oneof" json:"target,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,7,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleRequest) Reset() {
+	*x = UpdateAlertRuleRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleRequest) ProtoMessage() {}
+
+func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UpdateAlertRuleRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *UpdateAlertRuleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAlertRuleRequest) GetThreshold() string {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return ""
+}
+
+func (x *UpdateAlertRuleRequest) GetPeriod() AlertPeriod {
+	if x != nil && x.Period != nil {
+		return *x.Period
+	}
+	return AlertPeriod_ALERT_PERIOD_UNSPECIFIED
+}
+
+func (x *UpdateAlertRuleRequest) GetChannel() AlertChannel {
+	if x != nil && x.Channel != nil {
+		return *x.Channel
+	}
+	return AlertChannel_ALERT_CHANNEL_UNSPECIFIED
+}
+
+func (x *UpdateAlertRuleRequest) GetTarget() string {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return ""
+}
+
+func (x *UpdateAlertRuleRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type UpdateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleResponse) Reset() {
+	*x = UpdateAlertRuleResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleResponse) ProtoMessage() {}
+
+func (x *UpdateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DeleteAlertRuleRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DeleteAlertRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRuleRequest) Reset() {
+	*x = ListAlertRuleRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRuleRequest) ProtoMessage() {}
+
+func (x *ListAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListAlertRuleRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type ListAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRuleResponse) Reset() {
+	*x = ListAlertRuleResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRuleResponse) ProtoMessage() {}
+
+func (x *ListAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListAlertRuleResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type TestAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestAlertRuleRequest) Reset() {
+	*x = TestAlertRuleRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAlertRuleRequest) ProtoMessage() {}
+
+func (x *TestAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*TestAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *TestAlertRuleRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type TestAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestAlertRuleResponse) Reset() {
+	*x = TestAlertRuleResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAlertRuleResponse) ProtoMessage() {}
+
+func (x *TestAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*TestAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *TestAlertRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *int64                 `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"`
+	Limit         *uint64                `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRequest) Reset() {
+	*x = ListAlertRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRequest) ProtoMessage() {}
+
+func (x *ListAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListAlertRequest) GetRuleId() int64 {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return 0
+}
+
+func (x *ListAlertRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertResponse) Reset() {
+	*x = ListAlertResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertResponse) ProtoMessage() {}
+
+func (x *ListAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListAlertResponse) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type WebhookSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *WebhookSubscription) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WebhookSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId int64                  `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload        string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status         WebhookDeliveryStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=fin_aggregator_service.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastStatusCode *int32                 `protobuf:"varint,9,opt,name=last_status_code,json=lastStatusCode,proto3,oneof" json:"last_status_code,omitempty"`
+	LastError      *string                `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *WebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil && x.LastStatusCode != nil {
+		return *x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Secret        *string                `protobuf:"bytes,4,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookSubscriptionResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Subscription *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// secret signs the deliveries; it is only returned on creation.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionResponse) Reset() {
+	*x = CreateWebhookSubscriptionResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type UpdateWebhookSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int64                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Url            *string                `protobuf:"bytes,2,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	EventTypes     []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Enabled        *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookSubscriptionRequest) Reset() {
+	*x = UpdateWebhookSubscriptionRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type UpdateWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookSubscriptionResponse) Reset() {
+	*x = UpdateWebhookSubscriptionResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *UpdateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int64                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *DeleteWebhookSubscriptionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionRequest) Reset() {
+	*x = ListWebhookSubscriptionRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{128}
+}
+
+type ListWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionResponse) Reset() {
+	*x = ListWebhookSubscriptionResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListWebhookSubscriptionResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type ListWebhookDeliveryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId *int64                 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
+	Status         WebhookDeliveryStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=fin_aggregator_service.WebhookDeliveryStatus" json:"status,omitempty"`
+	Limit          *uint64                `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveryRequest) Reset() {
+	*x = ListWebhookDeliveryRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListWebhookDeliveryRequest) GetSubscriptionId() int64 {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveryRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *ListWebhookDeliveryRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveryResponse) Reset() {
+	*x = ListWebhookDeliveryResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListWebhookDeliveryResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    int64                  `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() int64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type CategorizationCondition struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Type          CategorizationConditionType `protobuf:"varint,1,opt,name=type,proto3,enum=fin_aggregator_service.CategorizationConditionType" json:"type,omitempty"`
+	Value         *string                     `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	MinAmount     *string                     `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount     *string                     `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	BankId        *int64                      `protobuf:"varint,5,opt,name=bank_id,json=bankId,proto3,oneof" json:"bank_id,omitempty"`
+	UserId        *int64                      `protobuf:"varint,6,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Sign          AmountSign                  `protobuf:"varint,7,opt,name=sign,proto3,enum=fin_aggregator_service.AmountSign" json:"sign,omitempty"`
+	FromDay       *int32                      `protobuf:"varint,8,opt,name=from_day,json=fromDay,proto3,oneof" json:"from_day,omitempty"`
+	ToDay         *int32                      `protobuf:"varint,9,opt,name=to_day,json=toDay,proto3,oneof" json:"to_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorizationCondition) Reset() {
+	*x = CategorizationCondition{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorizationCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorizationCondition) ProtoMessage() {}
+
+func (x *CategorizationCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorizationCondition.ProtoReflect.Descriptor instead.
+func (*CategorizationCondition) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *CategorizationCondition) GetType() CategorizationConditionType {
+	if x != nil {
+		return x.Type
+	}
+	return CategorizationConditionType_CATEGORIZATION_CONDITION_TYPE_UNSPECIFIED
+}
+
+func (x *CategorizationCondition) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *CategorizationCondition) GetMinAmount() string {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return ""
+}
+
+func (x *CategorizationCondition) GetMaxAmount() string {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return ""
+}
+
+func (x *CategorizationCondition) GetBankId() int64 {
+	if x != nil && x.BankId != nil {
+		return *x.BankId
+	}
+	return 0
+}
+
+func (x *CategorizationCondition) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *CategorizationCondition) GetSign() AmountSign {
+	if x != nil {
+		return x.Sign
+	}
+	return AmountSign_AMOUNT_SIGN_UNSPECIFIED
+}
+
+func (x *CategorizationCondition) GetFromDay() int32 {
+	if x != nil && x.FromDay != nil {
+		return *x.FromDay
+	}
+	return 0
+}
+
+func (x *CategorizationCondition) GetToDay() int32 {
+	if x != nil && x.ToDay != nil {
+		return *x.ToDay
+	}
+	return 0
+}
+
+type CategorizationActions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    *int64                 `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Type          *TransactionType       `protobuf:"varint,2,opt,name=type,proto3,enum=fin_aggregator_service.TransactionType,oneof" json:"type,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Merchant      *string                `protobuf:"bytes,4,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorizationActions) Reset() {
+	*x = CategorizationActions{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorizationActions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorizationActions) ProtoMessage() {}
+
+func (x *CategorizationActions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorizationActions.ProtoReflect.Descriptor instead.
+func (*CategorizationActions) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *CategorizationActions) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategorizationActions) GetType() TransactionType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return TransactionType_UNSPECIFIED
+}
+
+func (x *CategorizationActions) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CategorizationActions) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+type CategorizationRule struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Id            int64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Priority      int32                      `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Conditions    []*CategorizationCondition `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Actions       *CategorizationActions     `protobuf:"bytes,5,opt,name=actions,proto3" json:"actions,omitempty"`
+	Enabled       bool                       `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp     `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	HitCount      int64                      `protobuf:"varint,8,opt,name=hit_count,json=hitCount,proto3" json:"hit_count,omitempty"`
+	LastHitAt     *timestamppb.Timestamp     `protobuf:"bytes,9,opt,name=last_hit_at,json=lastHitAt,proto3,oneof" json:"last_hit_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorizationRule) Reset() {
+	*x = CategorizationRule{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorizationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorizationRule) ProtoMessage() {}
+
+func (x *CategorizationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorizationRule.ProtoReflect.Descriptor instead.
+func (*CategorizationRule) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CategorizationRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategorizationRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategorizationRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CategorizationRule) GetConditions() []*CategorizationCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *CategorizationRule) GetActions() *CategorizationActions {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *CategorizationRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *CategorizationRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CategorizationRule) GetHitCount() int64 {
+	if x != nil {
+		return x.HitCount
+	}
+	return 0
+}
+
+func (x *CategorizationRule) GetLastHitAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHitAt
+	}
+	return nil
+}
+
+type CreateCategorizationRuleRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Name          string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Priority      *int32                     `protobuf:"varint,2,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	Conditions    []*CategorizationCondition `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Actions       *CategorizationActions     `protobuf:"bytes,4,opt,name=actions,proto3" json:"actions,omitempty"`
+	Enabled       *bool                      `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategorizationRuleRequest) Reset() {
+	*x = CreateCategorizationRuleRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategorizationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategorizationRuleRequest) ProtoMessage() {}
+
+func (x *CreateCategorizationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategorizationRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategorizationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CreateCategorizationRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategorizationRuleRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *CreateCategorizationRuleRequest) GetConditions() []*CategorizationCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *CreateCategorizationRuleRequest) GetActions() *CategorizationActions {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *CreateCategorizationRuleRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type CreateCategorizationRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *CategorizationRule    `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategorizationRuleResponse) Reset() {
+	*x = CreateCategorizationRuleResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategorizationRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategorizationRuleResponse) ProtoMessage() {}
+
+func (x *CreateCategorizationRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategorizationRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategorizationRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *CreateCategorizationRuleResponse) GetRule() *CategorizationRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateCategorizationRuleRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	RuleId        int64                      `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Name          *string                    `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Priority      *int32                     `protobuf:"varint,3,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	Conditions    []*CategorizationCondition `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Actions       *CategorizationActions     `protobuf:"bytes,5,opt,name=actions,proto3,oneof" json:"actions,omitempty"`
+	Enabled       *bool                      `protobuf:"varint,6,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategorizationRuleRequest) Reset() {
+	*x = UpdateCategorizationRuleRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategorizationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategorizationRuleRequest) ProtoMessage() {}
+
+func (x *UpdateCategorizationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategorizationRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategorizationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *UpdateCategorizationRuleRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *UpdateCategorizationRuleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategorizationRuleRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *UpdateCategorizationRuleRequest) GetConditions() []*CategorizationCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *UpdateCategorizationRuleRequest) GetActions() *CategorizationActions {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *UpdateCategorizationRuleRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type UpdateCategorizationRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *CategorizationRule    `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategorizationRuleResponse) Reset() {
+	*x = UpdateCategorizationRuleResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategorizationRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategorizationRuleResponse) ProtoMessage() {}
+
+func (x *UpdateCategorizationRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategorizationRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategorizationRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *UpdateCategorizationRuleResponse) GetRule() *CategorizationRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteCategorizationRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategorizationRuleRequest) Reset() {
+	*x = DeleteCategorizationRuleRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategorizationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategorizationRuleRequest) ProtoMessage() {}
+
+func (x *DeleteCategorizationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategorizationRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategorizationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *DeleteCategorizationRuleRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type DeleteCategorizationRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategorizationRuleResponse) Reset() {
+	*x = DeleteCategorizationRuleResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategorizationRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategorizationRuleResponse) ProtoMessage() {}
+
+func (x *DeleteCategorizationRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategorizationRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategorizationRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *DeleteCategorizationRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListCategorizationRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_hit_count lists weak rules that matched at most this many transactions.
+	MaxHitCount   *int64 `protobuf:"varint,1,opt,name=max_hit_count,json=maxHitCount,proto3,oneof" json:"max_hit_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategorizationRuleRequest) Reset() {
+	*x = ListCategorizationRuleRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategorizationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategorizationRuleRequest) ProtoMessage() {}
+
+func (x *ListCategorizationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategorizationRuleRequest.ProtoReflect.Descriptor instead.
+func (*ListCategorizationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ListCategorizationRuleRequest) GetMaxHitCount() int64 {
+	if x != nil && x.MaxHitCount != nil {
+		return *x.MaxHitCount
+	}
+	return 0
+}
+
+type ListCategorizationRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CategorizationRule  `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategorizationRuleResponse) Reset() {
+	*x = ListCategorizationRuleResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategorizationRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategorizationRuleResponse) ProtoMessage() {}
+
+func (x *ListCategorizationRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategorizationRuleResponse.ProtoReflect.Descriptor instead.
+func (*ListCategorizationRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ListCategorizationRuleResponse) GetRules() []*CategorizationRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CategoryKeyword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        *int64                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryKeyword) Reset() {
+	*x = CategoryKeyword{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryKeyword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryKeyword) ProtoMessage() {}
+
+func (x *CategoryKeyword) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryKeyword.ProtoReflect.Descriptor instead.
+func (*CategoryKeyword) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *CategoryKeyword) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryKeyword) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryKeyword) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryKeyword) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type CreateCategoryRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ParentId    *int64                 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// kind defaults to the kind of the parent, or expense for top-level categories.
+	Kind CategoryKind `protobuf:"varint,4,opt,name=kind,proto3,enum=fin_aggregator_service.CategoryKind" json:"kind,omitempty"`
+	// user_id creates a category only the user sees.
+	UserId        *int64 `protobuf:"varint,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetKind() CategoryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CategoryKind_CATEGORY_KIND_UNSPECIFIED
+}
+
+func (x *CreateCategoryRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId  int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Archived    *bool                  `protobuf:"varint,4,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
+	// parent_id 0 makes the category a top-level category.
+	ParentId      *int64        `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Kind          *CategoryKind `protobuf:"varint,6,opt,name=kind,proto3,enum=fin_aggregator_service.CategoryKind,oneof" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *UpdateCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+func (x *UpdateCategoryRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetKind() CategoryKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return CategoryKind_CATEGORY_KIND_UNSPECIFIED
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId           int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ReassignToCategoryId *int64                 `protobuf:"varint,2,opt,name=reassign_to_category_id,json=reassignToCategoryId,proto3,oneof" json:"reassign_to_category_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *DeleteCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetReassignToCategoryId() int64 {
+	if x != nil && x.ReassignToCategoryId != nil {
+		return *x.ReassignToCategoryId
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Success                bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ReassignedTransactions int64                  `protobuf:"varint,2,opt,name=reassigned_transactions,json=reassignedTransactions,proto3" json:"reassigned_transactions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *DeleteCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteCategoryResponse) GetReassignedTransactions() int64 {
+	if x != nil {
+		return x.ReassignedTransactions
+	}
+	return 0
+}
+
+type MergeCategoryRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId       int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TargetCategoryId int64                  `protobuf:"varint,2,opt,name=target_category_id,json=targetCategoryId,proto3" json:"target_category_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MergeCategoryRequest) Reset() {
+	*x = MergeCategoryRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCategoryRequest) ProtoMessage() {}
+
+func (x *MergeCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MergeCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *MergeCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *MergeCategoryRequest) GetTargetCategoryId() int64 {
+	if x != nil {
+		return x.TargetCategoryId
+	}
+	return 0
+}
+
+type MergeCategoryResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Success                bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ReassignedTransactions int64                  `protobuf:"varint,2,opt,name=reassigned_transactions,json=reassignedTransactions,proto3" json:"reassigned_transactions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MergeCategoryResponse) Reset() {
+	*x = MergeCategoryResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCategoryResponse) ProtoMessage() {}
+
+func (x *MergeCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCategoryResponse.ProtoReflect.Descriptor instead.
+func (*MergeCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *MergeCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MergeCategoryResponse) GetReassignedTransactions() int64 {
+	if x != nil {
+		return x.ReassignedTransactions
+	}
+	return 0
+}
+
+type ListCategoryKeywordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryKeywordRequest) Reset() {
+	*x = ListCategoryKeywordRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryKeywordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryKeywordRequest) ProtoMessage() {}
+
+func (x *ListCategoryKeywordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryKeywordRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryKeywordRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *ListCategoryKeywordRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type ListCategoryKeywordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keywords      []*CategoryKeyword     `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryKeywordResponse) Reset() {
+	*x = ListCategoryKeywordResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryKeywordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryKeywordResponse) ProtoMessage() {}
+
+func (x *ListCategoryKeywordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryKeywordResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryKeywordResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ListCategoryKeywordResponse) GetKeywords() []*CategoryKeyword {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type AddCategoryKeywordRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// user_id scopes the keyword to the transactions of the user, overriding a global keyword with the same name.
+	// Keywords of user categories always belong to the owner.
+	UserId        *int64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCategoryKeywordRequest) Reset() {
+	*x = AddCategoryKeywordRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCategoryKeywordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryKeywordRequest) ProtoMessage() {}
+
+func (x *AddCategoryKeywordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryKeywordRequest.ProtoReflect.Descriptor instead.
+func (*AddCategoryKeywordRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *AddCategoryKeywordRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AddCategoryKeywordRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddCategoryKeywordRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type AddCategoryKeywordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       *CategoryKeyword       `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCategoryKeywordResponse) Reset() {
+	*x = AddCategoryKeywordResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCategoryKeywordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryKeywordResponse) ProtoMessage() {}
+
+func (x *AddCategoryKeywordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryKeywordResponse.ProtoReflect.Descriptor instead.
+func (*AddCategoryKeywordResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *AddCategoryKeywordResponse) GetKeyword() *CategoryKeyword {
+	if x != nil {
+		return x.Keyword
+	}
+	return nil
+}
+
+type DeleteCategoryKeywordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeywordId     int64                  `protobuf:"varint,1,opt,name=keyword_id,json=keywordId,proto3" json:"keyword_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryKeywordRequest) Reset() {
+	*x = DeleteCategoryKeywordRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryKeywordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryKeywordRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryKeywordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryKeywordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryKeywordRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *DeleteCategoryKeywordRequest) GetKeywordId() int64 {
+	if x != nil {
+		return x.KeywordId
+	}
+	return 0
+}
+
+type DeleteCategoryKeywordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryKeywordResponse) Reset() {
+	*x = DeleteCategoryKeywordResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryKeywordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryKeywordResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryKeywordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryKeywordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryKeywordResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *DeleteCategoryKeywordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CategorySuggestion struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pattern       string                   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	CategoryId    int64                    `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TransactionId int64                    `protobuf:"varint,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Description   string                   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Occurrences   int32                    `protobuf:"varint,6,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	Status        CategorySuggestionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fin_aggregator_service.CategorySuggestionStatus" json:"status,omitempty"`
+	Kind          CategorySuggestionKind   `protobuf:"varint,8,opt,name=kind,proto3,enum=fin_aggregator_service.CategorySuggestionKind" json:"kind,omitempty"`
+	KeywordId     *int64                   `protobuf:"varint,9,opt,name=keyword_id,json=keywordId,proto3,oneof" json:"keyword_id,omitempty"`
+	RuleId        *int64                   `protobuf:"varint,10,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorySuggestion) Reset() {
+	*x = CategorySuggestion{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorySuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorySuggestion) ProtoMessage() {}
+
+func (x *CategorySuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorySuggestion.ProtoReflect.Descriptor instead.
+func (*CategorySuggestion) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *CategorySuggestion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategorySuggestion) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *CategorySuggestion) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategorySuggestion) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *CategorySuggestion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CategorySuggestion) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *CategorySuggestion) GetStatus() CategorySuggestionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_UNSPECIFIED
+}
+
+func (x *CategorySuggestion) GetKind() CategorySuggestionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_UNSPECIFIED
+}
+
+func (x *CategorySuggestion) GetKeywordId() int64 {
+	if x != nil && x.KeywordId != nil {
+		return *x.KeywordId
+	}
+	return 0
+}
+
+func (x *CategorySuggestion) GetRuleId() int64 {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return 0
+}
+
+func (x *CategorySuggestion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCategorySuggestionRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        CategorySuggestionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=fin_aggregator_service.CategorySuggestionStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategorySuggestionRequest) Reset() {
+	*x = ListCategorySuggestionRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategorySuggestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategorySuggestionRequest) ProtoMessage() {}
+
+func (x *ListCategorySuggestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategorySuggestionRequest.ProtoReflect.Descriptor instead.
+func (*ListCategorySuggestionRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ListCategorySuggestionRequest) GetStatus() CategorySuggestionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CategorySuggestionStatus_CATEGORY_SUGGESTION_STATUS_UNSPECIFIED
+}
+
+type ListCategorySuggestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*CategorySuggestion  `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategorySuggestionResponse) Reset() {
+	*x = ListCategorySuggestionResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategorySuggestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategorySuggestionResponse) ProtoMessage() {}
+
+func (x *ListCategorySuggestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategorySuggestionResponse.ProtoReflect.Descriptor instead.
+func (*ListCategorySuggestionResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ListCategorySuggestionResponse) GetSuggestions() []*CategorySuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type AcceptCategorySuggestionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SuggestionId int64                  `protobuf:"varint,1,opt,name=suggestion_id,json=suggestionId,proto3" json:"suggestion_id,omitempty"`
+	// kind defaults to a category keyword.
+	Kind               CategorySuggestionKind `protobuf:"varint,2,opt,name=kind,proto3,enum=fin_aggregator_service.CategorySuggestionKind" json:"kind,omitempty"`
+	Pattern            *string                `protobuf:"bytes,3,opt,name=pattern,proto3,oneof" json:"pattern,omitempty"`
+	ApplyRetroactively bool                   `protobuf:"varint,4,opt,name=apply_retroactively,json=applyRetroactively,proto3" json:"apply_retroactively,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AcceptCategorySuggestionRequest) Reset() {
+	*x = AcceptCategorySuggestionRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCategorySuggestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCategorySuggestionRequest) ProtoMessage() {}
+
+func (x *AcceptCategorySuggestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCategorySuggestionRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCategorySuggestionRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *AcceptCategorySuggestionRequest) GetSuggestionId() int64 {
+	if x != nil {
+		return x.SuggestionId
+	}
+	return 0
+}
+
+func (x *AcceptCategorySuggestionRequest) GetKind() CategorySuggestionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CategorySuggestionKind_CATEGORY_SUGGESTION_KIND_UNSPECIFIED
+}
+
+func (x *AcceptCategorySuggestionRequest) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
+func (x *AcceptCategorySuggestionRequest) GetApplyRetroactively() bool {
+	if x != nil {
+		return x.ApplyRetroactively
+	}
+	return false
+}
+
+type AcceptCategorySuggestionResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Suggestion             *CategorySuggestion    `protobuf:"bytes,1,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	ReassignedTransactions int64                  `protobuf:"varint,2,opt,name=reassigned_transactions,json=reassignedTransactions,proto3" json:"reassigned_transactions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AcceptCategorySuggestionResponse) Reset() {
+	*x = AcceptCategorySuggestionResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCategorySuggestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCategorySuggestionResponse) ProtoMessage() {}
+
+func (x *AcceptCategorySuggestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCategorySuggestionResponse.ProtoReflect.Descriptor instead.
+func (*AcceptCategorySuggestionResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *AcceptCategorySuggestionResponse) GetSuggestion() *CategorySuggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+func (x *AcceptCategorySuggestionResponse) GetReassignedTransactions() int64 {
+	if x != nil {
+		return x.ReassignedTransactions
+	}
+	return 0
+}
+
+type RejectCategorySuggestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SuggestionId  int64                  `protobuf:"varint,1,opt,name=suggestion_id,json=suggestionId,proto3" json:"suggestion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectCategorySuggestionRequest) Reset() {
+	*x = RejectCategorySuggestionRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCategorySuggestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCategorySuggestionRequest) ProtoMessage() {}
+
+func (x *RejectCategorySuggestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCategorySuggestionRequest.ProtoReflect.Descriptor instead.
+func (*RejectCategorySuggestionRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *RejectCategorySuggestionRequest) GetSuggestionId() int64 {
+	if x != nil {
+		return x.SuggestionId
+	}
+	return 0
+}
+
+type RejectCategorySuggestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestion    *CategorySuggestion    `protobuf:"bytes,1,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectCategorySuggestionResponse) Reset() {
+	*x = RejectCategorySuggestionResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCategorySuggestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCategorySuggestionResponse) ProtoMessage() {}
+
+func (x *RejectCategorySuggestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCategorySuggestionResponse.ProtoReflect.Descriptor instead.
+func (*RejectCategorySuggestionResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *RejectCategorySuggestionResponse) GetSuggestion() *CategorySuggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+type CategorizationModel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SampleCount   int32                  `protobuf:"varint,1,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	CategoryCount int32                  `protobuf:"varint,2,opt,name=category_count,json=categoryCount,proto3" json:"category_count,omitempty"`
+	TrainedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=trained_at,json=trainedAt,proto3" json:"trained_at,omitempty"`
+	MinConfidence float64                `protobuf:"fixed64,4,opt,name=min_confidence,json=minConfidence,proto3" json:"min_confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorizationModel) Reset() {
+	*x = CategorizationModel{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorizationModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorizationModel) ProtoMessage() {}
+
+func (x *CategorizationModel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorizationModel.ProtoReflect.Descriptor instead.
+func (*CategorizationModel) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *CategorizationModel) GetSampleCount() int32 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+func (x *CategorizationModel) GetCategoryCount() int32 {
+	if x != nil {
+		return x.CategoryCount
+	}
+	return 0
+}
+
+func (x *CategorizationModel) GetTrainedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TrainedAt
+	}
+	return nil
+}
+
+func (x *CategorizationModel) GetMinConfidence() float64 {
+	if x != nil {
+		return x.MinConfidence
+	}
+	return 0
+}
+
+type TrainCategorizationModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrainCategorizationModelRequest) Reset() {
+	*x = TrainCategorizationModelRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainCategorizationModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainCategorizationModelRequest) ProtoMessage() {}
+
+func (x *TrainCategorizationModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainCategorizationModelRequest.ProtoReflect.Descriptor instead.
+func (*TrainCategorizationModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{168}
+}
+
+type TrainCategorizationModelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *CategorizationModel   `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrainCategorizationModelResponse) Reset() {
+	*x = TrainCategorizationModelResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainCategorizationModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainCategorizationModelResponse) ProtoMessage() {}
+
+func (x *TrainCategorizationModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainCategorizationModelResponse.ProtoReflect.Descriptor instead.
+func (*TrainCategorizationModelResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *TrainCategorizationModelResponse) GetModel() *CategorizationModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+type GetCategorizationModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategorizationModelRequest) Reset() {
+	*x = GetCategorizationModelRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategorizationModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategorizationModelRequest) ProtoMessage() {}
+
+func (x *GetCategorizationModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategorizationModelRequest.ProtoReflect.Descriptor instead.
+func (*GetCategorizationModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{170}
+}
+
+type GetCategorizationModelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// model is unset until the first training.
+	Model         *CategorizationModel `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategorizationModelResponse) Reset() {
+	*x = GetCategorizationModelResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategorizationModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategorizationModelResponse) ProtoMessage() {}
+
+func (x *GetCategorizationModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategorizationModelResponse.ProtoReflect.Descriptor instead.
+func (*GetCategorizationModelResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *GetCategorizationModelResponse) GetModel() *CategorizationModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+type CategoryPrediction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryPrediction) Reset() {
+	*x = CategoryPrediction{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryPrediction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPrediction) ProtoMessage() {}
+
+func (x *CategoryPrediction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPrediction.ProtoReflect.Descriptor instead.
+func (*CategoryPrediction) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *CategoryPrediction) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryPrediction) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryPrediction) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type ListTransactionCategoryPredictionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionCategoryPredictionRequest) Reset() {
+	*x = ListTransactionCategoryPredictionRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionCategoryPredictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionCategoryPredictionRequest) ProtoMessage() {}
+
+func (x *ListTransactionCategoryPredictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionCategoryPredictionRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionCategoryPredictionRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ListTransactionCategoryPredictionRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *ListTransactionCategoryPredictionRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListTransactionCategoryPredictionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Predictions   []*CategoryPrediction  `protobuf:"bytes,1,rep,name=predictions,proto3" json:"predictions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionCategoryPredictionResponse) Reset() {
+	*x = ListTransactionCategoryPredictionResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionCategoryPredictionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionCategoryPredictionResponse) ProtoMessage() {}
+
+func (x *ListTransactionCategoryPredictionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionCategoryPredictionResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionCategoryPredictionResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ListTransactionCategoryPredictionResponse) GetPredictions() []*CategoryPrediction {
+	if x != nil {
+		return x.Predictions
+	}
+	return nil
+}
+
+type RecategorizeTransactionsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	From               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                 *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	BankId             *int64                 `protobuf:"varint,3,opt,name=bank_id,json=bankId,proto3,oneof" json:"bank_id,omitempty"`
+	UserId             *int64                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	AccountId          *int64                 `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	CategoryIds        []int64                `protobuf:"varint,6,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	SkipManuallyEdited bool                   `protobuf:"varint,7,opt,name=skip_manually_edited,json=skipManuallyEdited,proto3" json:"skip_manually_edited,omitempty"`
+	DryRun             bool                   `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RecategorizeTransactionsRequest) Reset() {
+	*x = RecategorizeTransactionsRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecategorizeTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecategorizeTransactionsRequest) ProtoMessage() {}
+
+func (x *RecategorizeTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecategorizeTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*RecategorizeTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *RecategorizeTransactionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RecategorizeTransactionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RecategorizeTransactionsRequest) GetBankId() int64 {
+	if x != nil && x.BankId != nil {
+		return *x.BankId
+	}
+	return 0
+}
+
+func (x *RecategorizeTransactionsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *RecategorizeTransactionsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *RecategorizeTransactionsRequest) GetCategoryIds() []int64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *RecategorizeTransactionsRequest) GetSkipManuallyEdited() bool {
+	if x != nil {
+		return x.SkipManuallyEdited
+	}
+	return false
+}
+
+func (x *RecategorizeTransactionsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type TransactionCategoryChange struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId        int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	TransactionDate      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PreviousCategoryId   int64                  `protobuf:"varint,4,opt,name=previous_category_id,json=previousCategoryId,proto3" json:"previous_category_id,omitempty"`
+	PreviousCategoryName string                 `protobuf:"bytes,5,opt,name=previous_category_name,json=previousCategoryName,proto3" json:"previous_category_name,omitempty"`
+	CategoryId           int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName         string                 `protobuf:"bytes,7,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	PreviousType         TransactionType        `protobuf:"varint,8,opt,name=previous_type,json=previousType,proto3,enum=fin_aggregator_service.TransactionType" json:"previous_type,omitempty"`
+	Type                 TransactionType        `protobuf:"varint,9,opt,name=type,proto3,enum=fin_aggregator_service.TransactionType" json:"type,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TransactionCategoryChange) Reset() {
+	*x = TransactionCategoryChange{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionCategoryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionCategoryChange) ProtoMessage() {}
+
+func (x *TransactionCategoryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionCategoryChange.ProtoReflect.Descriptor instead.
+func (*TransactionCategoryChange) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *TransactionCategoryChange) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *TransactionCategoryChange) GetTransactionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return nil
+}
+
+func (x *TransactionCategoryChange) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TransactionCategoryChange) GetPreviousCategoryId() int64 {
+	if x != nil {
+		return x.PreviousCategoryId
+	}
+	return 0
+}
+
+func (x *TransactionCategoryChange) GetPreviousCategoryName() string {
+	if x != nil {
+		return x.PreviousCategoryName
+	}
+	return ""
+}
+
+func (x *TransactionCategoryChange) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *TransactionCategoryChange) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *TransactionCategoryChange) GetPreviousType() TransactionType {
+	if x != nil {
+		return x.PreviousType
+	}
+	return TransactionType_UNSPECIFIED
+}
+
+func (x *TransactionCategoryChange) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_UNSPECIFIED
+}
+
+type RecategorizeTransactionsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	ScannedCount  int64                        `protobuf:"varint,1,opt,name=scanned_count,json=scannedCount,proto3" json:"scanned_count,omitempty"`
+	ChangedCount  int64                        `protobuf:"varint,2,opt,name=changed_count,json=changedCount,proto3" json:"changed_count,omitempty"`
+	Changes       []*TransactionCategoryChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecategorizeTransactionsResponse) Reset() {
+	*x = RecategorizeTransactionsResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecategorizeTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecategorizeTransactionsResponse) ProtoMessage() {}
+
+func (x *RecategorizeTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecategorizeTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*RecategorizeTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *RecategorizeTransactionsResponse) GetScannedCount() int64 {
+	if x != nil {
+		return x.ScannedCount
+	}
+	return 0
+}
+
+func (x *RecategorizeTransactionsResponse) GetChangedCount() int64 {
+	if x != nil {
+		return x.ChangedCount
+	}
+	return 0
+}
+
+func (x *RecategorizeTransactionsResponse) GetChanges() []*TransactionCategoryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ProviderCategoryMapping struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BankId           int64                  `protobuf:"varint,2,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	ExternalCategory string                 `protobuf:"bytes,3,opt,name=external_category,json=externalCategory,proto3" json:"external_category,omitempty"`
+	CategoryId       int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProviderCategoryMapping) Reset() {
+	*x = ProviderCategoryMapping{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderCategoryMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCategoryMapping) ProtoMessage() {}
+
+func (x *ProviderCategoryMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCategoryMapping.ProtoReflect.Descriptor instead.
+func (*ProviderCategoryMapping) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ProviderCategoryMapping) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProviderCategoryMapping) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *ProviderCategoryMapping) GetExternalCategory() string {
+	if x != nil {
+		return x.ExternalCategory
+	}
+	return ""
+}
+
+func (x *ProviderCategoryMapping) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ProviderCategoryMapping) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListProviderCategoryMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankId        *int64                 `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3,oneof" json:"bank_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProviderCategoryMappingRequest) Reset() {
+	*x = ListProviderCategoryMappingRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProviderCategoryMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProviderCategoryMappingRequest) ProtoMessage() {}
+
+func (x *ListProviderCategoryMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProviderCategoryMappingRequest.ProtoReflect.Descriptor instead.
+func (*ListProviderCategoryMappingRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ListProviderCategoryMappingRequest) GetBankId() int64 {
+	if x != nil && x.BankId != nil {
+		return *x.BankId
+	}
+	return 0
 }
 
-type Transaction struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BankId          int64                  `protobuf:"varint,2,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
-	ExternalId      string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	UserId          int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
-	Amount          string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	CategoryId      int64                  `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Description     string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	Type            TransactionType        `protobuf:"varint,9,opt,name=type,proto3,enum=fin_aggregator_service.TransactionType" json:"type,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	BankName        string                 `protobuf:"bytes,11,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
-	CategoryName    string                 `protobuf:"bytes,12,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	UserName        string                 `protobuf:"bytes,13,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ListProviderCategoryMappingResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Mappings      []*ProviderCategoryMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[0]
+func (x *ListProviderCategoryMappingResponse) Reset() {
+	*x = ListProviderCategoryMappingResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *ListProviderCategoryMappingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*ListProviderCategoryMappingResponse) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[0]
+func (x *ListProviderCategoryMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,125 +12227,145 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListProviderCategoryMappingResponse.ProtoReflect.Descriptor instead.
+func (*ListProviderCategoryMappingResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *Transaction) GetId() int64 {
+func (x *ListProviderCategoryMappingResponse) GetMappings() []*ProviderCategoryMapping {
 	if x != nil {
-		return x.Id
+		return x.Mappings
 	}
-	return 0
+	return nil
 }
 
-func (x *Transaction) GetBankId() int64 {
-	if x != nil {
-		return x.BankId
-	}
-	return 0
+type CreateProviderCategoryMappingRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BankId           int64                  `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	ExternalCategory string                 `protobuf:"bytes,2,opt,name=external_category,json=externalCategory,proto3" json:"external_category,omitempty"`
+	CategoryId       int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Transaction) GetExternalId() string {
-	if x != nil {
-		return x.ExternalId
-	}
-	return ""
+func (x *CreateProviderCategoryMappingRequest) Reset() {
+	*x = CreateProviderCategoryMappingRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) GetUserId() int64 {
+func (x *CreateProviderCategoryMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProviderCategoryMappingRequest) ProtoMessage() {}
+
+func (x *CreateProviderCategoryMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[181]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetTransactionDate() *timestamppb.Timestamp {
+// Deprecated: Use CreateProviderCategoryMappingRequest.ProtoReflect.Descriptor instead.
+func (*CreateProviderCategoryMappingRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *CreateProviderCategoryMappingRequest) GetBankId() int64 {
 	if x != nil {
-		return x.TransactionDate
+		return x.BankId
 	}
-	return nil
+	return 0
 }
 
-func (x *Transaction) GetAmount() string {
+func (x *CreateProviderCategoryMappingRequest) GetExternalCategory() string {
 	if x != nil {
-		return x.Amount
+		return x.ExternalCategory
 	}
 	return ""
 }
 
-func (x *Transaction) GetCategoryId() int64 {
+func (x *CreateProviderCategoryMappingRequest) GetCategoryId() int64 {
 	if x != nil {
 		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *Transaction) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type CreateProviderCategoryMappingResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Mapping       *ProviderCategoryMapping `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) GetType() TransactionType {
-	if x != nil {
-		return x.Type
-	}
-	return TransactionType_UNSPECIFIED
+func (x *CreateProviderCategoryMappingResponse) Reset() {
+	*x = CreateProviderCategoryMappingResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *CreateProviderCategoryMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Transaction) GetBankName() string {
+func (*CreateProviderCategoryMappingResponse) ProtoMessage() {}
+
+func (x *CreateProviderCategoryMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[182]
 	if x != nil {
-		return x.BankName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetCategoryName() string {
-	if x != nil {
-		return x.CategoryName
-	}
-	return ""
+// Deprecated: Use CreateProviderCategoryMappingResponse.ProtoReflect.Descriptor instead.
+func (*CreateProviderCategoryMappingResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *Transaction) GetUserName() string {
+func (x *CreateProviderCategoryMappingResponse) GetMapping() *ProviderCategoryMapping {
 	if x != nil {
-		return x.UserName
+		return x.Mapping
 	}
-	return ""
+	return nil
 }
 
-type GetTransactionsRequest struct {
+type UpdateProviderCategoryMappingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Month         int32                  `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
-	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	MappingId     int64                  `protobuf:"varint,1,opt,name=mapping_id,json=mappingId,proto3" json:"mapping_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsRequest) Reset() {
-	*x = GetTransactionsRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[1]
+func (x *UpdateProviderCategoryMappingRequest) Reset() {
+	*x = UpdateProviderCategoryMappingRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsRequest) String() string {
+func (x *UpdateProviderCategoryMappingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsRequest) ProtoMessage() {}
+func (*UpdateProviderCategoryMappingRequest) ProtoMessage() {}
 
-func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[1]
+func (x *UpdateProviderCategoryMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,50 +12376,47 @@ func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UpdateProviderCategoryMappingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProviderCategoryMappingRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *GetTransactionsRequest) GetMonth() int32 {
+func (x *UpdateProviderCategoryMappingRequest) GetMappingId() int64 {
 	if x != nil {
-		return x.Month
+		return x.MappingId
 	}
 	return 0
 }
 
-func (x *GetTransactionsRequest) GetYear() int32 {
+func (x *UpdateProviderCategoryMappingRequest) GetCategoryId() int64 {
 	if x != nil {
-		return x.Year
+		return x.CategoryId
 	}
 	return 0
 }
 
-type GetTransactionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	TotalIncome   string                 `protobuf:"bytes,3,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
-	TotalOutcome  string                 `protobuf:"bytes,4,opt,name=total_outcome,json=totalOutcome,proto3" json:"total_outcome,omitempty"`
+type UpdateProviderCategoryMappingResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Mapping       *ProviderCategoryMapping `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsResponse) Reset() {
-	*x = GetTransactionsResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[2]
+func (x *UpdateProviderCategoryMappingResponse) Reset() {
+	*x = UpdateProviderCategoryMappingResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsResponse) String() string {
+func (x *UpdateProviderCategoryMappingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsResponse) ProtoMessage() {}
+func (*UpdateProviderCategoryMappingResponse) ProtoMessage() {}
 
-func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[2]
+func (x *UpdateProviderCategoryMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,63 +12427,84 @@ func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use UpdateProviderCategoryMappingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProviderCategoryMappingResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *GetTransactionsResponse) GetTransactions() []*Transaction {
+func (x *UpdateProviderCategoryMappingResponse) GetMapping() *ProviderCategoryMapping {
 	if x != nil {
-		return x.Transactions
+		return x.Mapping
 	}
 	return nil
 }
 
-func (x *GetTransactionsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
+type DeleteProviderCategoryMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MappingId     int64                  `protobuf:"varint,1,opt,name=mapping_id,json=mappingId,proto3" json:"mapping_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsResponse) GetTotalIncome() string {
+func (x *DeleteProviderCategoryMappingRequest) Reset() {
+	*x = DeleteProviderCategoryMappingRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProviderCategoryMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProviderCategoryMappingRequest) ProtoMessage() {}
+
+func (x *DeleteProviderCategoryMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[185]
 	if x != nil {
-		return x.TotalIncome
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetTransactionsResponse) GetTotalOutcome() string {
+// Deprecated: Use DeleteProviderCategoryMappingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderCategoryMappingRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *DeleteProviderCategoryMappingRequest) GetMappingId() int64 {
 	if x != nil {
-		return x.TotalOutcome
+		return x.MappingId
 	}
-	return ""
+	return 0
 }
 
-type UpdateTransactionRequest struct {
+type DeleteProviderCategoryMappingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	CategoryId    *int64                 `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	Type          *TransactionType       `protobuf:"varint,3,opt,name=type,proto3,enum=fin_aggregator_service.TransactionType,oneof" json:"type,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTransactionRequest) Reset() {
-	*x = UpdateTransactionRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[3]
+func (x *DeleteProviderCategoryMappingResponse) Reset() {
+	*x = DeleteProviderCategoryMappingResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTransactionRequest) String() string {
+func (x *DeleteProviderCategoryMappingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTransactionRequest) ProtoMessage() {}
+func (*DeleteProviderCategoryMappingResponse) ProtoMessage() {}
 
-func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[3]
+func (x *DeleteProviderCategoryMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,99 +12515,172 @@ func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteProviderCategoryMappingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProviderCategoryMappingResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *UpdateTransactionRequest) GetTransactionId() int64 {
+func (x *DeleteProviderCategoryMappingResponse) GetSuccess() bool {
 	if x != nil {
-		return x.TransactionId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *UpdateTransactionRequest) GetCategoryId() int64 {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+type ExplainTransactionCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainTransactionCategoryRequest) Reset() {
+	*x = ExplainTransactionCategoryRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainTransactionCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainTransactionCategoryRequest) ProtoMessage() {}
+
+func (x *ExplainTransactionCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTransactionRequest) GetType() TransactionType {
-	if x != nil && x.Type != nil {
-		return *x.Type
+// Deprecated: Use ExplainTransactionCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ExplainTransactionCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *ExplainTransactionCategoryRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
 	}
-	return TransactionType_UNSPECIFIED
+	return 0
 }
 
-type UpdateTransactionResponse struct {
+type CategoryKeywordMatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	KeywordId     int64                  `protobuf:"varint,1,opt,name=keyword_id,json=keywordId,proto3" json:"keyword_id,omitempty"`
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Start         int32                  `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,6,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTransactionResponse) Reset() {
-	*x = UpdateTransactionResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[4]
+func (x *CategoryKeywordMatch) Reset() {
+	*x = CategoryKeywordMatch{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTransactionResponse) String() string {
+func (x *CategoryKeywordMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTransactionResponse) ProtoMessage() {}
+func (*CategoryKeywordMatch) ProtoMessage() {}
+
+func (x *CategoryKeywordMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryKeywordMatch.ProtoReflect.Descriptor instead.
+func (*CategoryKeywordMatch) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *CategoryKeywordMatch) GetKeywordId() int64 {
+	if x != nil {
+		return x.KeywordId
+	}
+	return 0
+}
+
+func (x *CategoryKeywordMatch) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *CategoryKeywordMatch) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
 
-func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[4]
+func (x *CategoryKeywordMatch) GetCategoryName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CategoryName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateTransactionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{4}
+func (x *CategoryKeywordMatch) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
 }
 
-func (x *UpdateTransactionResponse) GetTransaction() *Transaction {
+func (x *CategoryKeywordMatch) GetEnd() int32 {
 	if x != nil {
-		return x.Transaction
+		return x.End
 	}
-	return nil
+	return 0
 }
 
-type MonzoCallbackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CategoryEvaluation struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	CategoryId     *int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	CategoryName   string                  `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Provenance     *CategoryProvenance     `protobuf:"bytes,3,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	MatchedRuleIds []int64                 `protobuf:"varint,4,rep,packed,name=matched_rule_ids,json=matchedRuleIds,proto3" json:"matched_rule_ids,omitempty"`
+	KeywordMatches []*CategoryKeywordMatch `protobuf:"bytes,5,rep,name=keyword_matches,json=keywordMatches,proto3" json:"keyword_matches,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *MonzoCallbackRequest) Reset() {
-	*x = MonzoCallbackRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[5]
+func (x *CategoryEvaluation) Reset() {
+	*x = CategoryEvaluation{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonzoCallbackRequest) String() string {
+func (x *CategoryEvaluation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonzoCallbackRequest) ProtoMessage() {}
+func (*CategoryEvaluation) ProtoMessage() {}
 
-func (x *MonzoCallbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[5]
+func (x *CategoryEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,47 +12691,72 @@ func (x *MonzoCallbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonzoCallbackRequest.ProtoReflect.Descriptor instead.
-func (*MonzoCallbackRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CategoryEvaluation.ProtoReflect.Descriptor instead.
+func (*CategoryEvaluation) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *MonzoCallbackRequest) GetCode() string {
+func (x *CategoryEvaluation) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryEvaluation) GetCategoryName() string {
 	if x != nil {
-		return x.Code
+		return x.CategoryName
 	}
 	return ""
 }
 
-func (x *MonzoCallbackRequest) GetState() string {
+func (x *CategoryEvaluation) GetProvenance() *CategoryProvenance {
 	if x != nil {
-		return x.State
+		return x.Provenance
 	}
-	return ""
+	return nil
 }
 
-type MonzoCallbackResponse struct {
+func (x *CategoryEvaluation) GetMatchedRuleIds() []int64 {
+	if x != nil {
+		return x.MatchedRuleIds
+	}
+	return nil
+}
+
+func (x *CategoryEvaluation) GetKeywordMatches() []*CategoryKeywordMatch {
+	if x != nil {
+		return x.KeywordMatches
+	}
+	return nil
+}
+
+type ExplainTransactionCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Provenance    *CategoryProvenance    `protobuf:"bytes,4,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	Current       *CategoryEvaluation    `protobuf:"bytes,5,opt,name=current,proto3" json:"current,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MonzoCallbackResponse) Reset() {
-	*x = MonzoCallbackResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[6]
+func (x *ExplainTransactionCategoryResponse) Reset() {
+	*x = ExplainTransactionCategoryResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonzoCallbackResponse) String() string {
+func (x *ExplainTransactionCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonzoCallbackResponse) ProtoMessage() {}
+func (*ExplainTransactionCategoryResponse) ProtoMessage() {}
 
-func (x *MonzoCallbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[6]
+func (x *ExplainTransactionCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,39 +12767,67 @@ func (x *MonzoCallbackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonzoCallbackResponse.ProtoReflect.Descriptor instead.
-func (*MonzoCallbackResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExplainTransactionCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ExplainTransactionCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *MonzoCallbackResponse) GetSuccess() bool {
+func (x *ExplainTransactionCategoryResponse) GetTransactionId() int64 {
 	if x != nil {
-		return x.Success
+		return x.TransactionId
 	}
-	return false
+	return 0
 }
 
-type MonzoAccountRequest struct {
+func (x *ExplainTransactionCategoryResponse) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ExplainTransactionCategoryResponse) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *ExplainTransactionCategoryResponse) GetProvenance() *CategoryProvenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+func (x *ExplainTransactionCategoryResponse) GetCurrent() *CategoryEvaluation {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+type ReloadCachesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MonzoAccountRequest) Reset() {
-	*x = MonzoAccountRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[7]
+func (x *ReloadCachesRequest) Reset() {
+	*x = ReloadCachesRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonzoAccountRequest) String() string {
+func (x *ReloadCachesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonzoAccountRequest) ProtoMessage() {}
+func (*ReloadCachesRequest) ProtoMessage() {}
 
-func (x *MonzoAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[7]
+func (x *ReloadCachesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,33 +12838,33 @@ func (x *MonzoAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonzoAccountRequest.ProtoReflect.Descriptor instead.
-func (*MonzoAccountRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ReloadCachesRequest.ProtoReflect.Descriptor instead.
+func (*ReloadCachesRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{191}
 }
 
-type MonzoAccountResponse struct {
+type ReloadCachesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Caches        []string               `protobuf:"bytes,1,rep,name=caches,proto3" json:"caches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MonzoAccountResponse) Reset() {
-	*x = MonzoAccountResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[8]
+func (x *ReloadCachesResponse) Reset() {
+	*x = ReloadCachesResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonzoAccountResponse) String() string {
+func (x *ReloadCachesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonzoAccountResponse) ProtoMessage() {}
+func (*ReloadCachesResponse) ProtoMessage() {}
 
-func (x *MonzoAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[8]
+func (x *ReloadCachesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,39 +12875,44 @@ func (x *MonzoAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonzoAccountResponse.ProtoReflect.Descriptor instead.
-func (*MonzoAccountResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ReloadCachesResponse.ProtoReflect.Descriptor instead.
+func (*ReloadCachesResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *MonzoAccountResponse) GetSuccess() bool {
+func (x *ReloadCachesResponse) GetCaches() []string {
 	if x != nil {
-		return x.Success
+		return x.Caches
 	}
-	return false
+	return nil
 }
 
-type GetMonzoAuthURLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BankHeader struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BankId            int64                  `protobuf:"varint,2,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Required          bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	TransactionFields []TransactionField     `protobuf:"varint,5,rep,packed,name=transaction_fields,json=transactionFields,proto3,enum=fin_aggregator_service.TransactionField" json:"transaction_fields,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetMonzoAuthURLRequest) Reset() {
-	*x = GetMonzoAuthURLRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[9]
+func (x *BankHeader) Reset() {
+	*x = BankHeader{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonzoAuthURLRequest) String() string {
+func (x *BankHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonzoAuthURLRequest) ProtoMessage() {}
+func (*BankHeader) ProtoMessage() {}
 
-func (x *GetMonzoAuthURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[9]
+func (x *BankHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,33 +12923,68 @@ func (x *GetMonzoAuthURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonzoAuthURLRequest.ProtoReflect.Descriptor instead.
-func (*GetMonzoAuthURLRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BankHeader.ProtoReflect.Descriptor instead.
+func (*BankHeader) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{193}
 }
 
-type GetMonzoAuthURLResponse struct {
+func (x *BankHeader) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BankHeader) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *BankHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BankHeader) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *BankHeader) GetTransactionFields() []TransactionField {
+	if x != nil {
+		return x.TransactionFields
+	}
+	return nil
+}
+
+type ListBankHeaderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuthUrl       string                 `protobuf:"bytes,1,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	BankId        int64                  `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMonzoAuthURLResponse) Reset() {
-	*x = GetMonzoAuthURLResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[10]
+func (x *ListBankHeaderRequest) Reset() {
+	*x = ListBankHeaderRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonzoAuthURLResponse) String() string {
+func (x *ListBankHeaderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonzoAuthURLResponse) ProtoMessage() {}
+func (*ListBankHeaderRequest) ProtoMessage() {}
 
-func (x *GetMonzoAuthURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[10]
+func (x *ListBankHeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,43 +12995,41 @@ func (x *GetMonzoAuthURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonzoAuthURLResponse.ProtoReflect.Descriptor instead.
-func (*GetMonzoAuthURLResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListBankHeaderRequest.ProtoReflect.Descriptor instead.
+func (*ListBankHeaderRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *GetMonzoAuthURLResponse) GetAuthUrl() string {
+func (x *ListBankHeaderRequest) GetBankId() int64 {
 	if x != nil {
-		return x.AuthUrl
+		return x.BankId
 	}
-	return ""
+	return 0
 }
 
-type LoadMonzoTransactionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
-	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
-	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BankId        int64                  `protobuf:"varint,4,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListBankHeaderResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Headers               []*BankHeader          `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	MissingRequiredFields []TransactionField     `protobuf:"varint,2,rep,packed,name=missing_required_fields,json=missingRequiredFields,proto3,enum=fin_aggregator_service.TransactionField" json:"missing_required_fields,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *LoadMonzoTransactionsRequest) Reset() {
-	*x = LoadMonzoTransactionsRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[11]
+func (x *ListBankHeaderResponse) Reset() {
+	*x = ListBankHeaderResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoadMonzoTransactionsRequest) String() string {
+func (x *ListBankHeaderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadMonzoTransactionsRequest) ProtoMessage() {}
+func (*ListBankHeaderResponse) ProtoMessage() {}
 
-func (x *LoadMonzoTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[11]
+func (x *ListBankHeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,61 +13040,50 @@ func (x *LoadMonzoTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadMonzoTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*LoadMonzoTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListBankHeaderResponse.ProtoReflect.Descriptor instead.
+func (*ListBankHeaderResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *LoadMonzoTransactionsRequest) GetSince() *timestamppb.Timestamp {
+func (x *ListBankHeaderResponse) GetHeaders() []*BankHeader {
 	if x != nil {
-		return x.Since
+		return x.Headers
 	}
 	return nil
 }
 
-func (x *LoadMonzoTransactionsRequest) GetBefore() *timestamppb.Timestamp {
+func (x *ListBankHeaderResponse) GetMissingRequiredFields() []TransactionField {
 	if x != nil {
-		return x.Before
+		return x.MissingRequiredFields
 	}
 	return nil
 }
 
-func (x *LoadMonzoTransactionsRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *LoadMonzoTransactionsRequest) GetBankId() int64 {
-	if x != nil {
-		return x.BankId
-	}
-	return 0
-}
-
-type LoadMonzoTransactionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateBankHeaderRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BankId            int64                  `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Required          bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	TransactionFields []TransactionField     `protobuf:"varint,4,rep,packed,name=transaction_fields,json=transactionFields,proto3,enum=fin_aggregator_service.TransactionField" json:"transaction_fields,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *LoadMonzoTransactionsResponse) Reset() {
-	*x = LoadMonzoTransactionsResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[12]
+func (x *CreateBankHeaderRequest) Reset() {
+	*x = CreateBankHeaderRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoadMonzoTransactionsResponse) String() string {
+func (x *CreateBankHeaderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadMonzoTransactionsResponse) ProtoMessage() {}
+func (*CreateBankHeaderRequest) ProtoMessage() {}
 
-func (x *LoadMonzoTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[12]
+func (x *CreateBankHeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,43 +13094,61 @@ func (x *LoadMonzoTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadMonzoTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*LoadMonzoTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateBankHeaderRequest.ProtoReflect.Descriptor instead.
+func (*CreateBankHeaderRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *LoadMonzoTransactionsResponse) GetSuccess() bool {
+func (x *CreateBankHeaderRequest) GetBankId() int64 {
 	if x != nil {
-		return x.Success
+		return x.BankId
+	}
+	return 0
+}
+
+func (x *CreateBankHeaderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBankHeaderRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
 	}
 	return false
 }
 
-type UploadCSVRequest struct {
+func (x *CreateBankHeaderRequest) GetTransactionFields() []TransactionField {
+	if x != nil {
+		return x.TransactionFields
+	}
+	return nil
+}
+
+type CreateBankHeaderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CsvData       []byte                 `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	BankId        int64                  `protobuf:"varint,3,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Header        *BankHeader            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadCSVRequest) Reset() {
-	*x = UploadCSVRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[13]
+func (x *CreateBankHeaderResponse) Reset() {
+	*x = CreateBankHeaderResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadCSVRequest) String() string {
+func (x *CreateBankHeaderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadCSVRequest) ProtoMessage() {}
+func (*CreateBankHeaderResponse) ProtoMessage() {}
 
-func (x *UploadCSVRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[13]
+func (x *CreateBankHeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,62 +13159,43 @@ func (x *UploadCSVRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadCSVRequest.ProtoReflect.Descriptor instead.
-func (*UploadCSVRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateBankHeaderResponse.ProtoReflect.Descriptor instead.
+func (*CreateBankHeaderResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *UploadCSVRequest) GetCsvData() []byte {
+func (x *CreateBankHeaderResponse) GetHeader() *BankHeader {
 	if x != nil {
-		return x.CsvData
+		return x.Header
 	}
 	return nil
 }
 
-func (x *UploadCSVRequest) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
-}
-
-func (x *UploadCSVRequest) GetBankId() int64 {
-	if x != nil {
-		return x.BankId
-	}
-	return 0
-}
-
-func (x *UploadCSVRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-type UploadCSVResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	RecordError   []*RecordError         `protobuf:"bytes,2,rep,name=record_error,json=recordError,proto3" json:"record_error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateBankHeaderRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	HeaderId          int64                  `protobuf:"varint,1,opt,name=header_id,json=headerId,proto3" json:"header_id,omitempty"`
+	Name              *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Required          *bool                  `protobuf:"varint,3,opt,name=required,proto3,oneof" json:"required,omitempty"`
+	TransactionFields []TransactionField     `protobuf:"varint,4,rep,packed,name=transaction_fields,json=transactionFields,proto3,enum=fin_aggregator_service.TransactionField" json:"transaction_fields,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UploadCSVResponse) Reset() {
-	*x = UploadCSVResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[14]
+func (x *UpdateBankHeaderRequest) Reset() {
+	*x = UpdateBankHeaderRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadCSVResponse) String() string {
+func (x *UpdateBankHeaderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadCSVResponse) ProtoMessage() {}
+func (*UpdateBankHeaderRequest) ProtoMessage() {}
 
-func (x *UploadCSVResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[14]
+func (x *UpdateBankHeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,48 +13206,61 @@ func (x *UploadCSVResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadCSVResponse.ProtoReflect.Descriptor instead.
-func (*UploadCSVResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdateBankHeaderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBankHeaderRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *UploadCSVResponse) GetSuccess() bool {
+func (x *UpdateBankHeaderRequest) GetHeaderId() int64 {
 	if x != nil {
-		return x.Success
+		return x.HeaderId
+	}
+	return 0
+}
+
+func (x *UpdateBankHeaderRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBankHeaderRequest) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
 	}
 	return false
 }
 
-func (x *UploadCSVResponse) GetRecordError() []*RecordError {
+func (x *UpdateBankHeaderRequest) GetTransactionFields() []TransactionField {
 	if x != nil {
-		return x.RecordError
+		return x.TransactionFields
 	}
 	return nil
 }
 
-type RecordError struct {
+type UpdateBankHeaderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RowId         int64                  `protobuf:"varint,1,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
-	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Header        *BankHeader            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordError) Reset() {
-	*x = RecordError{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[15]
+func (x *UpdateBankHeaderResponse) Reset() {
+	*x = UpdateBankHeaderResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordError) String() string {
+func (x *UpdateBankHeaderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordError) ProtoMessage() {}
+func (*UpdateBankHeaderResponse) ProtoMessage() {}
 
-func (x *RecordError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[15]
+func (x *UpdateBankHeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,46 +13271,40 @@ func (x *RecordError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordError.ProtoReflect.Descriptor instead.
-func (*RecordError) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *RecordError) GetRowId() int64 {
-	if x != nil {
-		return x.RowId
-	}
-	return 0
+// Deprecated: Use UpdateBankHeaderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBankHeaderResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *RecordError) GetErrors() []string {
+func (x *UpdateBankHeaderResponse) GetHeader() *BankHeader {
 	if x != nil {
-		return x.Errors
+		return x.Header
 	}
 	return nil
 }
 
-type ListBankRequest struct {
+type DeleteBankHeaderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	HeaderId      int64                  `protobuf:"varint,1,opt,name=header_id,json=headerId,proto3" json:"header_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBankRequest) Reset() {
-	*x = ListBankRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[16]
+func (x *DeleteBankHeaderRequest) Reset() {
+	*x = DeleteBankHeaderRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBankRequest) String() string {
+func (x *DeleteBankHeaderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBankRequest) ProtoMessage() {}
+func (*DeleteBankHeaderRequest) ProtoMessage() {}
 
-func (x *ListBankRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[16]
+func (x *DeleteBankHeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,33 +13315,40 @@ func (x *ListBankRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBankRequest.ProtoReflect.Descriptor instead.
-func (*ListBankRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DeleteBankHeaderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBankHeaderRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{200}
 }
 
-type ListBankResponse struct {
+func (x *DeleteBankHeaderRequest) GetHeaderId() int64 {
+	if x != nil {
+		return x.HeaderId
+	}
+	return 0
+}
+
+type DeleteBankHeaderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Banks         []*Bank                `protobuf:"bytes,1,rep,name=banks,proto3" json:"banks,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBankResponse) Reset() {
-	*x = ListBankResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[17]
+func (x *DeleteBankHeaderResponse) Reset() {
+	*x = DeleteBankHeaderResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBankResponse) String() string {
+func (x *DeleteBankHeaderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBankResponse) ProtoMessage() {}
+func (*DeleteBankHeaderResponse) ProtoMessage() {}
 
-func (x *ListBankResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[17]
+func (x *DeleteBankHeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,42 +13359,51 @@ func (x *ListBankResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBankResponse.ProtoReflect.Descriptor instead.
-func (*ListBankResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DeleteBankHeaderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBankHeaderResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *ListBankResponse) GetBanks() []*Bank {
+func (x *DeleteBankHeaderResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Banks
+		return x.Success
 	}
-	return nil
-}
-
-type Bank struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ImportMethod  []BankImportMethod     `protobuf:"varint,3,rep,packed,name=import_method,json=importMethod,proto3,enum=fin_aggregator_service.BankImportMethod" json:"import_method,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return false
 }
 
-func (x *Bank) Reset() {
-	*x = Bank{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[18]
+type BankParsingProfile struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BankId             int64                  `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	DateLayouts        []string               `protobuf:"bytes,2,rep,name=date_layouts,json=dateLayouts,proto3" json:"date_layouts,omitempty"`
+	Timezone           string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DecimalSeparator   string                 `protobuf:"bytes,4,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"`
+	ThousandsSeparator string                 `protobuf:"bytes,5,opt,name=thousands_separator,json=thousandsSeparator,proto3" json:"thousands_separator,omitempty"`
+	SignConvention     SignConvention         `protobuf:"varint,6,opt,name=sign_convention,json=signConvention,proto3,enum=fin_aggregator_service.SignConvention" json:"sign_convention,omitempty"`
+	AmountMode         AmountMode             `protobuf:"varint,7,opt,name=amount_mode,json=amountMode,proto3,enum=fin_aggregator_service.AmountMode" json:"amount_mode,omitempty"`
+	Delimiter          string                 `protobuf:"bytes,8,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	Encoding           CsvEncoding            `protobuf:"varint,9,opt,name=encoding,proto3,enum=fin_aggregator_service.CsvEncoding" json:"encoding,omitempty"`
+	HeaderRow          *int32                 `protobuf:"varint,10,opt,name=header_row,json=headerRow,proto3,oneof" json:"header_row,omitempty"`
+	SkipRows           int32                  `protobuf:"varint,11,opt,name=skip_rows,json=skipRows,proto3" json:"skip_rows,omitempty"`
+	IsDefault          bool                   `protobuf:"varint,12,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BankParsingProfile) Reset() {
+	*x = BankParsingProfile{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Bank) String() string {
+func (x *BankParsingProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bank) ProtoMessage() {}
+func (*BankParsingProfile) ProtoMessage() {}
 
-func (x *Bank) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[18]
+func (x *BankParsingProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,90 +13414,117 @@ func (x *Bank) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bank.ProtoReflect.Descriptor instead.
-func (*Bank) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BankParsingProfile.ProtoReflect.Descriptor instead.
+func (*BankParsingProfile) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *Bank) GetId() int64 {
+func (x *BankParsingProfile) GetBankId() int64 {
 	if x != nil {
-		return x.Id
+		return x.BankId
 	}
 	return 0
 }
 
-func (x *Bank) GetName() string {
+func (x *BankParsingProfile) GetDateLayouts() []string {
 	if x != nil {
-		return x.Name
+		return x.DateLayouts
+	}
+	return nil
+}
+
+func (x *BankParsingProfile) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *Bank) GetImportMethod() []BankImportMethod {
+func (x *BankParsingProfile) GetDecimalSeparator() string {
 	if x != nil {
-		return x.ImportMethod
+		return x.DecimalSeparator
 	}
-	return nil
+	return ""
 }
 
-type ListUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BankParsingProfile) GetThousandsSeparator() string {
+	if x != nil {
+		return x.ThousandsSeparator
+	}
+	return ""
 }
 
-func (x *ListUserRequest) Reset() {
-	*x = ListUserRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BankParsingProfile) GetSignConvention() SignConvention {
+	if x != nil {
+		return x.SignConvention
+	}
+	return SignConvention_SIGN_CONVENTION_UNSPECIFIED
 }
 
-func (x *ListUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BankParsingProfile) GetAmountMode() AmountMode {
+	if x != nil {
+		return x.AmountMode
+	}
+	return AmountMode_AMOUNT_MODE_UNSPECIFIED
 }
 
-func (*ListUserRequest) ProtoMessage() {}
+func (x *BankParsingProfile) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
 
-func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[19]
+func (x *BankParsingProfile) GetEncoding() CsvEncoding {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Encoding
 	}
-	return mi.MessageOf(x)
+	return CsvEncoding_CSV_ENCODING_UNSPECIFIED
 }
 
-// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
-func (*ListUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{19}
+func (x *BankParsingProfile) GetHeaderRow() int32 {
+	if x != nil && x.HeaderRow != nil {
+		return *x.HeaderRow
+	}
+	return 0
 }
 
-type ListUserResponse struct {
+func (x *BankParsingProfile) GetSkipRows() int32 {
+	if x != nil {
+		return x.SkipRows
+	}
+	return 0
+}
+
+func (x *BankParsingProfile) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type GetBankParsingProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	BankId        int64                  `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserResponse) Reset() {
-	*x = ListUserResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[20]
+func (x *GetBankParsingProfileRequest) Reset() {
+	*x = GetBankParsingProfileRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserResponse) String() string {
+func (x *GetBankParsingProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserResponse) ProtoMessage() {}
+func (*GetBankParsingProfileRequest) ProtoMessage() {}
 
-func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[20]
+func (x *GetBankParsingProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,42 +13535,40 @@ func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
-func (*ListUserResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetBankParsingProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetBankParsingProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *ListUserResponse) GetUsers() []*User {
+func (x *GetBankParsingProfileRequest) GetBankId() int64 {
 	if x != nil {
-		return x.Users
+		return x.BankId
 	}
-	return nil
+	return 0
 }
 
-type User struct {
+type GetBankParsingProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Banks         []int64                `protobuf:"varint,3,rep,packed,name=banks,proto3" json:"banks,omitempty"`
+	Profile       *BankParsingProfile    `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[21]
+func (x *GetBankParsingProfileResponse) Reset() {
+	*x = GetBankParsingProfileResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *GetBankParsingProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*GetBankParsingProfileResponse) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[21]
+func (x *GetBankParsingProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,53 +13579,50 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *User) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *User) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetBankParsingProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetBankParsingProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *User) GetBanks() []int64 {
+func (x *GetBankParsingProfileResponse) GetProfile() *BankParsingProfile {
 	if x != nil {
-		return x.Banks
+		return x.Profile
 	}
 	return nil
 }
 
-type ListCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListCategoryRequest) Reset() {
-	*x = ListCategoryRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[22]
+type UpdateBankParsingProfileRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BankId             int64                  `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
+	DateLayouts        []string               `protobuf:"bytes,2,rep,name=date_layouts,json=dateLayouts,proto3" json:"date_layouts,omitempty"`
+	Timezone           string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DecimalSeparator   string                 `protobuf:"bytes,4,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"`
+	ThousandsSeparator string                 `protobuf:"bytes,5,opt,name=thousands_separator,json=thousandsSeparator,proto3" json:"thousands_separator,omitempty"`
+	SignConvention     SignConvention         `protobuf:"varint,6,opt,name=sign_convention,json=signConvention,proto3,enum=fin_aggregator_service.SignConvention" json:"sign_convention,omitempty"`
+	AmountMode         AmountMode             `protobuf:"varint,7,opt,name=amount_mode,json=amountMode,proto3,enum=fin_aggregator_service.AmountMode" json:"amount_mode,omitempty"`
+	Delimiter          string                 `protobuf:"bytes,8,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	Encoding           CsvEncoding            `protobuf:"varint,9,opt,name=encoding,proto3,enum=fin_aggregator_service.CsvEncoding" json:"encoding,omitempty"`
+	HeaderRow          *int32                 `protobuf:"varint,10,opt,name=header_row,json=headerRow,proto3,oneof" json:"header_row,omitempty"`
+	SkipRows           int32                  `protobuf:"varint,11,opt,name=skip_rows,json=skipRows,proto3" json:"skip_rows,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateBankParsingProfileRequest) Reset() {
+	*x = UpdateBankParsingProfileRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoryRequest) String() string {
+func (x *UpdateBankParsingProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoryRequest) ProtoMessage() {}
+func (*UpdateBankParsingProfileRequest) ProtoMessage() {}
 
-func (x *ListCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[22]
+func (x *UpdateBankParsingProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,78 +13633,110 @@ func (x *ListCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoryRequest.ProtoReflect.Descriptor instead.
-func (*ListCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateBankParsingProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBankParsingProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{205}
 }
 
-type ListCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      []*Category            `protobuf:"bytes,1,rep,name=category,proto3" json:"category,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateBankParsingProfileRequest) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
 }
 
-func (x *ListCategoryResponse) Reset() {
-	*x = ListCategoryResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateBankParsingProfileRequest) GetDateLayouts() []string {
+	if x != nil {
+		return x.DateLayouts
+	}
+	return nil
 }
 
-func (x *ListCategoryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateBankParsingProfileRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
 }
 
-func (*ListCategoryResponse) ProtoMessage() {}
+func (x *UpdateBankParsingProfileRequest) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
 
-func (x *ListCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[23]
+func (x *UpdateBankParsingProfileRequest) GetThousandsSeparator() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ThousandsSeparator
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListCategoryResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{23}
+func (x *UpdateBankParsingProfileRequest) GetSignConvention() SignConvention {
+	if x != nil {
+		return x.SignConvention
+	}
+	return SignConvention_SIGN_CONVENTION_UNSPECIFIED
 }
 
-func (x *ListCategoryResponse) GetCategory() []*Category {
+func (x *UpdateBankParsingProfileRequest) GetAmountMode() AmountMode {
 	if x != nil {
-		return x.Category
+		return x.AmountMode
 	}
-	return nil
+	return AmountMode_AMOUNT_MODE_UNSPECIFIED
 }
 
-type Category struct {
+func (x *UpdateBankParsingProfileRequest) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *UpdateBankParsingProfileRequest) GetEncoding() CsvEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return CsvEncoding_CSV_ENCODING_UNSPECIFIED
+}
+
+func (x *UpdateBankParsingProfileRequest) GetHeaderRow() int32 {
+	if x != nil && x.HeaderRow != nil {
+		return *x.HeaderRow
+	}
+	return 0
+}
+
+func (x *UpdateBankParsingProfileRequest) GetSkipRows() int32 {
+	if x != nil {
+		return x.SkipRows
+	}
+	return 0
+}
+
+type UpdateBankParsingProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Profile       *BankParsingProfile    `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[24]
+func (x *UpdateBankParsingProfileResponse) Reset() {
+	*x = UpdateBankParsingProfileResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Category) String() string {
+func (x *UpdateBankParsingProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*UpdateBankParsingProfileResponse) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[24]
+func (x *UpdateBankParsingProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,46 +13747,40 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *Category) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use UpdateBankParsingProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBankParsingProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *Category) GetName() string {
+func (x *UpdateBankParsingProfileResponse) GetProfile() *BankParsingProfile {
 	if x != nil {
-		return x.Name
+		return x.Profile
 	}
-	return ""
+	return nil
 }
 
-type ListTransactionTypeRequest struct {
+type DeleteBankParsingProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankId        int64                  `protobuf:"varint,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionTypeRequest) Reset() {
-	*x = ListTransactionTypeRequest{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[25]
+func (x *DeleteBankParsingProfileRequest) Reset() {
+	*x = DeleteBankParsingProfileRequest{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionTypeRequest) String() string {
+func (x *DeleteBankParsingProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionTypeRequest) ProtoMessage() {}
+func (*DeleteBankParsingProfileRequest) ProtoMessage() {}
 
-func (x *ListTransactionTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[25]
+func (x *DeleteBankParsingProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1469,33 +13791,40 @@ func (x *ListTransactionTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionTypeRequest.ProtoReflect.Descriptor instead.
-func (*ListTransactionTypeRequest) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DeleteBankParsingProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBankParsingProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{207}
 }
 
-type ListTransactionTypeResponse struct {
+func (x *DeleteBankParsingProfileRequest) GetBankId() int64 {
+	if x != nil {
+		return x.BankId
+	}
+	return 0
+}
+
+type DeleteBankParsingProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          []TransactionType      `protobuf:"varint,1,rep,packed,name=type,proto3,enum=fin_aggregator_service.TransactionType" json:"type,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTransactionTypeResponse) Reset() {
-	*x = ListTransactionTypeResponse{}
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[26]
+func (x *DeleteBankParsingProfileResponse) Reset() {
+	*x = DeleteBankParsingProfileResponse{}
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTransactionTypeResponse) String() string {
+func (x *DeleteBankParsingProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionTypeResponse) ProtoMessage() {}
+func (*DeleteBankParsingProfileResponse) ProtoMessage() {}
 
-func (x *ListTransactionTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[26]
+func (x *DeleteBankParsingProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1506,23 +13835,23 @@ func (x *ListTransactionTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionTypeResponse.ProtoReflect.Descriptor instead.
-func (*ListTransactionTypeResponse) Descriptor() ([]byte, []int) {
-	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DeleteBankParsingProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBankParsingProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *ListTransactionTypeResponse) GetType() []TransactionType {
+func (x *DeleteBankParsingProfileResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Type
+		return x.Success
 	}
-	return nil
+	return false
 }
 
 var File_api_fin_aggregate_service_fin_aggregate_service_proto protoreflect.FileDescriptor
 
 const file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDesc = "" +
 	"\n" +
-	"5api/fin-aggregate-service/fin-aggregate-service.proto\x12\x16fin_aggregator_service\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x03\n" +
+	"5api/fin-aggregate-service/fin-aggregate-service.proto\x12\x16fin_aggregator_service\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf4\x05\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\abank_id\x18\x02 \x01(\x03R\x06bankId\x12\x1f\n" +
@@ -1540,7 +13869,30 @@ const file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
 	"\tbank_name\x18\v \x01(\tR\bbankName\x12#\n" +
 	"\rcategory_name\x18\f \x01(\tR\fcategoryName\x12\x1b\n" +
-	"\tuser_name\x18\r \x01(\tR\buserName\"B\n" +
+	"\tuser_name\x18\r \x01(\tR\buserName\x12\"\n" +
+	"\n" +
+	"account_id\x18\x0e \x01(\x03H\x00R\taccountId\x88\x01\x01\x12&\n" +
+	"\faccount_name\x18\x0f \x01(\tH\x01R\vaccountName\x88\x01\x01\x12\x1f\n" +
+	"\bmerchant\x18\x10 \x01(\tH\x02R\bmerchant\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x11 \x03(\tR\x04tags\x12[\n" +
+	"\x13category_provenance\x18\x12 \x01(\v2*.fin_aggregator_service.CategoryProvenanceR\x12categoryProvenanceB\r\n" +
+	"\v_account_idB\x0f\n" +
+	"\r_account_nameB\v\n" +
+	"\t_merchant\"\x9b\x02\n" +
+	"\x12CategoryProvenance\x12>\n" +
+	"\x06source\x18\x01 \x01(\x0e2&.fin_aggregator_service.CategorySourceR\x06source\x12\x1c\n" +
+	"\arule_id\x18\x02 \x01(\x03H\x00R\x06ruleId\x88\x01\x01\x12 \n" +
+	"\trule_name\x18\x03 \x01(\tH\x01R\bruleName\x88\x01\x01\x12&\n" +
+	"\fmatched_text\x18\x04 \x01(\tH\x02R\vmatchedText\x88\x01\x01\x12#\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\x01H\x03R\n" +
+	"confidence\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rule_idB\f\n" +
+	"\n" +
+	"_rule_nameB\x0f\n" +
+	"\r_matched_textB\r\n" +
+	"\v_confidence\"B\n" +
 	"\x16GetTransactionsRequest\x12\x14\n" +
 	"\x05month\x18\x01 \x01(\x05R\x05month\x12\x12\n" +
 	"\x04year\x18\x02 \x01(\x05R\x04year\"\xcb\x01\n" +
@@ -1564,27 +13916,50 @@ const file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDesc = "" +
 	"\x05state\x18\x02 \x01(\tR\x05state\"1\n" +
 	"\x15MonzoCallbackResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x15\n" +
-	"\x13MonzoAccountRequest\"0\n" +
+	"\x13MonzoAccountRequest\"r\n" +
 	"\x14MonzoAccountResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12@\n" +
+	"\baccounts\x18\x02 \x03(\v2$.fin_aggregator_service.MonzoAccountR\baccounts\"\x88\x01\n" +
+	"\fMonzoAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06closed\x18\x05 \x01(\bR\x06closed\"\x18\n" +
 	"\x16GetMonzoAuthURLRequest\"4\n" +
 	"\x17GetMonzoAuthURLResponse\x12\x19\n" +
-	"\bauth_url\x18\x01 \x01(\tR\aauthUrl\"\xb6\x01\n" +
+	"\bauth_url\x18\x01 \x01(\tR\aauthUrl\"\xe9\x01\n" +
 	"\x1cLoadMonzoTransactionsRequest\x120\n" +
 	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x122\n" +
 	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x17\n" +
-	"\abank_id\x18\x04 \x01(\x03R\x06bankId\"9\n" +
+	"\abank_id\x18\x04 \x01(\x03R\x06bankId\x12\"\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\x03H\x00R\taccountId\x88\x01\x01B\r\n" +
+	"\v_account_id\"9\n" +
 	"\x1dLoadMonzoTransactionsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"{\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xae\x01\n" +
 	"\x10UploadCSVRequest\x12\x19\n" +
 	"\bcsv_data\x18\x01 \x01(\fR\acsvData\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x17\n" +
 	"\abank_id\x18\x03 \x01(\x03R\x06bankId\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\x03R\x06userId\"u\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\"\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\x03H\x00R\taccountId\x88\x01\x01B\r\n" +
+	"\v_account_id\"\xb3\x01\n" +
 	"\x11UploadCSVResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12F\n" +
-	"\frecord_error\x18\x02 \x03(\v2#.fin_aggregator_service.RecordErrorR\vrecordError\"<\n" +
+	"\frecord_error\x18\x02 \x03(\v2#.fin_aggregator_service.RecordErrorR\vrecordError\x12<\n" +
+	"\adialect\x18\x03 \x01(\v2\".fin_aggregator_service.CsvDialectR\adialect\"\xd9\x01\n" +
+	"\n" +
+	"CsvDialect\x12?\n" +
+	"\bencoding\x18\x01 \x01(\x0e2#.fin_aggregator_service.CsvEncodingR\bencoding\x12\x10\n" +
+	"\x03bom\x18\x02 \x01(\bR\x03bom\x12\x1c\n" +
+	"\tdelimiter\x18\x03 \x01(\tR\tdelimiter\x12\x14\n" +
+	"\x05quote\x18\x04 \x01(\tR\x05quote\x12%\n" +
+	"\x0elenient_quotes\x18\x05 \x01(\bR\rlenientQuotes\x12\x1d\n" +
+	"\n" +
+	"header_row\x18\x06 \x01(\x05R\theaderRow\"<\n" +
 	"\vRecordError\x12\x15\n" +
 	"\x06row_id\x18\x01 \x01(\x03R\x05rowId\x12\x16\n" +
 	"\x06errors\x18\x02 \x03(\tR\x06errors\"\x11\n" +
@@ -1601,25 +13976,1174 @@ const file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDesc = "" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05banks\x18\x03 \x03(\x03R\x05banks\"\x15\n" +
-	"\x13ListCategoryRequest\"T\n" +
+	"\x05banks\x18\x03 \x03(\x03R\x05banks\"~\n" +
+	"\x13ListCategoryRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\x12\x12\n" +
+	"\x04tree\x18\x02 \x01(\bR\x04tree\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"T\n" +
 	"\x14ListCategoryResponse\x12<\n" +
-	"\bcategory\x18\x01 \x03(\v2 .fin_aggregator_service.CategoryR\bcategory\".\n" +
+	"\bcategory\x18\x01 \x03(\v2 .fin_aggregator_service.CategoryR\bcategory\"\xd3\x02\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\x1c\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1a\n" +
+	"\barchived\x18\x04 \x01(\bR\barchived\x12 \n" +
+	"\tparent_id\x18\x05 \x01(\x03H\x01R\bparentId\x88\x01\x01\x12<\n" +
+	"\bchildren\x18\x06 \x03(\v2 .fin_aggregator_service.CategoryR\bchildren\x128\n" +
+	"\x04kind\x18\a \x01(\x0e2$.fin_aggregator_service.CategoryKindR\x04kind\x12\x1c\n" +
+	"\auser_id\x18\b \x01(\x03H\x02R\x06userId\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_parent_idB\n" +
+	"\n" +
+	"\b_user_id\"\x1c\n" +
 	"\x1aListTransactionTypeRequest\"Z\n" +
 	"\x1bListTransactionTypeResponse\x12;\n" +
-	"\x04type\x18\x01 \x03(\x0e2'.fin_aggregator_service.TransactionTypeR\x04type*;\n" +
+	"\x04type\x18\x01 \x03(\x0e2'.fin_aggregator_service.TransactionTypeR\x04type\"\xba\x01\n" +
+	"\x1aGetSpendingInsightsRequest\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\x05R\x05month\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\x03H\x00R\x06userId\x88\x01\x01\x121\n" +
+	"\x12parent_category_id\x18\x04 \x01(\x03H\x01R\x10parentCategoryId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\x15\n" +
+	"\x13_parent_category_id\"\x94\x02\n" +
+	"\x1bGetSpendingInsightsResponse\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\x05R\x05month\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x1f\n" +
+	"\vtotal_spend\x18\x03 \x01(\tR\n" +
+	"totalSpend\x12O\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\v2/.fin_aggregator_service.CategorySpendingInsightR\n" +
+	"categories\x12Y\n" +
+	"\x0elarge_expenses\x18\x05 \x03(\v22.fin_aggregator_service.TransactionSpendingInsightR\rlargeExpenses\"\xee\x04\n" +
+	"\x17CategorySpendingInsight\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x122\n" +
+	"\x15previous_month_amount\x18\x04 \x01(\tR\x13previousMonthAmount\x12I\n" +
+	"\x1fmonth_over_month_change_percent\x18\x05 \x01(\x01H\x00R\x1bmonthOverMonthChangePercent\x88\x01\x01\x12(\n" +
+	"\x10last_year_amount\x18\x06 \x01(\tR\x0elastYearAmount\x12E\n" +
+	"\x1dyear_over_year_change_percent\x18\a \x01(\x01H\x01R\x19yearOverYearChangePercent\x88\x01\x01\x12!\n" +
+	"\frolling_mean\x18\b \x01(\tR\vrollingMean\x12&\n" +
+	"\x0frolling_std_dev\x18\t \x01(\tR\rrollingStdDev\x12\x1c\n" +
+	"\az_score\x18\n" +
+	" \x01(\x01H\x02R\x06zScore\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"is_anomaly\x18\v \x01(\bR\tisAnomaly\x12+\n" +
+	"\x11has_subcategories\x18\f \x01(\bR\x10hasSubcategoriesB\"\n" +
+	" _month_over_month_change_percentB \n" +
+	"\x1e_year_over_year_change_percentB\n" +
+	"\n" +
+	"\b_z_score\"\xfc\x03\n" +
+	"\x1aTransactionSpendingInsight\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x17\n" +
+	"\abank_id\x18\x03 \x01(\x03R\x06bankId\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x05 \x01(\tR\fcategoryName\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\a \x01(\tR\x06amount\x12E\n" +
+	"\x10transaction_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0ftransactionDate\x12V\n" +
+	"\x0fbaseline_source\x18\t \x01(\x0e2-.fin_aggregator_service.InsightBaselineSourceR\x0ebaselineSource\x12#\n" +
+	"\rbaseline_mean\x18\n" +
+	" \x01(\tR\fbaselineMean\x12(\n" +
+	"\x10baseline_std_dev\x18\v \x01(\tR\x0ebaselineStdDev\x12\x17\n" +
+	"\az_score\x18\f \x01(\x01R\x06zScore\"\x83\x01\n" +
+	"\fExpenseShare\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\n" +
+	"percentage\x18\x02 \x01(\x01H\x00R\n" +
+	"percentage\x88\x01\x01\x12\x1b\n" +
+	"\x06amount\x18\x03 \x01(\tH\x01R\x06amount\x88\x01\x01B\r\n" +
+	"\v_percentageB\t\n" +
+	"\a_amount\"\xce\x02\n" +
+	"\rSharedExpense\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\"\n" +
+	"\rpayer_user_id\x18\x03 \x01(\x03R\vpayerUserId\x12!\n" +
+	"\ftotal_amount\x18\x04 \x01(\tR\vtotalAmount\x12F\n" +
+	"\fsplit_method\x18\x05 \x01(\x0e2#.fin_aggregator_service.SplitMethodR\vsplitMethod\x12<\n" +
+	"\x06shares\x18\x06 \x03(\v2$.fin_aggregator_service.ExpenseShareR\x06shares\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x02\n" +
+	"\x18MarkSharedExpenseRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12'\n" +
+	"\rpayer_user_id\x18\x02 \x01(\x03H\x00R\vpayerUserId\x88\x01\x01\x12F\n" +
+	"\fsplit_method\x18\x03 \x01(\x0e2#.fin_aggregator_service.SplitMethodR\vsplitMethod\x12<\n" +
+	"\x06shares\x18\x04 \x03(\v2$.fin_aggregator_service.ExpenseShareR\x06sharesB\x10\n" +
+	"\x0e_payer_user_id\"i\n" +
+	"\x19MarkSharedExpenseResponse\x12L\n" +
+	"\x0eshared_expense\x18\x01 \x01(\v2%.fin_aggregator_service.SharedExpenseR\rsharedExpense\"C\n" +
+	"\x1aUnmarkSharedExpenseRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\"7\n" +
+	"\x1bUnmarkSharedExpenseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\x18ListSharedExpenseRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"k\n" +
+	"\x19ListSharedExpenseResponse\x12N\n" +
+	"\x0fshared_expenses\x18\x01 \x03(\v2%.fin_aggregator_service.SharedExpenseR\x0esharedExpenses\"u\n" +
+	"\vUserBalance\x12$\n" +
+	"\x0edebtor_user_id\x18\x01 \x01(\x03R\fdebtorUserId\x12(\n" +
+	"\x10creditor_user_id\x18\x02 \x01(\x03R\x0ecreditorUserId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"B\n" +
+	"\x16GetUserBalancesRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"Z\n" +
+	"\x17GetUserBalancesResponse\x12?\n" +
+	"\bbalances\x18\x01 \x03(\v2#.fin_aggregator_service.UserBalanceR\bbalances\"n\n" +
+	"\x14SettlementSuggestion\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\x03R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x03R\btoUserId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\x1f\n" +
+	"\x1dGetSettleUpSuggestionsRequest\"p\n" +
+	"\x1eGetSettleUpSuggestionsResponse\x12N\n" +
+	"\vsuggestions\x18\x01 \x03(\v2,.fin_aggregator_service.SettlementSuggestionR\vsuggestions\"\xcb\x02\n" +
+	"\n" +
+	"Settlement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
+	"\ffrom_user_id\x18\x02 \x01(\x03R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x03 \x01(\x03R\btoUserId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12*\n" +
+	"\x0etransaction_id\x18\x05 \x01(\x03H\x00R\rtransactionId\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x06 \x01(\tH\x01R\x04note\x88\x01\x01\x129\n" +
+	"\n" +
+	"settled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x11\n" +
+	"\x0f_transaction_idB\a\n" +
+	"\x05_note\"\x9d\x02\n" +
+	"\x17RecordSettlementRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\x03R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x03R\btoUserId\x12\x1b\n" +
+	"\x06amount\x18\x03 \x01(\tH\x00R\x06amount\x88\x01\x01\x12*\n" +
+	"\x0etransaction_id\x18\x04 \x01(\x03H\x01R\rtransactionId\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x05 \x01(\tH\x02R\x04note\x88\x01\x01\x129\n" +
+	"\n" +
+	"settled_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAtB\t\n" +
+	"\a_amountB\x11\n" +
+	"\x0f_transaction_idB\a\n" +
+	"\x05_note\"^\n" +
+	"\x18RecordSettlementResponse\x12B\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\".fin_aggregator_service.SettlementR\n" +
+	"settlement\"A\n" +
+	"\x15ListSettlementRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"^\n" +
+	"\x16ListSettlementResponse\x12D\n" +
+	"\vsettlements\x18\x01 \x03(\v2\".fin_aggregator_service.SettlementR\vsettlements\"\xb8\x02\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\abank_id\x18\x02 \x01(\x03R\x06bankId\x127\n" +
+	"\x04type\x18\x03 \x01(\x0e2#.fin_aggregator_service.AccountTypeR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12$\n" +
+	"\vexternal_id\x18\x05 \x01(\tH\x00R\n" +
+	"externalId\x88\x01\x01\x12!\n" +
+	"\fdisplay_name\x18\x06 \x01(\tR\vdisplayName\x12\x1b\n" +
+	"\towner_ids\x18\a \x03(\x03R\bownerIds\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_external_id\"\xfa\x01\n" +
+	"\x14CreateAccountRequest\x12\x17\n" +
+	"\abank_id\x18\x01 \x01(\x03R\x06bankId\x127\n" +
+	"\x04type\x18\x02 \x01(\x0e2#.fin_aggregator_service.AccountTypeR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12$\n" +
+	"\vexternal_id\x18\x04 \x01(\tH\x00R\n" +
+	"externalId\x88\x01\x01\x12!\n" +
+	"\fdisplay_name\x18\x05 \x01(\tR\vdisplayName\x12\x1b\n" +
+	"\towner_ids\x18\x06 \x03(\x03R\bownerIdsB\x0e\n" +
+	"\f_external_id\"R\n" +
+	"\x15CreateAccountResponse\x129\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1f.fin_aggregator_service.AccountR\aaccount\"\xb6\x02\n" +
+	"\x14UpdateAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12<\n" +
+	"\x04type\x18\x02 \x01(\x0e2#.fin_aggregator_service.AccountTypeH\x00R\x04type\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x03 \x01(\tH\x01R\bcurrency\x88\x01\x01\x12$\n" +
+	"\vexternal_id\x18\x04 \x01(\tH\x02R\n" +
+	"externalId\x88\x01\x01\x12&\n" +
+	"\fdisplay_name\x18\x05 \x01(\tH\x03R\vdisplayName\x88\x01\x01\x12\x1b\n" +
+	"\towner_ids\x18\x06 \x03(\x03R\bownerIdsB\a\n" +
+	"\x05_typeB\v\n" +
+	"\t_currencyB\x0e\n" +
+	"\f_external_idB\x0f\n" +
+	"\r_display_name\"R\n" +
+	"\x15UpdateAccountResponse\x129\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1f.fin_aggregator_service.AccountR\aaccount\"5\n" +
+	"\x14DeleteAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"1\n" +
+	"\x15DeleteAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x11GetAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"O\n" +
+	"\x12GetAccountResponse\x129\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1f.fin_aggregator_service.AccountR\aaccount\"h\n" +
+	"\x12ListAccountRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03H\x00R\x06userId\x88\x01\x01\x12\x1c\n" +
+	"\abank_id\x18\x02 \x01(\x03H\x01R\x06bankId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\n" +
+	"\n" +
+	"\b_bank_id\"R\n" +
+	"\x13ListAccountResponse\x12;\n" +
+	"\baccounts\x18\x01 \x03(\v2\x1f.fin_aggregator_service.AccountR\baccounts\"\x18\n" +
+	"\x16ListAccountTypeRequest\"R\n" +
+	"\x17ListAccountTypeResponse\x127\n" +
+	"\x04type\x18\x01 \x03(\x0e2#.fin_aggregator_service.AccountTypeR\x04type\"\xbd\x02\n" +
+	"\x0fBalanceSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance\x12=\n" +
+	"\fbalance_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vbalanceDate\x12E\n" +
+	"\x06source\x18\x05 \x01(\x0e2-.fin_aggregator_service.BalanceSnapshotSourceR\x06source\x12\x17\n" +
+	"\x04note\x18\x06 \x01(\tH\x00R\x04note\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
+	"\x05_note\"\xb8\x01\n" +
+	"\x1cCreateBalanceSnapshotRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12=\n" +
+	"\fbalance_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vbalanceDate\x12\x17\n" +
+	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01B\a\n" +
+	"\x05_note\"d\n" +
+	"\x1dCreateBalanceSnapshotResponse\x12C\n" +
+	"\bsnapshot\x18\x01 \x01(\v2'.fin_aggregator_service.BalanceSnapshotR\bsnapshot\"\x97\x01\n" +
+	"\x1aListBalanceSnapshotRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"d\n" +
+	"\x1bListBalanceSnapshotResponse\x12E\n" +
+	"\tsnapshots\x18\x01 \x03(\v2'.fin_aggregator_service.BalanceSnapshotR\tsnapshots\"?\n" +
+	"\x1cDeleteBalanceSnapshotRequest\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\x03R\n" +
+	"snapshotId\"9\n" +
+	"\x1dDeleteBalanceSnapshotResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb4\x03\n" +
+	"\x14ReconciliationPeriod\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12'\n" +
+	"\x0fopening_balance\x18\x03 \x01(\tR\x0eopeningBalance\x128\n" +
+	"\x18expected_closing_balance\x18\x04 \x01(\tR\x16expectedClosingBalance\x124\n" +
+	"\x16actual_closing_balance\x18\x05 \x01(\tR\x14actualClosingBalance\x12\x1e\n" +
+	"\n" +
+	"difference\x18\x06 \x01(\tR\n" +
+	"difference\x12+\n" +
+	"\x11transaction_count\x18\a \x01(\x05R\x10transactionCount\x12D\n" +
+	"\x06status\x18\b \x01(\x0e2,.fin_aggregator_service.ReconciliationStatusR\x06status\"\x94\x01\n" +
+	"\x17ReconcileAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x9c\x02\n" +
+	"\x18ReconcileAccountResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
+	"\x0esnapshot_count\x18\x02 \x01(\x05R\rsnapshotCount\x12#\n" +
+	"\ris_reconciled\x18\x03 \x01(\bR\fisReconciled\x12F\n" +
+	"\aperiods\x18\x04 \x03(\v2,.fin_aggregator_service.ReconciliationPeriodR\aperiods\x12M\n" +
+	"\vfirst_issue\x18\x05 \x01(\v2,.fin_aggregator_service.ReconciliationPeriodR\n" +
+	"firstIssue\"\xb9\x02\n" +
+	"\x05Asset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
+	"\x04kind\x18\x03 \x01(\x0e2!.fin_aggregator_service.AssetKindR\x04kind\x12C\n" +
+	"\vasset_class\x18\x04 \x01(\x0e2\".fin_aggregator_service.AssetClassR\n" +
+	"assetClass\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\rowner_user_id\x18\x06 \x01(\x03H\x00R\vownerUserId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x10\n" +
+	"\x0e_owner_user_id\"\xfb\x01\n" +
+	"\x12CreateAssetRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2!.fin_aggregator_service.AssetKindR\x04kind\x12C\n" +
+	"\vasset_class\x18\x03 \x01(\x0e2\".fin_aggregator_service.AssetClassR\n" +
+	"assetClass\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12'\n" +
+	"\rowner_user_id\x18\x05 \x01(\x03H\x00R\vownerUserId\x88\x01\x01B\x10\n" +
+	"\x0e_owner_user_id\"J\n" +
+	"\x13CreateAssetResponse\x123\n" +
+	"\x05asset\x18\x01 \x01(\v2\x1d.fin_aggregator_service.AssetR\x05asset\"\xe6\x01\n" +
+	"\x12UpdateAssetRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\x03R\aassetId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12H\n" +
+	"\vasset_class\x18\x03 \x01(\x0e2\".fin_aggregator_service.AssetClassH\x01R\n" +
+	"assetClass\x88\x01\x01\x12'\n" +
+	"\rowner_user_id\x18\x04 \x01(\x03H\x02R\vownerUserId\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_asset_classB\x10\n" +
+	"\x0e_owner_user_id\"J\n" +
+	"\x13UpdateAssetResponse\x123\n" +
+	"\x05asset\x18\x01 \x01(\v2\x1d.fin_aggregator_service.AssetR\x05asset\"/\n" +
+	"\x12DeleteAssetRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\x03R\aassetId\"/\n" +
+	"\x13DeleteAssetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x10ListAssetRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"J\n" +
+	"\x11ListAssetResponse\x125\n" +
+	"\x06assets\x18\x01 \x03(\v2\x1d.fin_aggregator_service.AssetR\x06assets\"\xb6\x01\n" +
+	"\x0eAssetValuation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\x03R\aassetId\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12A\n" +
+	"\x0evaluation_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rvaluationDate\x12\x17\n" +
+	"\x04note\x18\x05 \x01(\tH\x00R\x04note\x88\x01\x01B\a\n" +
+	"\x05_note\"\xb0\x01\n" +
+	"\x18AddAssetValuationRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\x03R\aassetId\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12A\n" +
+	"\x0evaluation_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rvaluationDate\x12\x17\n" +
+	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01B\a\n" +
+	"\x05_note\"a\n" +
+	"\x19AddAssetValuationResponse\x12D\n" +
+	"\tvaluation\x18\x01 \x01(\v2&.fin_aggregator_service.AssetValuationR\tvaluation\"6\n" +
+	"\x19ListAssetValuationRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\x03R\aassetId\"d\n" +
+	"\x1aListAssetValuationResponse\x12F\n" +
+	"\n" +
+	"valuations\x18\x01 \x03(\v2&.fin_aggregator_service.AssetValuationR\n" +
+	"valuations\"\xa3\x01\n" +
+	"\x0fAssetClassValue\x12C\n" +
+	"\vasset_class\x18\x01 \x01(\x0e2\".fin_aggregator_service.AssetClassR\n" +
+	"assetClass\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2!.fin_aggregator_service.AssetKindR\x04kind\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xf3\x01\n" +
+	"\rNetWorthPoint\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12!\n" +
+	"\ftotal_assets\x18\x02 \x01(\tR\vtotalAssets\x12+\n" +
+	"\x11total_liabilities\x18\x03 \x01(\tR\x10totalLiabilities\x12\x1b\n" +
+	"\tnet_worth\x18\x04 \x01(\tR\bnetWorth\x12E\n" +
+	"\tbreakdown\x18\x05 \x03(\v2'.fin_aggregator_service.AssetClassValueR\tbreakdown\"\xa1\x01\n" +
+	"\x19GetNetWorthHistoryRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"[\n" +
+	"\x1aGetNetWorthHistoryResponse\x12=\n" +
+	"\x06points\x18\x01 \x03(\v2%.fin_aggregator_service.NetWorthPointR\x06points\"\xad\x03\n" +
+	"\vSavingsGoal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rtarget_amount\x18\x03 \x01(\tR\ftargetAmount\x12;\n" +
+	"\vtarget_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"targetDate\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x12\"\n" +
+	"\n" +
+	"account_id\x18\x06 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\a \x01(\x03H\x01R\n" +
+	"categoryId\x88\x01\x01\x12'\n" +
+	"\rowner_user_id\x18\b \x01(\x03H\x02R\vownerUserId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_account_idB\x0e\n" +
+	"\f_category_idB\x10\n" +
+	"\x0e_owner_user_id\"\x83\x03\n" +
+	"\x18CreateSavingsGoalRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rtarget_amount\x18\x02 \x01(\tR\ftargetAmount\x12;\n" +
+	"\vtarget_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"targetDate\x12>\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartDate\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\x03H\x01R\taccountId\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x06 \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x12'\n" +
+	"\rowner_user_id\x18\a \x01(\x03H\x03R\vownerUserId\x88\x01\x01B\r\n" +
+	"\v_start_dateB\r\n" +
+	"\v_account_idB\x0e\n" +
+	"\f_category_idB\x10\n" +
+	"\x0e_owner_user_id\"T\n" +
+	"\x19CreateSavingsGoalResponse\x127\n" +
+	"\x04goal\x18\x01 \x01(\v2#.fin_aggregator_service.SavingsGoalR\x04goal\"\x87\x03\n" +
+	"\x18UpdateSavingsGoalRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\x03R\x06goalId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12(\n" +
+	"\rtarget_amount\x18\x03 \x01(\tH\x01R\ftargetAmount\x88\x01\x01\x12@\n" +
+	"\vtarget_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\n" +
+	"targetDate\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\x03H\x03R\taccountId\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x06 \x01(\x03H\x04R\n" +
+	"categoryId\x88\x01\x01\x12'\n" +
+	"\rowner_user_id\x18\a \x01(\x03H\x05R\vownerUserId\x88\x01\x01B\a\n" +
+	"\x05_nameB\x10\n" +
+	"\x0e_target_amountB\x0e\n" +
+	"\f_target_dateB\r\n" +
+	"\v_account_idB\x0e\n" +
+	"\f_category_idB\x10\n" +
+	"\x0e_owner_user_id\"T\n" +
+	"\x19UpdateSavingsGoalResponse\x127\n" +
+	"\x04goal\x18\x01 \x01(\v2#.fin_aggregator_service.SavingsGoalR\x04goal\"3\n" +
+	"\x18DeleteSavingsGoalRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\x03R\x06goalId\"5\n" +
+	"\x19DeleteSavingsGoalResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\x16ListSavingsGoalRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"T\n" +
+	"\x17ListSavingsGoalResponse\x129\n" +
+	"\x05goals\x18\x01 \x03(\v2#.fin_aggregator_service.SavingsGoalR\x05goals\"6\n" +
+	"\x1bGetSavingsGoalStatusRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\x03R\x06goalId\"\xef\x03\n" +
+	"\x1cGetSavingsGoalStatusResponse\x127\n" +
+	"\x04goal\x18\x01 \x01(\v2#.fin_aggregator_service.SavingsGoalR\x04goal\x12!\n" +
+	"\fsaved_amount\x18\x02 \x01(\tR\vsavedAmount\x12)\n" +
+	"\x10remaining_amount\x18\x03 \x01(\tR\x0fremainingAmount\x12)\n" +
+	"\x10percent_complete\x18\x04 \x01(\x01R\x0fpercentComplete\x12)\n" +
+	"\x10months_remaining\x18\x05 \x01(\x05R\x0fmonthsRemaining\x12B\n" +
+	"\x1drequired_monthly_contribution\x18\x06 \x01(\tR\x1brequiredMonthlyContribution\x12@\n" +
+	"\x1caverage_monthly_contribution\x18\a \x01(\tR\x1aaverageMonthlyContribution\x12)\n" +
+	"\x10projected_amount\x18\b \x01(\tR\x0fprojectedAmount\x12A\n" +
+	"\x06status\x18\t \x01(\x0e2).fin_aggregator_service.SavingsGoalStatusR\x06status\"\xbd\x01\n" +
+	"\x15GenerateReportRequest\x12<\n" +
+	"\x06period\x18\x01 \x01(\x0e2$.fin_aggregator_service.ReportPeriodR\x06period\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\x05R\x05month\x12<\n" +
+	"\x06format\x18\x04 \x01(\x0e2$.fin_aggregator_service.ReportFormatR\x06format\"\xa1\x04\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12B\n" +
+	"\trule_type\x18\x03 \x01(\x0e2%.fin_aggregator_service.AlertRuleTypeR\bruleType\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\x03H\x00R\x06userId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\x03H\x01R\taccountId\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x06 \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x12!\n" +
+	"\tthreshold\x18\a \x01(\tH\x03R\tthreshold\x88\x01\x01\x12;\n" +
+	"\x06period\x18\b \x01(\x0e2#.fin_aggregator_service.AlertPeriodR\x06period\x12>\n" +
+	"\achannel\x18\t \x01(\x0e2$.fin_aggregator_service.AlertChannelR\achannel\x12\x16\n" +
+	"\x06target\x18\n" +
+	" \x01(\tR\x06target\x12\x18\n" +
+	"\aenabled\x18\v \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_account_idB\x0e\n" +
+	"\f_category_idB\f\n" +
+	"\n" +
+	"_threshold\"\xa1\x02\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x03R\x06ruleId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12*\n" +
+	"\x0etransaction_id\x18\x04 \x01(\x03H\x00R\rtransactionId\x88\x01\x01\x12\x1c\n" +
+	"\tdelivered\x18\x05 \x01(\bR\tdelivered\x12*\n" +
+	"\x0edelivery_error\x18\x06 \x01(\tH\x01R\rdeliveryError\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x11\n" +
+	"\x0f_transaction_idB\x11\n" +
+	"\x0f_delivery_error\"\xc9\x03\n" +
+	"\x16CreateAlertRuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12B\n" +
+	"\trule_type\x18\x02 \x01(\x0e2%.fin_aggregator_service.AlertRuleTypeR\bruleType\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\x03H\x00R\x06userId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\x03H\x01R\taccountId\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x05 \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x12!\n" +
+	"\tthreshold\x18\x06 \x01(\tH\x03R\tthreshold\x88\x01\x01\x12;\n" +
+	"\x06period\x18\a \x01(\x0e2#.fin_aggregator_service.AlertPeriodR\x06period\x12>\n" +
+	"\achannel\x18\b \x01(\x0e2$.fin_aggregator_service.AlertChannelR\achannel\x12\x16\n" +
+	"\x06target\x18\t \x01(\tR\x06targetB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_account_idB\x0e\n" +
+	"\f_category_idB\f\n" +
+	"\n" +
+	"_threshold\"P\n" +
+	"\x17CreateAlertRuleResponse\x125\n" +
+	"\x04rule\x18\x01 \x01(\v2!.fin_aggregator_service.AlertRuleR\x04rule\"\xf5\x02\n" +
+	"\x16UpdateAlertRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12!\n" +
+	"\tthreshold\x18\x03 \x01(\tH\x01R\tthreshold\x88\x01\x01\x12@\n" +
+	"\x06period\x18\x04 \x01(\x0e2#.fin_aggregator_service.AlertPeriodH\x02R\x06period\x88\x01\x01\x12C\n" +
+	"\achannel\x18\x05 \x01(\x0e2$.fin_aggregator_service.AlertChannelH\x03R\achannel\x88\x01\x01\x12\x1b\n" +
+	"\x06target\x18\x06 \x01(\tH\x04R\x06target\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\a \x01(\bH\x05R\aenabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_thresholdB\t\n" +
+	"\a_periodB\n" +
+	"\n" +
+	"\b_channelB\t\n" +
+	"\a_targetB\n" +
+	"\n" +
+	"\b_enabled\"P\n" +
+	"\x17UpdateAlertRuleResponse\x125\n" +
+	"\x04rule\x18\x01 \x01(\v2!.fin_aggregator_service.AlertRuleR\x04rule\"1\n" +
+	"\x16DeleteAlertRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\"3\n" +
+	"\x17DeleteAlertRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"@\n" +
+	"\x14ListAlertRuleRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"P\n" +
+	"\x15ListAlertRuleResponse\x127\n" +
+	"\x05rules\x18\x01 \x03(\v2!.fin_aggregator_service.AlertRuleR\x05rules\"/\n" +
+	"\x14TestAlertRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\"1\n" +
+	"\x15TestAlertRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"a\n" +
+	"\x10ListAlertRequest\x12\x1c\n" +
+	"\arule_id\x18\x01 \x01(\x03H\x00R\x06ruleId\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x04H\x01R\x05limit\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rule_idB\b\n" +
+	"\x06_limit\"J\n" +
+	"\x11ListAlertResponse\x125\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x1d.fin_aggregator_service.AlertR\x06alerts\"\xe4\x01\n" +
+	"\x13WebhookSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_description\"\xb6\x04\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\x03R\x0esubscriptionId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12E\n" +
+	"\x06status\x18\x06 \x01(\x0e2-.fin_aggregator_service.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12B\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12-\n" +
+	"\x10last_status_code\x18\t \x01(\x05H\x00R\x0elastStatusCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tH\x01R\tlastError\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fdelivered_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAtB\x13\n" +
+	"\x11_last_status_codeB\r\n" +
+	"\v_last_error\"\xb4\x01\n" +
+	" CreateWebhookSubscriptionRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06secret\x18\x04 \x01(\tH\x01R\x06secret\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_secret\"\x8c\x01\n" +
+	"!CreateWebhookSubscriptionResponse\x12O\n" +
+	"\fsubscription\x18\x01 \x01(\v2+.fin_aggregator_service.WebhookSubscriptionR\fsubscription\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\xed\x01\n" +
+	" UpdateWebhookSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x03R\x0esubscriptionId\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tH\x00R\x03url\x88\x01\x01\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x05 \x01(\bH\x02R\aenabled\x88\x01\x01B\x06\n" +
+	"\x04_urlB\x0e\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_enabled\"t\n" +
+	"!UpdateWebhookSubscriptionResponse\x12O\n" +
+	"\fsubscription\x18\x01 \x01(\v2+.fin_aggregator_service.WebhookSubscriptionR\fsubscription\"K\n" +
+	" DeleteWebhookSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x03R\x0esubscriptionId\"=\n" +
+	"!DeleteWebhookSubscriptionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\" \n" +
+	"\x1eListWebhookSubscriptionRequest\"t\n" +
+	"\x1fListWebhookSubscriptionResponse\x12Q\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2+.fin_aggregator_service.WebhookSubscriptionR\rsubscriptions\"\xca\x01\n" +
+	"\x1aListWebhookDeliveryRequest\x12,\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x03H\x00R\x0esubscriptionId\x88\x01\x01\x12E\n" +
+	"\x06status\x18\x02 \x01(\x0e2-.fin_aggregator_service.WebhookDeliveryStatusR\x06status\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x04H\x01R\x05limit\x88\x01\x01B\x12\n" +
+	"\x10_subscription_idB\b\n" +
+	"\x06_limit\"f\n" +
+	"\x1bListWebhookDeliveryResponse\x12G\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2'.fin_aggregator_service.WebhookDeliveryR\n" +
+	"deliveries\":\n" +
+	"\x17RedeliverWebhookRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\x03R\n" +
+	"deliveryId\"_\n" +
+	"\x18RedeliverWebhookResponse\x12C\n" +
+	"\bdelivery\x18\x01 \x01(\v2'.fin_aggregator_service.WebhookDeliveryR\bdelivery\"\xcd\x03\n" +
+	"\x17CategorizationCondition\x12G\n" +
+	"\x04type\x18\x01 \x01(\x0e23.fin_aggregator_service.CategorizationConditionTypeR\x04type\x12\x19\n" +
+	"\x05value\x18\x02 \x01(\tH\x00R\x05value\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"min_amount\x18\x03 \x01(\tH\x01R\tminAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_amount\x18\x04 \x01(\tH\x02R\tmaxAmount\x88\x01\x01\x12\x1c\n" +
+	"\abank_id\x18\x05 \x01(\x03H\x03R\x06bankId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x06 \x01(\x03H\x04R\x06userId\x88\x01\x01\x126\n" +
+	"\x04sign\x18\a \x01(\x0e2\".fin_aggregator_service.AmountSignR\x04sign\x12\x1e\n" +
+	"\bfrom_day\x18\b \x01(\x05H\x05R\afromDay\x88\x01\x01\x12\x1a\n" +
+	"\x06to_day\x18\t \x01(\x05H\x06R\x05toDay\x88\x01\x01B\b\n" +
+	"\x06_valueB\r\n" +
+	"\v_min_amountB\r\n" +
+	"\v_max_amountB\n" +
+	"\n" +
+	"\b_bank_idB\n" +
+	"\n" +
+	"\b_user_idB\v\n" +
+	"\t_from_dayB\t\n" +
+	"\a_to_day\"\xda\x01\n" +
+	"\x15CategorizationActions\x12$\n" +
+	"\vcategory_id\x18\x01 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12@\n" +
+	"\x04type\x18\x02 \x01(\x0e2'.fin_aggregator_service.TransactionTypeH\x01R\x04type\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x1f\n" +
+	"\bmerchant\x18\x04 \x01(\tH\x02R\bmerchant\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\a\n" +
+	"\x05_typeB\v\n" +
+	"\t_merchant\"\xb1\x03\n" +
+	"\x12CategorizationRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12O\n" +
+	"\n" +
+	"conditions\x18\x04 \x03(\v2/.fin_aggregator_service.CategorizationConditionR\n" +
+	"conditions\x12G\n" +
+	"\aactions\x18\x05 \x01(\v2-.fin_aggregator_service.CategorizationActionsR\aactions\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\thit_count\x18\b \x01(\x03R\bhitCount\x12?\n" +
+	"\vlast_hit_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tlastHitAt\x88\x01\x01B\x0e\n" +
+	"\f_last_hit_at\"\xa8\x02\n" +
+	"\x1fCreateCategorizationRuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\bpriority\x18\x02 \x01(\x05H\x00R\bpriority\x88\x01\x01\x12O\n" +
+	"\n" +
+	"conditions\x18\x03 \x03(\v2/.fin_aggregator_service.CategorizationConditionR\n" +
+	"conditions\x12G\n" +
+	"\aactions\x18\x04 \x01(\v2-.fin_aggregator_service.CategorizationActionsR\aactions\x12\x1d\n" +
+	"\aenabled\x18\x05 \x01(\bH\x01R\aenabled\x88\x01\x01B\v\n" +
+	"\t_priorityB\n" +
+	"\n" +
+	"\b_enabled\"b\n" +
+	" CreateCategorizationRuleResponse\x12>\n" +
+	"\x04rule\x18\x01 \x01(\v2*.fin_aggregator_service.CategorizationRuleR\x04rule\"\xe0\x02\n" +
+	"\x1fUpdateCategorizationRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\bpriority\x18\x03 \x01(\x05H\x01R\bpriority\x88\x01\x01\x12O\n" +
+	"\n" +
+	"conditions\x18\x04 \x03(\v2/.fin_aggregator_service.CategorizationConditionR\n" +
+	"conditions\x12L\n" +
+	"\aactions\x18\x05 \x01(\v2-.fin_aggregator_service.CategorizationActionsH\x02R\aactions\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x06 \x01(\bH\x03R\aenabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_priorityB\n" +
+	"\n" +
+	"\b_actionsB\n" +
+	"\n" +
+	"\b_enabled\"b\n" +
+	" UpdateCategorizationRuleResponse\x12>\n" +
+	"\x04rule\x18\x01 \x01(\v2*.fin_aggregator_service.CategorizationRuleR\x04rule\":\n" +
+	"\x1fDeleteCategorizationRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\"<\n" +
+	" DeleteCategorizationRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Z\n" +
+	"\x1dListCategorizationRuleRequest\x12'\n" +
+	"\rmax_hit_count\x18\x01 \x01(\x03H\x00R\vmaxHitCount\x88\x01\x01B\x10\n" +
+	"\x0e_max_hit_count\"b\n" +
+	"\x1eListCategorizationRuleResponse\x12@\n" +
+	"\x05rules\x18\x01 \x03(\v2*.fin_aggregator_service.CategorizationRuleR\x05rules\"\x80\x01\n" +
+	"\x0fCategoryKeyword\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"\xf6\x01\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x03 \x01(\x03H\x01R\bparentId\x88\x01\x01\x128\n" +
+	"\x04kind\x18\x04 \x01(\x0e2$.fin_aggregator_service.CategoryKindR\x04kind\x12\x1c\n" +
+	"\auser_id\x18\x05 \x01(\x03H\x02R\x06userId\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_parent_idB\n" +
+	"\n" +
+	"\b_user_id\"V\n" +
+	"\x16CreateCategoryResponse\x12<\n" +
+	"\bcategory\x18\x01 \x01(\v2 .fin_aggregator_service.CategoryR\bcategory\"\xb7\x02\n" +
+	"\x15UpdateCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\barchived\x18\x04 \x01(\bH\x02R\barchived\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x05 \x01(\x03H\x03R\bparentId\x88\x01\x01\x12=\n" +
+	"\x04kind\x18\x06 \x01(\x0e2$.fin_aggregator_service.CategoryKindH\x04R\x04kind\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_archivedB\f\n" +
+	"\n" +
+	"_parent_idB\a\n" +
+	"\x05_kind\"V\n" +
+	"\x16UpdateCategoryResponse\x12<\n" +
+	"\bcategory\x18\x01 \x01(\v2 .fin_aggregator_service.CategoryR\bcategory\"\x90\x01\n" +
+	"\x15DeleteCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12:\n" +
+	"\x17reassign_to_category_id\x18\x02 \x01(\x03H\x00R\x14reassignToCategoryId\x88\x01\x01B\x1a\n" +
+	"\x18_reassign_to_category_id\"k\n" +
+	"\x16DeleteCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x127\n" +
+	"\x17reassigned_transactions\x18\x02 \x01(\x03R\x16reassignedTransactions\"e\n" +
+	"\x14MergeCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12,\n" +
+	"\x12target_category_id\x18\x02 \x01(\x03R\x10targetCategoryId\"j\n" +
+	"\x15MergeCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x127\n" +
+	"\x17reassigned_transactions\x18\x02 \x01(\x03R\x16reassignedTransactions\"=\n" +
+	"\x1aListCategoryKeywordRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\"b\n" +
+	"\x1bListCategoryKeywordResponse\x12C\n" +
+	"\bkeywords\x18\x01 \x03(\v2'.fin_aggregator_service.CategoryKeywordR\bkeywords\"z\n" +
+	"\x19AddCategoryKeywordRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"_\n" +
+	"\x1aAddCategoryKeywordResponse\x12A\n" +
+	"\akeyword\x18\x01 \x01(\v2'.fin_aggregator_service.CategoryKeywordR\akeyword\"=\n" +
+	"\x1cDeleteCategoryKeywordRequest\x12\x1d\n" +
+	"\n" +
+	"keyword_id\x18\x01 \x01(\x03R\tkeywordId\"9\n" +
+	"\x1dDeleteCategoryKeywordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf0\x03\n" +
+	"\x12CategorySuggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\x03R\rtransactionId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12 \n" +
+	"\voccurrences\x18\x06 \x01(\x05R\voccurrences\x12H\n" +
+	"\x06status\x18\a \x01(\x0e20.fin_aggregator_service.CategorySuggestionStatusR\x06status\x12B\n" +
+	"\x04kind\x18\b \x01(\x0e2..fin_aggregator_service.CategorySuggestionKindR\x04kind\x12\"\n" +
+	"\n" +
+	"keyword_id\x18\t \x01(\x03H\x00R\tkeywordId\x88\x01\x01\x12\x1c\n" +
+	"\arule_id\x18\n" +
+	" \x01(\x03H\x01R\x06ruleId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_keyword_idB\n" +
+	"\n" +
+	"\b_rule_id\"i\n" +
+	"\x1dListCategorySuggestionRequest\x12H\n" +
+	"\x06status\x18\x01 \x01(\x0e20.fin_aggregator_service.CategorySuggestionStatusR\x06status\"n\n" +
+	"\x1eListCategorySuggestionResponse\x12L\n" +
+	"\vsuggestions\x18\x01 \x03(\v2*.fin_aggregator_service.CategorySuggestionR\vsuggestions\"\xe6\x01\n" +
+	"\x1fAcceptCategorySuggestionRequest\x12#\n" +
+	"\rsuggestion_id\x18\x01 \x01(\x03R\fsuggestionId\x12B\n" +
+	"\x04kind\x18\x02 \x01(\x0e2..fin_aggregator_service.CategorySuggestionKindR\x04kind\x12\x1d\n" +
+	"\apattern\x18\x03 \x01(\tH\x00R\apattern\x88\x01\x01\x12/\n" +
+	"\x13apply_retroactively\x18\x04 \x01(\bR\x12applyRetroactivelyB\n" +
+	"\n" +
+	"\b_pattern\"\xa7\x01\n" +
+	" AcceptCategorySuggestionResponse\x12J\n" +
+	"\n" +
+	"suggestion\x18\x01 \x01(\v2*.fin_aggregator_service.CategorySuggestionR\n" +
+	"suggestion\x127\n" +
+	"\x17reassigned_transactions\x18\x02 \x01(\x03R\x16reassignedTransactions\"F\n" +
+	"\x1fRejectCategorySuggestionRequest\x12#\n" +
+	"\rsuggestion_id\x18\x01 \x01(\x03R\fsuggestionId\"n\n" +
+	" RejectCategorySuggestionResponse\x12J\n" +
+	"\n" +
+	"suggestion\x18\x01 \x01(\v2*.fin_aggregator_service.CategorySuggestionR\n" +
+	"suggestion\"\xc1\x01\n" +
+	"\x13CategorizationModel\x12!\n" +
+	"\fsample_count\x18\x01 \x01(\x05R\vsampleCount\x12%\n" +
+	"\x0ecategory_count\x18\x02 \x01(\x05R\rcategoryCount\x129\n" +
+	"\n" +
+	"trained_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttrainedAt\x12%\n" +
+	"\x0emin_confidence\x18\x04 \x01(\x01R\rminConfidence\"!\n" +
+	"\x1fTrainCategorizationModelRequest\"e\n" +
+	" TrainCategorizationModelResponse\x12A\n" +
+	"\x05model\x18\x01 \x01(\v2+.fin_aggregator_service.CategorizationModelR\x05model\"\x1f\n" +
+	"\x1dGetCategorizationModelRequest\"c\n" +
+	"\x1eGetCategorizationModelResponse\x12A\n" +
+	"\x05model\x18\x01 \x01(\v2+.fin_aggregator_service.CategorizationModelR\x05model\"z\n" +
+	"\x12CategoryPrediction\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\x01R\n" +
+	"confidence\"v\n" +
+	"(ListTransactionCategoryPredictionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"y\n" +
+	")ListTransactionCategoryPredictionResponse\x12L\n" +
+	"\vpredictions\x18\x01 \x03(\v2*.fin_aggregator_service.CategoryPredictionR\vpredictions\"\xf2\x02\n" +
+	"\x1fRecategorizeTransactionsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1c\n" +
+	"\abank_id\x18\x03 \x01(\x03H\x00R\x06bankId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\x03H\x01R\x06userId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\x03H\x02R\taccountId\x88\x01\x01\x12!\n" +
+	"\fcategory_ids\x18\x06 \x03(\x03R\vcategoryIds\x120\n" +
+	"\x14skip_manually_edited\x18\a \x01(\bR\x12skipManuallyEdited\x12\x17\n" +
+	"\adry_run\x18\b \x01(\bR\x06dryRunB\n" +
+	"\n" +
+	"\b_bank_idB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_account_id\"\xe4\x03\n" +
+	"\x19TransactionCategoryChange\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12E\n" +
+	"\x10transaction_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0ftransactionDate\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x120\n" +
+	"\x14previous_category_id\x18\x04 \x01(\x03R\x12previousCategoryId\x124\n" +
+	"\x16previous_category_name\x18\x05 \x01(\tR\x14previousCategoryName\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\a \x01(\tR\fcategoryName\x12L\n" +
+	"\rprevious_type\x18\b \x01(\x0e2'.fin_aggregator_service.TransactionTypeR\fpreviousType\x12;\n" +
+	"\x04type\x18\t \x01(\x0e2'.fin_aggregator_service.TransactionTypeR\x04type\"\xb9\x01\n" +
+	" RecategorizeTransactionsResponse\x12#\n" +
+	"\rscanned_count\x18\x01 \x01(\x03R\fscannedCount\x12#\n" +
+	"\rchanged_count\x18\x02 \x01(\x03R\fchangedCount\x12K\n" +
+	"\achanges\x18\x03 \x03(\v21.fin_aggregator_service.TransactionCategoryChangeR\achanges\"\xcb\x01\n" +
+	"\x17ProviderCategoryMapping\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\abank_id\x18\x02 \x01(\x03R\x06bankId\x12+\n" +
+	"\x11external_category\x18\x03 \x01(\tR\x10externalCategory\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"N\n" +
+	"\"ListProviderCategoryMappingRequest\x12\x1c\n" +
+	"\abank_id\x18\x01 \x01(\x03H\x00R\x06bankId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_bank_id\"r\n" +
+	"#ListProviderCategoryMappingResponse\x12K\n" +
+	"\bmappings\x18\x01 \x03(\v2/.fin_aggregator_service.ProviderCategoryMappingR\bmappings\"\x8d\x01\n" +
+	"$CreateProviderCategoryMappingRequest\x12\x17\n" +
+	"\abank_id\x18\x01 \x01(\x03R\x06bankId\x12+\n" +
+	"\x11external_category\x18\x02 \x01(\tR\x10externalCategory\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\"r\n" +
+	"%CreateProviderCategoryMappingResponse\x12I\n" +
+	"\amapping\x18\x01 \x01(\v2/.fin_aggregator_service.ProviderCategoryMappingR\amapping\"f\n" +
+	"$UpdateProviderCategoryMappingRequest\x12\x1d\n" +
+	"\n" +
+	"mapping_id\x18\x01 \x01(\x03R\tmappingId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\"r\n" +
+	"%UpdateProviderCategoryMappingResponse\x12I\n" +
+	"\amapping\x18\x01 \x01(\v2/.fin_aggregator_service.ProviderCategoryMappingR\amapping\"E\n" +
+	"$DeleteProviderCategoryMappingRequest\x12\x1d\n" +
+	"\n" +
+	"mapping_id\x18\x01 \x01(\x03R\tmappingId\"A\n" +
+	"%DeleteProviderCategoryMappingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"J\n" +
+	"!ExplainTransactionCategoryRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\"\xbd\x01\n" +
+	"\x14CategoryKeywordMatch\x12\x1d\n" +
+	"\n" +
+	"keyword_id\x18\x01 \x01(\x03R\tkeywordId\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x04 \x01(\tR\fcategoryName\x12\x14\n" +
+	"\x05start\x18\x05 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x06 \x01(\x05R\x03end\"\xbc\x02\n" +
+	"\x12CategoryEvaluation\x12$\n" +
+	"\vcategory_id\x18\x01 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12J\n" +
+	"\n" +
+	"provenance\x18\x03 \x01(\v2*.fin_aggregator_service.CategoryProvenanceR\n" +
+	"provenance\x12(\n" +
+	"\x10matched_rule_ids\x18\x04 \x03(\x03R\x0ematchedRuleIds\x12U\n" +
+	"\x0fkeyword_matches\x18\x05 \x03(\v2,.fin_aggregator_service.CategoryKeywordMatchR\x0ekeywordMatchesB\x0e\n" +
+	"\f_category_id\"\xa3\x02\n" +
+	"\"ExplainTransactionCategoryResponse\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12J\n" +
+	"\n" +
+	"provenance\x18\x04 \x01(\v2*.fin_aggregator_service.CategoryProvenanceR\n" +
+	"provenance\x12D\n" +
+	"\acurrent\x18\x05 \x01(\v2*.fin_aggregator_service.CategoryEvaluationR\acurrent\"\x15\n" +
+	"\x13ReloadCachesRequest\".\n" +
+	"\x14ReloadCachesResponse\x12\x16\n" +
+	"\x06caches\x18\x01 \x03(\tR\x06caches\"\xbe\x01\n" +
+	"\n" +
+	"BankHeader\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\abank_id\x18\x02 \x01(\x03R\x06bankId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12W\n" +
+	"\x12transaction_fields\x18\x05 \x03(\x0e2(.fin_aggregator_service.TransactionFieldR\x11transactionFields\"0\n" +
+	"\x15ListBankHeaderRequest\x12\x17\n" +
+	"\abank_id\x18\x01 \x01(\x03R\x06bankId\"\xb8\x01\n" +
+	"\x16ListBankHeaderResponse\x12<\n" +
+	"\aheaders\x18\x01 \x03(\v2\".fin_aggregator_service.BankHeaderR\aheaders\x12`\n" +
+	"\x17missing_required_fields\x18\x02 \x03(\x0e2(.fin_aggregator_service.TransactionFieldR\x15missingRequiredFields\"\xbb\x01\n" +
+	"\x17CreateBankHeaderRequest\x12\x17\n" +
+	"\abank_id\x18\x01 \x01(\x03R\x06bankId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12W\n" +
+	"\x12transaction_fields\x18\x04 \x03(\x0e2(.fin_aggregator_service.TransactionFieldR\x11transactionFields\"V\n" +
+	"\x18CreateBankHeaderResponse\x12:\n" +
+	"\x06header\x18\x01 \x01(\v2\".fin_aggregator_service.BankHeaderR\x06header\"\xdf\x01\n" +
+	"\x17UpdateBankHeaderRequest\x12\x1b\n" +
+	"\theader_id\x18\x01 \x01(\x03R\bheaderId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\brequired\x18\x03 \x01(\bH\x01R\brequired\x88\x01\x01\x12W\n" +
+	"\x12transaction_fields\x18\x04 \x03(\x0e2(.fin_aggregator_service.TransactionFieldR\x11transactionFieldsB\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_required\"V\n" +
+	"\x18UpdateBankHeaderResponse\x12:\n" +
+	"\x06header\x18\x01 \x01(\v2\".fin_aggregator_service.BankHeaderR\x06header\"6\n" +
+	"\x17DeleteBankHeaderRequest\x12\x1b\n" +
+	"\theader_id\x18\x01 \x01(\x03R\bheaderId\"4\n" +
+	"\x18DeleteBankHeaderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xae\x04\n" +
+	"\x12BankParsingProfile\x12\x17\n" +
+	"\abank_id\x18\x01 \x01(\x03R\x06bankId\x12!\n" +
+	"\fdate_layouts\x18\x02 \x03(\tR\vdateLayouts\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12+\n" +
+	"\x11decimal_separator\x18\x04 \x01(\tR\x10decimalSeparator\x12/\n" +
+	"\x13thousands_separator\x18\x05 \x01(\tR\x12thousandsSeparator\x12O\n" +
+	"\x0fsign_convention\x18\x06 \x01(\x0e2&.fin_aggregator_service.SignConventionR\x0esignConvention\x12C\n" +
+	"\vamount_mode\x18\a \x01(\x0e2\".fin_aggregator_service.AmountModeR\n" +
+	"amountMode\x12\x1c\n" +
+	"\tdelimiter\x18\b \x01(\tR\tdelimiter\x12?\n" +
+	"\bencoding\x18\t \x01(\x0e2#.fin_aggregator_service.CsvEncodingR\bencoding\x12\"\n" +
+	"\n" +
+	"header_row\x18\n" +
+	" \x01(\x05H\x00R\theaderRow\x88\x01\x01\x12\x1b\n" +
+	"\tskip_rows\x18\v \x01(\x05R\bskipRows\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\f \x01(\bR\tisDefaultB\r\n" +
+	"\v_header_row\"7\n" +
+	"\x1cGetBankParsingProfileRequest\x12\x17\n" +
+	"\abank_id\x18\x01 \x01(\x03R\x06bankId\"e\n" +
+	"\x1dGetBankParsingProfileResponse\x12D\n" +
+	"\aprofile\x18\x01 \x01(\v2*.fin_aggregator_service.BankParsingProfileR\aprofile\"\x9c\x04\n" +
+	"\x1fUpdateBankParsingProfileRequest\x12\x17\n" +
+	"\abank_id\x18\x01 \x01(\x03R\x06bankId\x12!\n" +
+	"\fdate_layouts\x18\x02 \x03(\tR\vdateLayouts\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12+\n" +
+	"\x11decimal_separator\x18\x04 \x01(\tR\x10decimalSeparator\x12/\n" +
+	"\x13thousands_separator\x18\x05 \x01(\tR\x12thousandsSeparator\x12O\n" +
+	"\x0fsign_convention\x18\x06 \x01(\x0e2&.fin_aggregator_service.SignConventionR\x0esignConvention\x12C\n" +
+	"\vamount_mode\x18\a \x01(\x0e2\".fin_aggregator_service.AmountModeR\n" +
+	"amountMode\x12\x1c\n" +
+	"\tdelimiter\x18\b \x01(\tR\tdelimiter\x12?\n" +
+	"\bencoding\x18\t \x01(\x0e2#.fin_aggregator_service.CsvEncodingR\bencoding\x12\"\n" +
+	"\n" +
+	"header_row\x18\n" +
+	" \x01(\x05H\x00R\theaderRow\x88\x01\x01\x12\x1b\n" +
+	"\tskip_rows\x18\v \x01(\x05R\bskipRowsB\r\n" +
+	"\v_header_row\"h\n" +
+	" UpdateBankParsingProfileResponse\x12D\n" +
+	"\aprofile\x18\x01 \x01(\v2*.fin_aggregator_service.BankParsingProfileR\aprofile\":\n" +
+	"\x1fDeleteBankParsingProfileRequest\x12\x17\n" +
+	"\abank_id\x18\x01 \x01(\x03R\x06bankId\"<\n" +
+	" DeleteBankParsingProfileResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess*;\n" +
 	"\x0fTransactionType\x12\x0f\n" +
 	"\vUNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06INCOME\x10\x01\x12\v\n" +
-	"\aOUTCOME\x10\x02*3\n" +
+	"\aOUTCOME\x10\x02*\xca\x01\n" +
+	"\x0eCategorySource\x12\x1f\n" +
+	"\x1bCATEGORY_SOURCE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CATEGORY_SOURCE_RULE\x10\x01\x12$\n" +
+	" CATEGORY_SOURCE_PROVIDER_MAPPING\x10\x02\x12\x1b\n" +
+	"\x17CATEGORY_SOURCE_KEYWORD\x10\x03\x12\x1e\n" +
+	"\x1aCATEGORY_SOURCE_CLASSIFIER\x10\x04\x12\x1a\n" +
+	"\x16CATEGORY_SOURCE_MANUAL\x10\x05*3\n" +
 	"\x10BankImportMethod\x12\r\n" +
 	"\tUNDEFINED\x10\x00\x12\a\n" +
 	"\x03CSV\x10\x01\x12\a\n" +
-	"\x03API\x10\x022\xfe\v\n" +
+	"\x03API\x10\x02*\xb5\x01\n" +
+	"\fCategoryKind\x12\x1d\n" +
+	"\x19CATEGORY_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CATEGORY_KIND_EXPENSE\x10\x01\x12\x18\n" +
+	"\x14CATEGORY_KIND_INCOME\x10\x02\x12\x1a\n" +
+	"\x16CATEGORY_KIND_TRANSFER\x10\x03\x12\x1a\n" +
+	"\x16CATEGORY_KIND_EXCLUDED\x10\x04\x12\x19\n" +
+	"\x15CATEGORY_KIND_SAVINGS\x10\x05*_\n" +
+	"\x15InsightBaselineSource\x12\x18\n" +
+	"\x14BASELINE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11BASELINE_MERCHANT\x10\x01\x12\x15\n" +
+	"\x11BASELINE_CATEGORY\x10\x02*Q\n" +
+	"\vSplitMethod\x12\x1c\n" +
+	"\x18SPLIT_METHOD_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05EQUAL\x10\x01\x12\x0e\n" +
+	"\n" +
+	"PERCENTAGE\x10\x02\x12\t\n" +
+	"\x05EXACT\x10\x03*k\n" +
+	"\vAccountType\x12\x1c\n" +
+	"\x18ACCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCURRENT\x10\x01\x12\v\n" +
+	"\aSAVINGS\x10\x02\x12\x0f\n" +
+	"\vCREDIT_CARD\x10\x03\x12\b\n" +
+	"\x04LOAN\x10\x04\x12\t\n" +
+	"\x05OTHER\x10\x05*\x82\x01\n" +
+	"\x15BalanceSnapshotSource\x12\x1e\n" +
+	"\x1aBALANCE_SOURCE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12BALANCE_SOURCE_CSV\x10\x01\x12\x16\n" +
+	"\x12BALANCE_SOURCE_API\x10\x02\x12\x19\n" +
+	"\x15BALANCE_SOURCE_MANUAL\x10\x03*\x89\x01\n" +
+	"\x14ReconciliationStatus\x12%\n" +
+	"!RECONCILIATION_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11RECONCILIATION_OK\x10\x01\x12\x1b\n" +
+	"\x17RECONCILIATION_MISMATCH\x10\x02\x12\x16\n" +
+	"\x12RECONCILIATION_GAP\x10\x03*A\n" +
+	"\tAssetKind\x12\x1a\n" +
+	"\x16ASSET_KIND_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05ASSET\x10\x01\x12\r\n" +
+	"\tLIABILITY\x10\x02*\xa4\x02\n" +
+	"\n" +
+	"AssetClass\x12\x1b\n" +
+	"\x17ASSET_CLASS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ASSET_CLASS_CASH\x10\x01\x12\x17\n" +
+	"\x13ASSET_CLASS_SAVINGS\x10\x02\x12\x1a\n" +
+	"\x16ASSET_CLASS_INVESTMENT\x10\x03\x12\x17\n" +
+	"\x13ASSET_CLASS_PENSION\x10\x04\x12\x18\n" +
+	"\x14ASSET_CLASS_PROPERTY\x10\x05\x12\x17\n" +
+	"\x13ASSET_CLASS_VEHICLE\x10\x06\x12\x18\n" +
+	"\x14ASSET_CLASS_MORTGAGE\x10\a\x12\x14\n" +
+	"\x10ASSET_CLASS_LOAN\x10\b\x12\x1b\n" +
+	"\x17ASSET_CLASS_CREDIT_CARD\x10\t\x12\x15\n" +
+	"\x11ASSET_CLASS_OTHER\x10\n" +
+	"*\xbd\x01\n" +
+	"\x11SavingsGoalStatus\x12#\n" +
+	"\x1fSAVINGS_GOAL_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cSAVINGS_GOAL_STATUS_ACHIEVED\x10\x01\x12 \n" +
+	"\x1cSAVINGS_GOAL_STATUS_ON_TRACK\x10\x02\x12\x1e\n" +
+	"\x1aSAVINGS_GOAL_STATUS_BEHIND\x10\x03\x12\x1f\n" +
+	"\x1bSAVINGS_GOAL_STATUS_OVERDUE\x10\x04*b\n" +
+	"\fReportPeriod\x12\x1d\n" +
+	"\x19REPORT_PERIOD_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15REPORT_PERIOD_MONTHLY\x10\x01\x12\x18\n" +
+	"\x14REPORT_PERIOD_ANNUAL\x10\x02*\\\n" +
+	"\fReportFormat\x12\x1d\n" +
+	"\x19REPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_FORMAT_HTML\x10\x01\x12\x15\n" +
+	"\x11REPORT_FORMAT_PDF\x10\x02*\xe1\x01\n" +
+	"\rAlertRuleType\x12\x1f\n" +
+	"\x1bALERT_RULE_TYPE_UNSPECIFIED\x10\x00\x12%\n" +
+	"!ALERT_RULE_TYPE_LARGE_TRANSACTION\x10\x01\x12\"\n" +
+	"\x1eALERT_RULE_TYPE_CATEGORY_SPEND\x10\x02\x12 \n" +
+	"\x1cALERT_RULE_TYPE_NEW_MERCHANT\x10\x03\x12\x1f\n" +
+	"\x1bALERT_RULE_TYPE_LOW_BALANCE\x10\x04\x12!\n" +
+	"\x1dALERT_RULE_TYPE_IMPORT_FAILED\x10\x05*Z\n" +
+	"\vAlertPeriod\x12\x1c\n" +
+	"\x18ALERT_PERIOD_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ALERT_PERIOD_WEEK\x10\x01\x12\x16\n" +
+	"\x12ALERT_PERIOD_MONTH\x10\x02*a\n" +
+	"\fAlertChannel\x12\x1d\n" +
+	"\x19ALERT_CHANNEL_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ALERT_CHANNEL_EMAIL\x10\x01\x12\x19\n" +
+	"\x15ALERT_CHANNEL_WEBHOOK\x10\x02*\xae\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_SUCCEEDED\x10\x02\x12 \n" +
+	"\x1cWEBHOOK_DELIVERY_STATUS_DEAD\x10\x03*\xa2\x03\n" +
+	"\x1bCategorizationConditionType\x12-\n" +
+	")CATEGORIZATION_CONDITION_TYPE_UNSPECIFIED\x10\x00\x12*\n" +
+	"&CATEGORIZATION_CONDITION_TYPE_CONTAINS\x10\x01\x12'\n" +
+	"#CATEGORIZATION_CONDITION_TYPE_REGEX\x10\x02\x12'\n" +
+	"#CATEGORIZATION_CONDITION_TYPE_EXACT\x10\x03\x12.\n" +
+	"*CATEGORIZATION_CONDITION_TYPE_AMOUNT_RANGE\x10\x04\x12&\n" +
+	"\"CATEGORIZATION_CONDITION_TYPE_BANK\x10\x05\x12&\n" +
+	"\"CATEGORIZATION_CONDITION_TYPE_USER\x10\x06\x12&\n" +
+	"\"CATEGORIZATION_CONDITION_TYPE_SIGN\x10\a\x12.\n" +
+	"*CATEGORIZATION_CONDITION_TYPE_DAY_OF_MONTH\x10\b*]\n" +
+	"\n" +
+	"AmountSign\x12\x1b\n" +
+	"\x17AMOUNT_SIGN_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14AMOUNT_SIGN_POSITIVE\x10\x01\x12\x18\n" +
+	"\x14AMOUNT_SIGN_NEGATIVE\x10\x02*\xc0\x01\n" +
+	"\x18CategorySuggestionStatus\x12*\n" +
+	"&CATEGORY_SUGGESTION_STATUS_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"CATEGORY_SUGGESTION_STATUS_PENDING\x10\x01\x12'\n" +
+	"#CATEGORY_SUGGESTION_STATUS_ACCEPTED\x10\x02\x12'\n" +
+	"#CATEGORY_SUGGESTION_STATUS_REJECTED\x10\x03*\x8b\x01\n" +
+	"\x16CategorySuggestionKind\x12(\n" +
+	"$CATEGORY_SUGGESTION_KIND_UNSPECIFIED\x10\x00\x12$\n" +
+	" CATEGORY_SUGGESTION_KIND_KEYWORD\x10\x01\x12!\n" +
+	"\x1dCATEGORY_SUGGESTION_KIND_RULE\x10\x02*\xaf\x02\n" +
+	"\x10TransactionField\x12!\n" +
+	"\x1dTRANSACTION_FIELD_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TRANSACTION_FIELD_DATE\x10\x01\x12\x1c\n" +
+	"\x18TRANSACTION_FIELD_AMOUNT\x10\x02\x12!\n" +
+	"\x1dTRANSACTION_FIELD_DESCRIPTION\x10\x03\x12\x1e\n" +
+	"\x1aTRANSACTION_FIELD_CATEGORY\x10\x04\x12!\n" +
+	"\x1dTRANSACTION_FIELD_EXTERNAL_ID\x10\x05\x12\x1d\n" +
+	"\x19TRANSACTION_FIELD_BALANCE\x10\x06\x12\x1b\n" +
+	"\x17TRANSACTION_FIELD_DEBIT\x10\a\x12\x1c\n" +
+	"\x18TRANSACTION_FIELD_CREDIT\x10\b*\xbc\x01\n" +
+	"\x0eSignConvention\x12\x1f\n" +
+	"\x1bSIGN_CONVENTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SIGN_CONVENTION_SIGNED\x10\x01\x12$\n" +
+	" SIGN_CONVENTION_NEGATIVE_OUTCOME\x10\x02\x12$\n" +
+	" SIGN_CONVENTION_POSITIVE_OUTCOME\x10\x03\x12!\n" +
+	"\x1dSIGN_CONVENTION_CATEGORY_KIND\x10\x04*_\n" +
+	"\n" +
+	"AmountMode\x12\x1b\n" +
+	"\x17AMOUNT_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12AMOUNT_MODE_SINGLE\x10\x01\x12\x1c\n" +
+	"\x18AMOUNT_MODE_DEBIT_CREDIT\x10\x02*\xb2\x01\n" +
+	"\vCsvEncoding\x12\x1c\n" +
+	"\x18CSV_ENCODING_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CSV_ENCODING_UTF8\x10\x01\x12\x18\n" +
+	"\x14CSV_ENCODING_UTF16LE\x10\x02\x12\x1d\n" +
+	"\x19CSV_ENCODING_WINDOWS_1252\x10\x03\x12\x1b\n" +
+	"\x17CSV_ENCODING_ISO_8859_1\x10\x04\x12\x18\n" +
+	"\x14CSV_ENCODING_UTF16BE\x10\x052\xdci\n" +
 	"\x14FinAggregatorService\x12\x89\x01\n" +
 	"\x0fGetTransactions\x12..fin_aggregator_service.GetTransactionsRequest\x1a/.fin_aggregator_service.GetTransactionsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/transactions\x12\xa3\x01\n" +
 	"\x11UpdateTransaction\x120.fin_aggregator_service.UpdateTransactionRequest\x1a1.fin_aggregator_service.UpdateTransactionResponse\")\x82\xd3\xe4\x93\x02#:\x01*2\x1e/transactions/{transaction_id}\x12\x8b\x01\n" +
@@ -1631,7 +15155,83 @@ const file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDesc = "" +
 	"\bListBank\x12'.fin_aggregator_service.ListBankRequest\x1a(.fin_aggregator_service.ListBankResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/banks\x12m\n" +
 	"\bListUser\x12'.fin_aggregator_service.ListUserRequest\x1a(.fin_aggregator_service.ListUserResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/users\x12~\n" +
 	"\fListCategory\x12+.fin_aggregator_service.ListCategoryRequest\x1a,.fin_aggregator_service.ListCategoryResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/categories\x12\x9a\x01\n" +
-	"\x13ListTransactionType\x122.fin_aggregator_service.ListTransactionTypeRequest\x1a3.fin_aggregator_service.ListTransactionTypeResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/transaction-typesB_Z]github.com/Everest13/fin-aggregator-service/pkg/fin-aggregator-service;fin_aggregator_serviceb\x06proto3"
+	"\x13ListTransactionType\x122.fin_aggregator_service.ListTransactionTypeRequest\x1a3.fin_aggregator_service.ListTransactionTypeResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/transaction-types\x12\x9a\x01\n" +
+	"\x13GetSpendingInsights\x122.fin_aggregator_service.GetSpendingInsightsRequest\x1a3.fin_aggregator_service.GetSpendingInsightsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/insights/spending\x12\x95\x01\n" +
+	"\x11MarkSharedExpense\x120.fin_aggregator_service.MarkSharedExpenseRequest\x1a1.fin_aggregator_service.MarkSharedExpenseResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/shared-expenses\x12\xa9\x01\n" +
+	"\x13UnmarkSharedExpense\x122.fin_aggregator_service.UnmarkSharedExpenseRequest\x1a3.fin_aggregator_service.UnmarkSharedExpenseResponse\")\x82\xd3\xe4\x93\x02#*!/shared-expenses/{transaction_id}\x12\x92\x01\n" +
+	"\x11ListSharedExpense\x120.fin_aggregator_service.ListSharedExpenseRequest\x1a1.fin_aggregator_service.ListSharedExpenseResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/shared-expenses\x12\x95\x01\n" +
+	"\x0fGetUserBalances\x12..fin_aggregator_service.GetUserBalancesRequest\x1a/.fin_aggregator_service.GetUserBalancesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/shared-expenses/balances\x12\xab\x01\n" +
+	"\x16GetSettleUpSuggestions\x125.fin_aggregator_service.GetSettleUpSuggestionsRequest\x1a6.fin_aggregator_service.GetSettleUpSuggestionsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/shared-expenses/settle-up\x12\x8e\x01\n" +
+	"\x10RecordSettlement\x12/.fin_aggregator_service.RecordSettlementRequest\x1a0.fin_aggregator_service.RecordSettlementResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/settlements\x12\x85\x01\n" +
+	"\x0eListSettlement\x12-.fin_aggregator_service.ListSettlementRequest\x1a..fin_aggregator_service.ListSettlementResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/settlements\x12\x82\x01\n" +
+	"\rCreateAccount\x12,.fin_aggregator_service.CreateAccountRequest\x1a-.fin_aggregator_service.CreateAccountResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/accounts\x12\x8f\x01\n" +
+	"\rUpdateAccount\x12,.fin_aggregator_service.UpdateAccountRequest\x1a-.fin_aggregator_service.UpdateAccountResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/accounts/{account_id}\x12\x8c\x01\n" +
+	"\rDeleteAccount\x12,.fin_aggregator_service.DeleteAccountRequest\x1a-.fin_aggregator_service.DeleteAccountResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/accounts/{account_id}\x12\x83\x01\n" +
+	"\n" +
+	"GetAccount\x12).fin_aggregator_service.GetAccountRequest\x1a*.fin_aggregator_service.GetAccountResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/accounts/{account_id}\x12y\n" +
+	"\vListAccount\x12*.fin_aggregator_service.ListAccountRequest\x1a+.fin_aggregator_service.ListAccountResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/accounts\x12\x8a\x01\n" +
+	"\x0fListAccountType\x12..fin_aggregator_service.ListAccountTypeRequest\x1a/.fin_aggregator_service.ListAccountTypeResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/account-types\x12\xb0\x01\n" +
+	"\x15CreateBalanceSnapshot\x124.fin_aggregator_service.CreateBalanceSnapshotRequest\x1a5.fin_aggregator_service.CreateBalanceSnapshotResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/accounts/{account_id}/balances\x12\xa7\x01\n" +
+	"\x13ListBalanceSnapshot\x122.fin_aggregator_service.ListBalanceSnapshotRequest\x1a3.fin_aggregator_service.ListBalanceSnapshotResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/accounts/{account_id}/balances\x12\xa5\x01\n" +
+	"\x15DeleteBalanceSnapshot\x124.fin_aggregator_service.DeleteBalanceSnapshotRequest\x1a5.fin_aggregator_service.DeleteBalanceSnapshotResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/balances/{snapshot_id}\x12\xa4\x01\n" +
+	"\x10ReconcileAccount\x12/.fin_aggregator_service.ReconcileAccountRequest\x1a0.fin_aggregator_service.ReconcileAccountResponse\"-\x82\xd3\xe4\x93\x02'\x12%/accounts/{account_id}/reconciliation\x12z\n" +
+	"\vCreateAsset\x12*.fin_aggregator_service.CreateAssetRequest\x1a+.fin_aggregator_service.CreateAssetResponse\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/assets\x12\x85\x01\n" +
+	"\vUpdateAsset\x12*.fin_aggregator_service.UpdateAssetRequest\x1a+.fin_aggregator_service.UpdateAssetResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/assets/{asset_id}\x12\x82\x01\n" +
+	"\vDeleteAsset\x12*.fin_aggregator_service.DeleteAssetRequest\x1a+.fin_aggregator_service.DeleteAssetResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/assets/{asset_id}\x12q\n" +
+	"\tListAsset\x12(.fin_aggregator_service.ListAssetRequest\x1a).fin_aggregator_service.ListAssetResponse\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/assets\x12\xa2\x01\n" +
+	"\x11AddAssetValuation\x120.fin_aggregator_service.AddAssetValuationRequest\x1a1.fin_aggregator_service.AddAssetValuationResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/assets/{asset_id}/valuations\x12\xa2\x01\n" +
+	"\x12ListAssetValuation\x121.fin_aggregator_service.ListAssetValuationRequest\x1a2.fin_aggregator_service.ListAssetValuationResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/assets/{asset_id}/valuations\x12\x97\x01\n" +
+	"\x12GetNetWorthHistory\x121.fin_aggregator_service.GetNetWorthHistoryRequest\x1a2.fin_aggregator_service.GetNetWorthHistoryResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/net-worth/history\x12\x93\x01\n" +
+	"\x11CreateSavingsGoal\x120.fin_aggregator_service.CreateSavingsGoalRequest\x1a1.fin_aggregator_service.CreateSavingsGoalResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/savings-goals\x12\x9d\x01\n" +
+	"\x11UpdateSavingsGoal\x120.fin_aggregator_service.UpdateSavingsGoalRequest\x1a1.fin_aggregator_service.UpdateSavingsGoalResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*2\x18/savings-goals/{goal_id}\x12\x9a\x01\n" +
+	"\x11DeleteSavingsGoal\x120.fin_aggregator_service.DeleteSavingsGoalRequest\x1a1.fin_aggregator_service.DeleteSavingsGoalResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/savings-goals/{goal_id}\x12\x8a\x01\n" +
+	"\x0fListSavingsGoal\x12..fin_aggregator_service.ListSavingsGoalRequest\x1a/.fin_aggregator_service.ListSavingsGoalResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/savings-goals\x12\xaa\x01\n" +
+	"\x14GetSavingsGoalStatus\x123.fin_aggregator_service.GetSavingsGoalStatusRequest\x1a4.fin_aggregator_service.GetSavingsGoalStatusResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/savings-goals/{goal_id}/status\x12g\n" +
+	"\x0eGenerateReport\x12-.fin_aggregator_service.GenerateReportRequest\x1a\x14.google.api.HttpBody\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/reports\x12\x8b\x01\n" +
+	"\x0fCreateAlertRule\x12..fin_aggregator_service.CreateAlertRuleRequest\x1a/.fin_aggregator_service.CreateAlertRuleResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/alert-rules\x12\x95\x01\n" +
+	"\x0fUpdateAlertRule\x12..fin_aggregator_service.UpdateAlertRuleRequest\x1a/.fin_aggregator_service.UpdateAlertRuleResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/alert-rules/{rule_id}\x12\x92\x01\n" +
+	"\x0fDeleteAlertRule\x12..fin_aggregator_service.DeleteAlertRuleRequest\x1a/.fin_aggregator_service.DeleteAlertRuleResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/alert-rules/{rule_id}\x12\x82\x01\n" +
+	"\rListAlertRule\x12,.fin_aggregator_service.ListAlertRuleRequest\x1a-.fin_aggregator_service.ListAlertRuleResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/alert-rules\x12\x94\x01\n" +
+	"\rTestAlertRule\x12,.fin_aggregator_service.TestAlertRuleRequest\x1a-.fin_aggregator_service.TestAlertRuleResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/alert-rules/{rule_id}/test\x12q\n" +
+	"\tListAlert\x12(.fin_aggregator_service.ListAlertRequest\x1a).fin_aggregator_service.ListAlertResponse\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/alerts\x12\xa6\x01\n" +
+	"\x19CreateWebhookSubscription\x128.fin_aggregator_service.CreateWebhookSubscriptionRequest\x1a9.fin_aggregator_service.CreateWebhookSubscriptionResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/webhooks\x12\xb8\x01\n" +
+	"\x19UpdateWebhookSubscription\x128.fin_aggregator_service.UpdateWebhookSubscriptionRequest\x1a9.fin_aggregator_service.UpdateWebhookSubscriptionResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/webhooks/{subscription_id}\x12\xb5\x01\n" +
+	"\x19DeleteWebhookSubscription\x128.fin_aggregator_service.DeleteWebhookSubscriptionRequest\x1a9.fin_aggregator_service.DeleteWebhookSubscriptionResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/webhooks/{subscription_id}\x12\x9d\x01\n" +
+	"\x17ListWebhookSubscription\x126.fin_aggregator_service.ListWebhookSubscriptionRequest\x1a7.fin_aggregator_service.ListWebhookSubscriptionResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/webhooks\x12\x9c\x01\n" +
+	"\x13ListWebhookDelivery\x122.fin_aggregator_service.ListWebhookDeliveryRequest\x1a3.fin_aggregator_service.ListWebhookDeliveryResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/webhooks/deliveries\x12\xae\x01\n" +
+	"\x10RedeliverWebhook\x12/.fin_aggregator_service.RedeliverWebhookRequest\x1a0.fin_aggregator_service.RedeliverWebhookResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/webhooks/deliveries/{delivery_id}/redeliver\x12\xaf\x01\n" +
+	"\x18CreateCategorizationRule\x127.fin_aggregator_service.CreateCategorizationRuleRequest\x1a8.fin_aggregator_service.CreateCategorizationRuleResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/categorization-rules\x12\xb9\x01\n" +
+	"\x18UpdateCategorizationRule\x127.fin_aggregator_service.UpdateCategorizationRuleRequest\x1a8.fin_aggregator_service.UpdateCategorizationRuleResponse\"*\x82\xd3\xe4\x93\x02$:\x01*2\x1f/categorization-rules/{rule_id}\x12\xb6\x01\n" +
+	"\x18DeleteCategorizationRule\x127.fin_aggregator_service.DeleteCategorizationRuleRequest\x1a8.fin_aggregator_service.DeleteCategorizationRuleResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/categorization-rules/{rule_id}\x12\xa6\x01\n" +
+	"\x16ListCategorizationRule\x125.fin_aggregator_service.ListCategorizationRuleRequest\x1a6.fin_aggregator_service.ListCategorizationRuleResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/categorization-rules\x12\x87\x01\n" +
+	"\x0eCreateCategory\x12-.fin_aggregator_service.CreateCategoryRequest\x1a..fin_aggregator_service.CreateCategoryResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/categories\x12\x95\x01\n" +
+	"\x0eUpdateCategory\x12-.fin_aggregator_service.UpdateCategoryRequest\x1a..fin_aggregator_service.UpdateCategoryResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/categories/{category_id}\x12\x92\x01\n" +
+	"\x0eDeleteCategory\x12-.fin_aggregator_service.DeleteCategoryRequest\x1a..fin_aggregator_service.DeleteCategoryResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/categories/{category_id}\x12\x98\x01\n" +
+	"\rMergeCategory\x12,.fin_aggregator_service.MergeCategoryRequest\x1a-.fin_aggregator_service.MergeCategoryResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/categories/{category_id}/merge\x12\xaa\x01\n" +
+	"\x13ListCategoryKeyword\x122.fin_aggregator_service.ListCategoryKeywordRequest\x1a3.fin_aggregator_service.ListCategoryKeywordResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/categories/{category_id}/keywords\x12\xaa\x01\n" +
+	"\x12AddCategoryKeyword\x121.fin_aggregator_service.AddCategoryKeywordRequest\x1a2.fin_aggregator_service.AddCategoryKeywordResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/categories/{category_id}/keywords\x12\xad\x01\n" +
+	"\x15DeleteCategoryKeyword\x124.fin_aggregator_service.DeleteCategoryKeywordRequest\x1a5.fin_aggregator_service.DeleteCategoryKeywordResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/category-keywords/{keyword_id}\x12\xa6\x01\n" +
+	"\x16ListCategorySuggestion\x125.fin_aggregator_service.ListCategorySuggestionRequest\x1a6.fin_aggregator_service.ListCategorySuggestionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/category-suggestions\x12\xc6\x01\n" +
+	"\x18AcceptCategorySuggestion\x127.fin_aggregator_service.AcceptCategorySuggestionRequest\x1a8.fin_aggregator_service.AcceptCategorySuggestionResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/category-suggestions/{suggestion_id}/accept\x12\xc6\x01\n" +
+	"\x18RejectCategorySuggestion\x127.fin_aggregator_service.RejectCategorySuggestionRequest\x1a8.fin_aggregator_service.RejectCategorySuggestionResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/category-suggestions/{suggestion_id}/reject\x12\xb5\x01\n" +
+	"\x18TrainCategorizationModel\x127.fin_aggregator_service.TrainCategorizationModelRequest\x1a8.fin_aggregator_service.TrainCategorizationModelResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/categorization-model/train\x12\xa6\x01\n" +
+	"\x16GetCategorizationModel\x125.fin_aggregator_service.GetCategorizationModelRequest\x1a6.fin_aggregator_service.GetCategorizationModelResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/categorization-model\x12\xe5\x01\n" +
+	"!ListTransactionCategoryPrediction\x12@.fin_aggregator_service.ListTransactionCategoryPredictionRequest\x1aA.fin_aggregator_service.ListTransactionCategoryPredictionResponse\";\x82\xd3\xe4\x93\x025\x123/transactions/{transaction_id}/category-predictions\x12\xb4\x01\n" +
+	"\x18RecategorizeTransactions\x127.fin_aggregator_service.RecategorizeTransactionsRequest\x1a8.fin_aggregator_service.RecategorizeTransactionsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/transactions/recategorize\x12\xbb\x01\n" +
+	"\x1bListProviderCategoryMapping\x12:.fin_aggregator_service.ListProviderCategoryMappingRequest\x1a;.fin_aggregator_service.ListProviderCategoryMappingResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/provider-category-mappings\x12\xc4\x01\n" +
+	"\x1dCreateProviderCategoryMapping\x12<.fin_aggregator_service.CreateProviderCategoryMappingRequest\x1a=.fin_aggregator_service.CreateProviderCategoryMappingResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/provider-category-mappings\x12\xd1\x01\n" +
+	"\x1dUpdateProviderCategoryMapping\x12<.fin_aggregator_service.UpdateProviderCategoryMappingRequest\x1a=.fin_aggregator_service.UpdateProviderCategoryMappingResponse\"3\x82\xd3\xe4\x93\x02-:\x01*2(/provider-category-mappings/{mapping_id}\x12\xce\x01\n" +
+	"\x1dDeleteProviderCategoryMapping\x12<.fin_aggregator_service.DeleteProviderCategoryMappingRequest\x1a=.fin_aggregator_service.DeleteProviderCategoryMappingResponse\"0\x82\xd3\xe4\x93\x02**(/provider-category-mappings/{mapping_id}\x12\xd0\x01\n" +
+	"\x1aExplainTransactionCategory\x129.fin_aggregator_service.ExplainTransactionCategoryRequest\x1a:.fin_aggregator_service.ExplainTransactionCategoryResponse\";\x82\xd3\xe4\x93\x025\x123/transactions/{transaction_id}/category-explanation\x12\x84\x01\n" +
+	"\fReloadCaches\x12+.fin_aggregator_service.ReloadCachesRequest\x1a,.fin_aggregator_service.ReloadCachesResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/caches/reload\x12\x91\x01\n" +
+	"\x0eListBankHeader\x12-.fin_aggregator_service.ListBankHeaderRequest\x1a..fin_aggregator_service.ListBankHeaderResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/banks/{bank_id}/headers\x12\x9a\x01\n" +
+	"\x10CreateBankHeader\x12/.fin_aggregator_service.CreateBankHeaderRequest\x1a0.fin_aggregator_service.CreateBankHeaderResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/banks/{bank_id}/headers\x12\x9b\x01\n" +
+	"\x10UpdateBankHeader\x12/.fin_aggregator_service.UpdateBankHeaderRequest\x1a0.fin_aggregator_service.UpdateBankHeaderResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/bank-headers/{header_id}\x12\x98\x01\n" +
+	"\x10DeleteBankHeader\x12/.fin_aggregator_service.DeleteBankHeaderRequest\x1a0.fin_aggregator_service.DeleteBankHeaderResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/bank-headers/{header_id}\x12\xae\x01\n" +
+	"\x15GetBankParsingProfile\x124.fin_aggregator_service.GetBankParsingProfileRequest\x1a5.fin_aggregator_service.GetBankParsingProfileResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /banks/{bank_id}/parsing-profile\x12\xba\x01\n" +
+	"\x18UpdateBankParsingProfile\x127.fin_aggregator_service.UpdateBankParsingProfileRequest\x1a8.fin_aggregator_service.UpdateBankParsingProfileResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /banks/{bank_id}/parsing-profile\x12\xb7\x01\n" +
+	"\x18DeleteBankParsingProfile\x127.fin_aggregator_service.DeleteBankParsingProfileRequest\x1a8.fin_aggregator_service.DeleteBankParsingProfileResponse\"(\x82\xd3\xe4\x93\x02\"* /banks/{bank_id}/parsing-profileB_Z]github.com/Everest13/fin-aggregator-service/pkg/fin-aggregator-service;fin_aggregator_serviceb\x06proto3"
 
 var (
 	file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescOnce sync.Once
@@ -1645,82 +15245,605 @@ func file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescGZIP() []
 	return file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDescData
 }
 
-var file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_api_fin_aggregate_service_fin_aggregate_service_proto_enumTypes = make([]protoimpl.EnumInfo, 26)
+var file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes = make([]protoimpl.MessageInfo, 209)
 var file_api_fin_aggregate_service_fin_aggregate_service_proto_goTypes = []any{
-	(TransactionType)(0),                  // 0: fin_aggregator_service.TransactionType
-	(BankImportMethod)(0),                 // 1: fin_aggregator_service.BankImportMethod
-	(*Transaction)(nil),                   // 2: fin_aggregator_service.Transaction
-	(*GetTransactionsRequest)(nil),        // 3: fin_aggregator_service.GetTransactionsRequest
-	(*GetTransactionsResponse)(nil),       // 4: fin_aggregator_service.GetTransactionsResponse
-	(*UpdateTransactionRequest)(nil),      // 5: fin_aggregator_service.UpdateTransactionRequest
-	(*UpdateTransactionResponse)(nil),     // 6: fin_aggregator_service.UpdateTransactionResponse
-	(*MonzoCallbackRequest)(nil),          // 7: fin_aggregator_service.MonzoCallbackRequest
-	(*MonzoCallbackResponse)(nil),         // 8: fin_aggregator_service.MonzoCallbackResponse
-	(*MonzoAccountRequest)(nil),           // 9: fin_aggregator_service.MonzoAccountRequest
-	(*MonzoAccountResponse)(nil),          // 10: fin_aggregator_service.MonzoAccountResponse
-	(*GetMonzoAuthURLRequest)(nil),        // 11: fin_aggregator_service.GetMonzoAuthURLRequest
-	(*GetMonzoAuthURLResponse)(nil),       // 12: fin_aggregator_service.GetMonzoAuthURLResponse
-	(*LoadMonzoTransactionsRequest)(nil),  // 13: fin_aggregator_service.LoadMonzoTransactionsRequest
-	(*LoadMonzoTransactionsResponse)(nil), // 14: fin_aggregator_service.LoadMonzoTransactionsResponse
-	(*UploadCSVRequest)(nil),              // 15: fin_aggregator_service.UploadCSVRequest
-	(*UploadCSVResponse)(nil),             // 16: fin_aggregator_service.UploadCSVResponse
-	(*RecordError)(nil),                   // 17: fin_aggregator_service.RecordError
-	(*ListBankRequest)(nil),               // 18: fin_aggregator_service.ListBankRequest
-	(*ListBankResponse)(nil),              // 19: fin_aggregator_service.ListBankResponse
-	(*Bank)(nil),                          // 20: fin_aggregator_service.Bank
-	(*ListUserRequest)(nil),               // 21: fin_aggregator_service.ListUserRequest
-	(*ListUserResponse)(nil),              // 22: fin_aggregator_service.ListUserResponse
-	(*User)(nil),                          // 23: fin_aggregator_service.User
-	(*ListCategoryRequest)(nil),           // 24: fin_aggregator_service.ListCategoryRequest
-	(*ListCategoryResponse)(nil),          // 25: fin_aggregator_service.ListCategoryResponse
-	(*Category)(nil),                      // 26: fin_aggregator_service.Category
-	(*ListTransactionTypeRequest)(nil),    // 27: fin_aggregator_service.ListTransactionTypeRequest
-	(*ListTransactionTypeResponse)(nil),   // 28: fin_aggregator_service.ListTransactionTypeResponse
-	(*timestamppb.Timestamp)(nil),         // 29: google.protobuf.Timestamp
+	(TransactionType)(0),                              // 0: fin_aggregator_service.TransactionType
+	(CategorySource)(0),                               // 1: fin_aggregator_service.CategorySource
+	(BankImportMethod)(0),                             // 2: fin_aggregator_service.BankImportMethod
+	(CategoryKind)(0),                                 // 3: fin_aggregator_service.CategoryKind
+	(InsightBaselineSource)(0),                        // 4: fin_aggregator_service.InsightBaselineSource
+	(SplitMethod)(0),                                  // 5: fin_aggregator_service.SplitMethod
+	(AccountType)(0),                                  // 6: fin_aggregator_service.AccountType
+	(BalanceSnapshotSource)(0),                        // 7: fin_aggregator_service.BalanceSnapshotSource
+	(ReconciliationStatus)(0),                         // 8: fin_aggregator_service.ReconciliationStatus
+	(AssetKind)(0),                                    // 9: fin_aggregator_service.AssetKind
+	(AssetClass)(0),                                   // 10: fin_aggregator_service.AssetClass
+	(SavingsGoalStatus)(0),                            // 11: fin_aggregator_service.SavingsGoalStatus
+	(ReportPeriod)(0),                                 // 12: fin_aggregator_service.ReportPeriod
+	(ReportFormat)(0),                                 // 13: fin_aggregator_service.ReportFormat
+	(AlertRuleType)(0),                                // 14: fin_aggregator_service.AlertRuleType
+	(AlertPeriod)(0),                                  // 15: fin_aggregator_service.AlertPeriod
+	(AlertChannel)(0),                                 // 16: fin_aggregator_service.AlertChannel
+	(WebhookDeliveryStatus)(0),                        // 17: fin_aggregator_service.WebhookDeliveryStatus
+	(CategorizationConditionType)(0),                  // 18: fin_aggregator_service.CategorizationConditionType
+	(AmountSign)(0),                                   // 19: fin_aggregator_service.AmountSign
+	(CategorySuggestionStatus)(0),                     // 20: fin_aggregator_service.CategorySuggestionStatus
+	(CategorySuggestionKind)(0),                       // 21: fin_aggregator_service.CategorySuggestionKind
+	(TransactionField)(0),                             // 22: fin_aggregator_service.TransactionField
+	(SignConvention)(0),                               // 23: fin_aggregator_service.SignConvention
+	(AmountMode)(0),                                   // 24: fin_aggregator_service.AmountMode
+	(CsvEncoding)(0),                                  // 25: fin_aggregator_service.CsvEncoding
+	(*Transaction)(nil),                               // 26: fin_aggregator_service.Transaction
+	(*CategoryProvenance)(nil),                        // 27: fin_aggregator_service.CategoryProvenance
+	(*GetTransactionsRequest)(nil),                    // 28: fin_aggregator_service.GetTransactionsRequest
+	(*GetTransactionsResponse)(nil),                   // 29: fin_aggregator_service.GetTransactionsResponse
+	(*UpdateTransactionRequest)(nil),                  // 30: fin_aggregator_service.UpdateTransactionRequest
+	(*UpdateTransactionResponse)(nil),                 // 31: fin_aggregator_service.UpdateTransactionResponse
+	(*MonzoCallbackRequest)(nil),                      // 32: fin_aggregator_service.MonzoCallbackRequest
+	(*MonzoCallbackResponse)(nil),                     // 33: fin_aggregator_service.MonzoCallbackResponse
+	(*MonzoAccountRequest)(nil),                       // 34: fin_aggregator_service.MonzoAccountRequest
+	(*MonzoAccountResponse)(nil),                      // 35: fin_aggregator_service.MonzoAccountResponse
+	(*MonzoAccount)(nil),                              // 36: fin_aggregator_service.MonzoAccount
+	(*GetMonzoAuthURLRequest)(nil),                    // 37: fin_aggregator_service.GetMonzoAuthURLRequest
+	(*GetMonzoAuthURLResponse)(nil),                   // 38: fin_aggregator_service.GetMonzoAuthURLResponse
+	(*LoadMonzoTransactionsRequest)(nil),              // 39: fin_aggregator_service.LoadMonzoTransactionsRequest
+	(*LoadMonzoTransactionsResponse)(nil),             // 40: fin_aggregator_service.LoadMonzoTransactionsResponse
+	(*UploadCSVRequest)(nil),                          // 41: fin_aggregator_service.UploadCSVRequest
+	(*UploadCSVResponse)(nil),                         // 42: fin_aggregator_service.UploadCSVResponse
+	(*CsvDialect)(nil),                                // 43: fin_aggregator_service.CsvDialect
+	(*RecordError)(nil),                               // 44: fin_aggregator_service.RecordError
+	(*ListBankRequest)(nil),                           // 45: fin_aggregator_service.ListBankRequest
+	(*ListBankResponse)(nil),                          // 46: fin_aggregator_service.ListBankResponse
+	(*Bank)(nil),                                      // 47: fin_aggregator_service.Bank
+	(*ListUserRequest)(nil),                           // 48: fin_aggregator_service.ListUserRequest
+	(*ListUserResponse)(nil),                          // 49: fin_aggregator_service.ListUserResponse
+	(*User)(nil),                                      // 50: fin_aggregator_service.User
+	(*ListCategoryRequest)(nil),                       // 51: fin_aggregator_service.ListCategoryRequest
+	(*ListCategoryResponse)(nil),                      // 52: fin_aggregator_service.ListCategoryResponse
+	(*Category)(nil),                                  // 53: fin_aggregator_service.Category
+	(*ListTransactionTypeRequest)(nil),                // 54: fin_aggregator_service.ListTransactionTypeRequest
+	(*ListTransactionTypeResponse)(nil),               // 55: fin_aggregator_service.ListTransactionTypeResponse
+	(*GetSpendingInsightsRequest)(nil),                // 56: fin_aggregator_service.GetSpendingInsightsRequest
+	(*GetSpendingInsightsResponse)(nil),               // 57: fin_aggregator_service.GetSpendingInsightsResponse
+	(*CategorySpendingInsight)(nil),                   // 58: fin_aggregator_service.CategorySpendingInsight
+	(*TransactionSpendingInsight)(nil),                // 59: fin_aggregator_service.TransactionSpendingInsight
+	(*ExpenseShare)(nil),                              // 60: fin_aggregator_service.ExpenseShare
+	(*SharedExpense)(nil),                             // 61: fin_aggregator_service.SharedExpense
+	(*MarkSharedExpenseRequest)(nil),                  // 62: fin_aggregator_service.MarkSharedExpenseRequest
+	(*MarkSharedExpenseResponse)(nil),                 // 63: fin_aggregator_service.MarkSharedExpenseResponse
+	(*UnmarkSharedExpenseRequest)(nil),                // 64: fin_aggregator_service.UnmarkSharedExpenseRequest
+	(*UnmarkSharedExpenseResponse)(nil),               // 65: fin_aggregator_service.UnmarkSharedExpenseResponse
+	(*ListSharedExpenseRequest)(nil),                  // 66: fin_aggregator_service.ListSharedExpenseRequest
+	(*ListSharedExpenseResponse)(nil),                 // 67: fin_aggregator_service.ListSharedExpenseResponse
+	(*UserBalance)(nil),                               // 68: fin_aggregator_service.UserBalance
+	(*GetUserBalancesRequest)(nil),                    // 69: fin_aggregator_service.GetUserBalancesRequest
+	(*GetUserBalancesResponse)(nil),                   // 70: fin_aggregator_service.GetUserBalancesResponse
+	(*SettlementSuggestion)(nil),                      // 71: fin_aggregator_service.SettlementSuggestion
+	(*GetSettleUpSuggestionsRequest)(nil),             // 72: fin_aggregator_service.GetSettleUpSuggestionsRequest
+	(*GetSettleUpSuggestionsResponse)(nil),            // 73: fin_aggregator_service.GetSettleUpSuggestionsResponse
+	(*Settlement)(nil),                                // 74: fin_aggregator_service.Settlement
+	(*RecordSettlementRequest)(nil),                   // 75: fin_aggregator_service.RecordSettlementRequest
+	(*RecordSettlementResponse)(nil),                  // 76: fin_aggregator_service.RecordSettlementResponse
+	(*ListSettlementRequest)(nil),                     // 77: fin_aggregator_service.ListSettlementRequest
+	(*ListSettlementResponse)(nil),                    // 78: fin_aggregator_service.ListSettlementResponse
+	(*Account)(nil),                                   // 79: fin_aggregator_service.Account
+	(*CreateAccountRequest)(nil),                      // 80: fin_aggregator_service.CreateAccountRequest
+	(*CreateAccountResponse)(nil),                     // 81: fin_aggregator_service.CreateAccountResponse
+	(*UpdateAccountRequest)(nil),                      // 82: fin_aggregator_service.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),                     // 83: fin_aggregator_service.UpdateAccountResponse
+	(*DeleteAccountRequest)(nil),                      // 84: fin_aggregator_service.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),                     // 85: fin_aggregator_service.DeleteAccountResponse
+	(*GetAccountRequest)(nil),                         // 86: fin_aggregator_service.GetAccountRequest
+	(*GetAccountResponse)(nil),                        // 87: fin_aggregator_service.GetAccountResponse
+	(*ListAccountRequest)(nil),                        // 88: fin_aggregator_service.ListAccountRequest
+	(*ListAccountResponse)(nil),                       // 89: fin_aggregator_service.ListAccountResponse
+	(*ListAccountTypeRequest)(nil),                    // 90: fin_aggregator_service.ListAccountTypeRequest
+	(*ListAccountTypeResponse)(nil),                   // 91: fin_aggregator_service.ListAccountTypeResponse
+	(*BalanceSnapshot)(nil),                           // 92: fin_aggregator_service.BalanceSnapshot
+	(*CreateBalanceSnapshotRequest)(nil),              // 93: fin_aggregator_service.CreateBalanceSnapshotRequest
+	(*CreateBalanceSnapshotResponse)(nil),             // 94: fin_aggregator_service.CreateBalanceSnapshotResponse
+	(*ListBalanceSnapshotRequest)(nil),                // 95: fin_aggregator_service.ListBalanceSnapshotRequest
+	(*ListBalanceSnapshotResponse)(nil),               // 96: fin_aggregator_service.ListBalanceSnapshotResponse
+	(*DeleteBalanceSnapshotRequest)(nil),              // 97: fin_aggregator_service.DeleteBalanceSnapshotRequest
+	(*DeleteBalanceSnapshotResponse)(nil),             // 98: fin_aggregator_service.DeleteBalanceSnapshotResponse
+	(*ReconciliationPeriod)(nil),                      // 99: fin_aggregator_service.ReconciliationPeriod
+	(*ReconcileAccountRequest)(nil),                   // 100: fin_aggregator_service.ReconcileAccountRequest
+	(*ReconcileAccountResponse)(nil),                  // 101: fin_aggregator_service.ReconcileAccountResponse
+	(*Asset)(nil),                                     // 102: fin_aggregator_service.Asset
+	(*CreateAssetRequest)(nil),                        // 103: fin_aggregator_service.CreateAssetRequest
+	(*CreateAssetResponse)(nil),                       // 104: fin_aggregator_service.CreateAssetResponse
+	(*UpdateAssetRequest)(nil),                        // 105: fin_aggregator_service.UpdateAssetRequest
+	(*UpdateAssetResponse)(nil),                       // 106: fin_aggregator_service.UpdateAssetResponse
+	(*DeleteAssetRequest)(nil),                        // 107: fin_aggregator_service.DeleteAssetRequest
+	(*DeleteAssetResponse)(nil),                       // 108: fin_aggregator_service.DeleteAssetResponse
+	(*ListAssetRequest)(nil),                          // 109: fin_aggregator_service.ListAssetRequest
+	(*ListAssetResponse)(nil),                         // 110: fin_aggregator_service.ListAssetResponse
+	(*AssetValuation)(nil),                            // 111: fin_aggregator_service.AssetValuation
+	(*AddAssetValuationRequest)(nil),                  // 112: fin_aggregator_service.AddAssetValuationRequest
+	(*AddAssetValuationResponse)(nil),                 // 113: fin_aggregator_service.AddAssetValuationResponse
+	(*ListAssetValuationRequest)(nil),                 // 114: fin_aggregator_service.ListAssetValuationRequest
+	(*ListAssetValuationResponse)(nil),                // 115: fin_aggregator_service.ListAssetValuationResponse
+	(*AssetClassValue)(nil),                           // 116: fin_aggregator_service.AssetClassValue
+	(*NetWorthPoint)(nil),                             // 117: fin_aggregator_service.NetWorthPoint
+	(*GetNetWorthHistoryRequest)(nil),                 // 118: fin_aggregator_service.GetNetWorthHistoryRequest
+	(*GetNetWorthHistoryResponse)(nil),                // 119: fin_aggregator_service.GetNetWorthHistoryResponse
+	(*SavingsGoal)(nil),                               // 120: fin_aggregator_service.SavingsGoal
+	(*CreateSavingsGoalRequest)(nil),                  // 121: fin_aggregator_service.CreateSavingsGoalRequest
+	(*CreateSavingsGoalResponse)(nil),                 // 122: fin_aggregator_service.CreateSavingsGoalResponse
+	(*UpdateSavingsGoalRequest)(nil),                  // 123: fin_aggregator_service.UpdateSavingsGoalRequest
+	(*UpdateSavingsGoalResponse)(nil),                 // 124: fin_aggregator_service.UpdateSavingsGoalResponse
+	(*DeleteSavingsGoalRequest)(nil),                  // 125: fin_aggregator_service.DeleteSavingsGoalRequest
+	(*DeleteSavingsGoalResponse)(nil),                 // 126: fin_aggregator_service.DeleteSavingsGoalResponse
+	(*ListSavingsGoalRequest)(nil),                    // 127: fin_aggregator_service.ListSavingsGoalRequest
+	(*ListSavingsGoalResponse)(nil),                   // 128: fin_aggregator_service.ListSavingsGoalResponse
+	(*GetSavingsGoalStatusRequest)(nil),               // 129: fin_aggregator_service.GetSavingsGoalStatusRequest
+	(*GetSavingsGoalStatusResponse)(nil),              // 130: fin_aggregator_service.GetSavingsGoalStatusResponse
+	(*GenerateReportRequest)(nil),                     // 131: fin_aggregator_service.GenerateReportRequest
+	(*AlertRule)(nil),                                 // 132: fin_aggregator_service.AlertRule
+	(*Alert)(nil),                                     // 133: fin_aggregator_service.Alert
+	(*CreateAlertRuleRequest)(nil),                    // 134: fin_aggregator_service.CreateAlertRuleRequest
+	(*CreateAlertRuleResponse)(nil),                   // 135: fin_aggregator_service.CreateAlertRuleResponse
+	(*UpdateAlertRuleRequest)(nil),                    // 136: fin_aggregator_service.UpdateAlertRuleRequest
+	(*UpdateAlertRuleResponse)(nil),                   // 137: fin_aggregator_service.UpdateAlertRuleResponse
+	(*DeleteAlertRuleRequest)(nil),                    // 138: fin_aggregator_service.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),                   // 139: fin_aggregator_service.DeleteAlertRuleResponse
+	(*ListAlertRuleRequest)(nil),                      // 140: fin_aggregator_service.ListAlertRuleRequest
+	(*ListAlertRuleResponse)(nil),                     // 141: fin_aggregator_service.ListAlertRuleResponse
+	(*TestAlertRuleRequest)(nil),                      // 142: fin_aggregator_service.TestAlertRuleRequest
+	(*TestAlertRuleResponse)(nil),                     // 143: fin_aggregator_service.TestAlertRuleResponse
+	(*ListAlertRequest)(nil),                          // 144: fin_aggregator_service.ListAlertRequest
+	(*ListAlertResponse)(nil),                         // 145: fin_aggregator_service.ListAlertResponse
+	(*WebhookSubscription)(nil),                       // 146: fin_aggregator_service.WebhookSubscription
+	(*WebhookDelivery)(nil),                           // 147: fin_aggregator_service.WebhookDelivery
+	(*CreateWebhookSubscriptionRequest)(nil),          // 148: fin_aggregator_service.CreateWebhookSubscriptionRequest
+	(*CreateWebhookSubscriptionResponse)(nil),         // 149: fin_aggregator_service.CreateWebhookSubscriptionResponse
+	(*UpdateWebhookSubscriptionRequest)(nil),          // 150: fin_aggregator_service.UpdateWebhookSubscriptionRequest
+	(*UpdateWebhookSubscriptionResponse)(nil),         // 151: fin_aggregator_service.UpdateWebhookSubscriptionResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),          // 152: fin_aggregator_service.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil),         // 153: fin_aggregator_service.DeleteWebhookSubscriptionResponse
+	(*ListWebhookSubscriptionRequest)(nil),            // 154: fin_aggregator_service.ListWebhookSubscriptionRequest
+	(*ListWebhookSubscriptionResponse)(nil),           // 155: fin_aggregator_service.ListWebhookSubscriptionResponse
+	(*ListWebhookDeliveryRequest)(nil),                // 156: fin_aggregator_service.ListWebhookDeliveryRequest
+	(*ListWebhookDeliveryResponse)(nil),               // 157: fin_aggregator_service.ListWebhookDeliveryResponse
+	(*RedeliverWebhookRequest)(nil),                   // 158: fin_aggregator_service.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),                  // 159: fin_aggregator_service.RedeliverWebhookResponse
+	(*CategorizationCondition)(nil),                   // 160: fin_aggregator_service.CategorizationCondition
+	(*CategorizationActions)(nil),                     // 161: fin_aggregator_service.CategorizationActions
+	(*CategorizationRule)(nil),                        // 162: fin_aggregator_service.CategorizationRule
+	(*CreateCategorizationRuleRequest)(nil),           // 163: fin_aggregator_service.CreateCategorizationRuleRequest
+	(*CreateCategorizationRuleResponse)(nil),          // 164: fin_aggregator_service.CreateCategorizationRuleResponse
+	(*UpdateCategorizationRuleRequest)(nil),           // 165: fin_aggregator_service.UpdateCategorizationRuleRequest
+	(*UpdateCategorizationRuleResponse)(nil),          // 166: fin_aggregator_service.UpdateCategorizationRuleResponse
+	(*DeleteCategorizationRuleRequest)(nil),           // 167: fin_aggregator_service.DeleteCategorizationRuleRequest
+	(*DeleteCategorizationRuleResponse)(nil),          // 168: fin_aggregator_service.DeleteCategorizationRuleResponse
+	(*ListCategorizationRuleRequest)(nil),             // 169: fin_aggregator_service.ListCategorizationRuleRequest
+	(*ListCategorizationRuleResponse)(nil),            // 170: fin_aggregator_service.ListCategorizationRuleResponse
+	(*CategoryKeyword)(nil),                           // 171: fin_aggregator_service.CategoryKeyword
+	(*CreateCategoryRequest)(nil),                     // 172: fin_aggregator_service.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),                    // 173: fin_aggregator_service.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),                     // 174: fin_aggregator_service.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),                    // 175: fin_aggregator_service.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),                     // 176: fin_aggregator_service.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),                    // 177: fin_aggregator_service.DeleteCategoryResponse
+	(*MergeCategoryRequest)(nil),                      // 178: fin_aggregator_service.MergeCategoryRequest
+	(*MergeCategoryResponse)(nil),                     // 179: fin_aggregator_service.MergeCategoryResponse
+	(*ListCategoryKeywordRequest)(nil),                // 180: fin_aggregator_service.ListCategoryKeywordRequest
+	(*ListCategoryKeywordResponse)(nil),               // 181: fin_aggregator_service.ListCategoryKeywordResponse
+	(*AddCategoryKeywordRequest)(nil),                 // 182: fin_aggregator_service.AddCategoryKeywordRequest
+	(*AddCategoryKeywordResponse)(nil),                // 183: fin_aggregator_service.AddCategoryKeywordResponse
+	(*DeleteCategoryKeywordRequest)(nil),              // 184: fin_aggregator_service.DeleteCategoryKeywordRequest
+	(*DeleteCategoryKeywordResponse)(nil),             // 185: fin_aggregator_service.DeleteCategoryKeywordResponse
+	(*CategorySuggestion)(nil),                        // 186: fin_aggregator_service.CategorySuggestion
+	(*ListCategorySuggestionRequest)(nil),             // 187: fin_aggregator_service.ListCategorySuggestionRequest
+	(*ListCategorySuggestionResponse)(nil),            // 188: fin_aggregator_service.ListCategorySuggestionResponse
+	(*AcceptCategorySuggestionRequest)(nil),           // 189: fin_aggregator_service.AcceptCategorySuggestionRequest
+	(*AcceptCategorySuggestionResponse)(nil),          // 190: fin_aggregator_service.AcceptCategorySuggestionResponse
+	(*RejectCategorySuggestionRequest)(nil),           // 191: fin_aggregator_service.RejectCategorySuggestionRequest
+	(*RejectCategorySuggestionResponse)(nil),          // 192: fin_aggregator_service.RejectCategorySuggestionResponse
+	(*CategorizationModel)(nil),                       // 193: fin_aggregator_service.CategorizationModel
+	(*TrainCategorizationModelRequest)(nil),           // 194: fin_aggregator_service.TrainCategorizationModelRequest
+	(*TrainCategorizationModelResponse)(nil),          // 195: fin_aggregator_service.TrainCategorizationModelResponse
+	(*GetCategorizationModelRequest)(nil),             // 196: fin_aggregator_service.GetCategorizationModelRequest
+	(*GetCategorizationModelResponse)(nil),            // 197: fin_aggregator_service.GetCategorizationModelResponse
+	(*CategoryPrediction)(nil),                        // 198: fin_aggregator_service.CategoryPrediction
+	(*ListTransactionCategoryPredictionRequest)(nil),  // 199: fin_aggregator_service.ListTransactionCategoryPredictionRequest
+	(*ListTransactionCategoryPredictionResponse)(nil), // 200: fin_aggregator_service.ListTransactionCategoryPredictionResponse
+	(*RecategorizeTransactionsRequest)(nil),           // 201: fin_aggregator_service.RecategorizeTransactionsRequest
+	(*TransactionCategoryChange)(nil),                 // 202: fin_aggregator_service.TransactionCategoryChange
+	(*RecategorizeTransactionsResponse)(nil),          // 203: fin_aggregator_service.RecategorizeTransactionsResponse
+	(*ProviderCategoryMapping)(nil),                   // 204: fin_aggregator_service.ProviderCategoryMapping
+	(*ListProviderCategoryMappingRequest)(nil),        // 205: fin_aggregator_service.ListProviderCategoryMappingRequest
+	(*ListProviderCategoryMappingResponse)(nil),       // 206: fin_aggregator_service.ListProviderCategoryMappingResponse
+	(*CreateProviderCategoryMappingRequest)(nil),      // 207: fin_aggregator_service.CreateProviderCategoryMappingRequest
+	(*CreateProviderCategoryMappingResponse)(nil),     // 208: fin_aggregator_service.CreateProviderCategoryMappingResponse
+	(*UpdateProviderCategoryMappingRequest)(nil),      // 209: fin_aggregator_service.UpdateProviderCategoryMappingRequest
+	(*UpdateProviderCategoryMappingResponse)(nil),     // 210: fin_aggregator_service.UpdateProviderCategoryMappingResponse
+	(*DeleteProviderCategoryMappingRequest)(nil),      // 211: fin_aggregator_service.DeleteProviderCategoryMappingRequest
+	(*DeleteProviderCategoryMappingResponse)(nil),     // 212: fin_aggregator_service.DeleteProviderCategoryMappingResponse
+	(*ExplainTransactionCategoryRequest)(nil),         // 213: fin_aggregator_service.ExplainTransactionCategoryRequest
+	(*CategoryKeywordMatch)(nil),                      // 214: fin_aggregator_service.CategoryKeywordMatch
+	(*CategoryEvaluation)(nil),                        // 215: fin_aggregator_service.CategoryEvaluation
+	(*ExplainTransactionCategoryResponse)(nil),        // 216: fin_aggregator_service.ExplainTransactionCategoryResponse
+	(*ReloadCachesRequest)(nil),                       // 217: fin_aggregator_service.ReloadCachesRequest
+	(*ReloadCachesResponse)(nil),                      // 218: fin_aggregator_service.ReloadCachesResponse
+	(*BankHeader)(nil),                                // 219: fin_aggregator_service.BankHeader
+	(*ListBankHeaderRequest)(nil),                     // 220: fin_aggregator_service.ListBankHeaderRequest
+	(*ListBankHeaderResponse)(nil),                    // 221: fin_aggregator_service.ListBankHeaderResponse
+	(*CreateBankHeaderRequest)(nil),                   // 222: fin_aggregator_service.CreateBankHeaderRequest
+	(*CreateBankHeaderResponse)(nil),                  // 223: fin_aggregator_service.CreateBankHeaderResponse
+	(*UpdateBankHeaderRequest)(nil),                   // 224: fin_aggregator_service.UpdateBankHeaderRequest
+	(*UpdateBankHeaderResponse)(nil),                  // 225: fin_aggregator_service.UpdateBankHeaderResponse
+	(*DeleteBankHeaderRequest)(nil),                   // 226: fin_aggregator_service.DeleteBankHeaderRequest
+	(*DeleteBankHeaderResponse)(nil),                  // 227: fin_aggregator_service.DeleteBankHeaderResponse
+	(*BankParsingProfile)(nil),                        // 228: fin_aggregator_service.BankParsingProfile
+	(*GetBankParsingProfileRequest)(nil),              // 229: fin_aggregator_service.GetBankParsingProfileRequest
+	(*GetBankParsingProfileResponse)(nil),             // 230: fin_aggregator_service.GetBankParsingProfileResponse
+	(*UpdateBankParsingProfileRequest)(nil),           // 231: fin_aggregator_service.UpdateBankParsingProfileRequest
+	(*UpdateBankParsingProfileResponse)(nil),          // 232: fin_aggregator_service.UpdateBankParsingProfileResponse
+	(*DeleteBankParsingProfileRequest)(nil),           // 233: fin_aggregator_service.DeleteBankParsingProfileRequest
+	(*DeleteBankParsingProfileResponse)(nil),          // 234: fin_aggregator_service.DeleteBankParsingProfileResponse
+	(*timestamppb.Timestamp)(nil),                     // 235: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),                         // 236: google.api.HttpBody
 }
 var file_api_fin_aggregate_service_fin_aggregate_service_proto_depIdxs = []int32{
-	29, // 0: fin_aggregator_service.Transaction.transaction_date:type_name -> google.protobuf.Timestamp
-	0,  // 1: fin_aggregator_service.Transaction.type:type_name -> fin_aggregator_service.TransactionType
-	29, // 2: fin_aggregator_service.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 3: fin_aggregator_service.GetTransactionsResponse.transactions:type_name -> fin_aggregator_service.Transaction
-	0,  // 4: fin_aggregator_service.UpdateTransactionRequest.type:type_name -> fin_aggregator_service.TransactionType
-	2,  // 5: fin_aggregator_service.UpdateTransactionResponse.transaction:type_name -> fin_aggregator_service.Transaction
-	29, // 6: fin_aggregator_service.LoadMonzoTransactionsRequest.since:type_name -> google.protobuf.Timestamp
-	29, // 7: fin_aggregator_service.LoadMonzoTransactionsRequest.before:type_name -> google.protobuf.Timestamp
-	17, // 8: fin_aggregator_service.UploadCSVResponse.record_error:type_name -> fin_aggregator_service.RecordError
-	20, // 9: fin_aggregator_service.ListBankResponse.banks:type_name -> fin_aggregator_service.Bank
-	1,  // 10: fin_aggregator_service.Bank.import_method:type_name -> fin_aggregator_service.BankImportMethod
-	23, // 11: fin_aggregator_service.ListUserResponse.users:type_name -> fin_aggregator_service.User
-	26, // 12: fin_aggregator_service.ListCategoryResponse.category:type_name -> fin_aggregator_service.Category
-	0,  // 13: fin_aggregator_service.ListTransactionTypeResponse.type:type_name -> fin_aggregator_service.TransactionType
-	3,  // 14: fin_aggregator_service.FinAggregatorService.GetTransactions:input_type -> fin_aggregator_service.GetTransactionsRequest
-	5,  // 15: fin_aggregator_service.FinAggregatorService.UpdateTransaction:input_type -> fin_aggregator_service.UpdateTransactionRequest
-	11, // 16: fin_aggregator_service.FinAggregatorService.GetMonzoAuthURL:input_type -> fin_aggregator_service.GetMonzoAuthURLRequest
-	7,  // 17: fin_aggregator_service.FinAggregatorService.MonzoCallback:input_type -> fin_aggregator_service.MonzoCallbackRequest
-	9,  // 18: fin_aggregator_service.FinAggregatorService.GetMonzoAccount:input_type -> fin_aggregator_service.MonzoAccountRequest
-	13, // 19: fin_aggregator_service.FinAggregatorService.LoadMonzoTransactions:input_type -> fin_aggregator_service.LoadMonzoTransactionsRequest
-	15, // 20: fin_aggregator_service.FinAggregatorService.UploadCSV:input_type -> fin_aggregator_service.UploadCSVRequest
-	18, // 21: fin_aggregator_service.FinAggregatorService.ListBank:input_type -> fin_aggregator_service.ListBankRequest
-	21, // 22: fin_aggregator_service.FinAggregatorService.ListUser:input_type -> fin_aggregator_service.ListUserRequest
-	24, // 23: fin_aggregator_service.FinAggregatorService.ListCategory:input_type -> fin_aggregator_service.ListCategoryRequest
-	27, // 24: fin_aggregator_service.FinAggregatorService.ListTransactionType:input_type -> fin_aggregator_service.ListTransactionTypeRequest
-	4,  // 25: fin_aggregator_service.FinAggregatorService.GetTransactions:output_type -> fin_aggregator_service.GetTransactionsResponse
-	6,  // 26: fin_aggregator_service.FinAggregatorService.UpdateTransaction:output_type -> fin_aggregator_service.UpdateTransactionResponse
-	12, // 27: fin_aggregator_service.FinAggregatorService.GetMonzoAuthURL:output_type -> fin_aggregator_service.GetMonzoAuthURLResponse
-	8,  // 28: fin_aggregator_service.FinAggregatorService.MonzoCallback:output_type -> fin_aggregator_service.MonzoCallbackResponse
-	10, // 29: fin_aggregator_service.FinAggregatorService.GetMonzoAccount:output_type -> fin_aggregator_service.MonzoAccountResponse
-	14, // 30: fin_aggregator_service.FinAggregatorService.LoadMonzoTransactions:output_type -> fin_aggregator_service.LoadMonzoTransactionsResponse
-	16, // 31: fin_aggregator_service.FinAggregatorService.UploadCSV:output_type -> fin_aggregator_service.UploadCSVResponse
-	19, // 32: fin_aggregator_service.FinAggregatorService.ListBank:output_type -> fin_aggregator_service.ListBankResponse
-	22, // 33: fin_aggregator_service.FinAggregatorService.ListUser:output_type -> fin_aggregator_service.ListUserResponse
-	25, // 34: fin_aggregator_service.FinAggregatorService.ListCategory:output_type -> fin_aggregator_service.ListCategoryResponse
-	28, // 35: fin_aggregator_service.FinAggregatorService.ListTransactionType:output_type -> fin_aggregator_service.ListTransactionTypeResponse
-	25, // [25:36] is the sub-list for method output_type
-	14, // [14:25] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	235, // 0: fin_aggregator_service.Transaction.transaction_date:type_name -> google.protobuf.Timestamp
+	0,   // 1: fin_aggregator_service.Transaction.type:type_name -> fin_aggregator_service.TransactionType
+	235, // 2: fin_aggregator_service.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	27,  // 3: fin_aggregator_service.Transaction.category_provenance:type_name -> fin_aggregator_service.CategoryProvenance
+	1,   // 4: fin_aggregator_service.CategoryProvenance.source:type_name -> fin_aggregator_service.CategorySource
+	26,  // 5: fin_aggregator_service.GetTransactionsResponse.transactions:type_name -> fin_aggregator_service.Transaction
+	0,   // 6: fin_aggregator_service.UpdateTransactionRequest.type:type_name -> fin_aggregator_service.TransactionType
+	26,  // 7: fin_aggregator_service.UpdateTransactionResponse.transaction:type_name -> fin_aggregator_service.Transaction
+	36,  // 8: fin_aggregator_service.MonzoAccountResponse.accounts:type_name -> fin_aggregator_service.MonzoAccount
+	235, // 9: fin_aggregator_service.LoadMonzoTransactionsRequest.since:type_name -> google.protobuf.Timestamp
+	235, // 10: fin_aggregator_service.LoadMonzoTransactionsRequest.before:type_name -> google.protobuf.Timestamp
+	44,  // 11: fin_aggregator_service.UploadCSVResponse.record_error:type_name -> fin_aggregator_service.RecordError
+	43,  // 12: fin_aggregator_service.UploadCSVResponse.dialect:type_name -> fin_aggregator_service.CsvDialect
+	25,  // 13: fin_aggregator_service.CsvDialect.encoding:type_name -> fin_aggregator_service.CsvEncoding
+	47,  // 14: fin_aggregator_service.ListBankResponse.banks:type_name -> fin_aggregator_service.Bank
+	2,   // 15: fin_aggregator_service.Bank.import_method:type_name -> fin_aggregator_service.BankImportMethod
+	50,  // 16: fin_aggregator_service.ListUserResponse.users:type_name -> fin_aggregator_service.User
+	53,  // 17: fin_aggregator_service.ListCategoryResponse.category:type_name -> fin_aggregator_service.Category
+	53,  // 18: fin_aggregator_service.Category.children:type_name -> fin_aggregator_service.Category
+	3,   // 19: fin_aggregator_service.Category.kind:type_name -> fin_aggregator_service.CategoryKind
+	0,   // 20: fin_aggregator_service.ListTransactionTypeResponse.type:type_name -> fin_aggregator_service.TransactionType
+	58,  // 21: fin_aggregator_service.GetSpendingInsightsResponse.categories:type_name -> fin_aggregator_service.CategorySpendingInsight
+	59,  // 22: fin_aggregator_service.GetSpendingInsightsResponse.large_expenses:type_name -> fin_aggregator_service.TransactionSpendingInsight
+	235, // 23: fin_aggregator_service.TransactionSpendingInsight.transaction_date:type_name -> google.protobuf.Timestamp
+	4,   // 24: fin_aggregator_service.TransactionSpendingInsight.baseline_source:type_name -> fin_aggregator_service.InsightBaselineSource
+	5,   // 25: fin_aggregator_service.SharedExpense.split_method:type_name -> fin_aggregator_service.SplitMethod
+	60,  // 26: fin_aggregator_service.SharedExpense.shares:type_name -> fin_aggregator_service.ExpenseShare
+	235, // 27: fin_aggregator_service.SharedExpense.created_at:type_name -> google.protobuf.Timestamp
+	5,   // 28: fin_aggregator_service.MarkSharedExpenseRequest.split_method:type_name -> fin_aggregator_service.SplitMethod
+	60,  // 29: fin_aggregator_service.MarkSharedExpenseRequest.shares:type_name -> fin_aggregator_service.ExpenseShare
+	61,  // 30: fin_aggregator_service.MarkSharedExpenseResponse.shared_expense:type_name -> fin_aggregator_service.SharedExpense
+	61,  // 31: fin_aggregator_service.ListSharedExpenseResponse.shared_expenses:type_name -> fin_aggregator_service.SharedExpense
+	68,  // 32: fin_aggregator_service.GetUserBalancesResponse.balances:type_name -> fin_aggregator_service.UserBalance
+	71,  // 33: fin_aggregator_service.GetSettleUpSuggestionsResponse.suggestions:type_name -> fin_aggregator_service.SettlementSuggestion
+	235, // 34: fin_aggregator_service.Settlement.settled_at:type_name -> google.protobuf.Timestamp
+	235, // 35: fin_aggregator_service.Settlement.created_at:type_name -> google.protobuf.Timestamp
+	235, // 36: fin_aggregator_service.RecordSettlementRequest.settled_at:type_name -> google.protobuf.Timestamp
+	74,  // 37: fin_aggregator_service.RecordSettlementResponse.settlement:type_name -> fin_aggregator_service.Settlement
+	74,  // 38: fin_aggregator_service.ListSettlementResponse.settlements:type_name -> fin_aggregator_service.Settlement
+	6,   // 39: fin_aggregator_service.Account.type:type_name -> fin_aggregator_service.AccountType
+	235, // 40: fin_aggregator_service.Account.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 41: fin_aggregator_service.CreateAccountRequest.type:type_name -> fin_aggregator_service.AccountType
+	79,  // 42: fin_aggregator_service.CreateAccountResponse.account:type_name -> fin_aggregator_service.Account
+	6,   // 43: fin_aggregator_service.UpdateAccountRequest.type:type_name -> fin_aggregator_service.AccountType
+	79,  // 44: fin_aggregator_service.UpdateAccountResponse.account:type_name -> fin_aggregator_service.Account
+	79,  // 45: fin_aggregator_service.GetAccountResponse.account:type_name -> fin_aggregator_service.Account
+	79,  // 46: fin_aggregator_service.ListAccountResponse.accounts:type_name -> fin_aggregator_service.Account
+	6,   // 47: fin_aggregator_service.ListAccountTypeResponse.type:type_name -> fin_aggregator_service.AccountType
+	235, // 48: fin_aggregator_service.BalanceSnapshot.balance_date:type_name -> google.protobuf.Timestamp
+	7,   // 49: fin_aggregator_service.BalanceSnapshot.source:type_name -> fin_aggregator_service.BalanceSnapshotSource
+	235, // 50: fin_aggregator_service.BalanceSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	235, // 51: fin_aggregator_service.CreateBalanceSnapshotRequest.balance_date:type_name -> google.protobuf.Timestamp
+	92,  // 52: fin_aggregator_service.CreateBalanceSnapshotResponse.snapshot:type_name -> fin_aggregator_service.BalanceSnapshot
+	235, // 53: fin_aggregator_service.ListBalanceSnapshotRequest.from:type_name -> google.protobuf.Timestamp
+	235, // 54: fin_aggregator_service.ListBalanceSnapshotRequest.to:type_name -> google.protobuf.Timestamp
+	92,  // 55: fin_aggregator_service.ListBalanceSnapshotResponse.snapshots:type_name -> fin_aggregator_service.BalanceSnapshot
+	235, // 56: fin_aggregator_service.ReconciliationPeriod.start_date:type_name -> google.protobuf.Timestamp
+	235, // 57: fin_aggregator_service.ReconciliationPeriod.end_date:type_name -> google.protobuf.Timestamp
+	8,   // 58: fin_aggregator_service.ReconciliationPeriod.status:type_name -> fin_aggregator_service.ReconciliationStatus
+	235, // 59: fin_aggregator_service.ReconcileAccountRequest.from:type_name -> google.protobuf.Timestamp
+	235, // 60: fin_aggregator_service.ReconcileAccountRequest.to:type_name -> google.protobuf.Timestamp
+	99,  // 61: fin_aggregator_service.ReconcileAccountResponse.periods:type_name -> fin_aggregator_service.ReconciliationPeriod
+	99,  // 62: fin_aggregator_service.ReconcileAccountResponse.first_issue:type_name -> fin_aggregator_service.ReconciliationPeriod
+	9,   // 63: fin_aggregator_service.Asset.kind:type_name -> fin_aggregator_service.AssetKind
+	10,  // 64: fin_aggregator_service.Asset.asset_class:type_name -> fin_aggregator_service.AssetClass
+	235, // 65: fin_aggregator_service.Asset.created_at:type_name -> google.protobuf.Timestamp
+	9,   // 66: fin_aggregator_service.CreateAssetRequest.kind:type_name -> fin_aggregator_service.AssetKind
+	10,  // 67: fin_aggregator_service.CreateAssetRequest.asset_class:type_name -> fin_aggregator_service.AssetClass
+	102, // 68: fin_aggregator_service.CreateAssetResponse.asset:type_name -> fin_aggregator_service.Asset
+	10,  // 69: fin_aggregator_service.UpdateAssetRequest.asset_class:type_name -> fin_aggregator_service.AssetClass
+	102, // 70: fin_aggregator_service.UpdateAssetResponse.asset:type_name -> fin_aggregator_service.Asset
+	102, // 71: fin_aggregator_service.ListAssetResponse.assets:type_name -> fin_aggregator_service.Asset
+	235, // 72: fin_aggregator_service.AssetValuation.valuation_date:type_name -> google.protobuf.Timestamp
+	235, // 73: fin_aggregator_service.AddAssetValuationRequest.valuation_date:type_name -> google.protobuf.Timestamp
+	111, // 74: fin_aggregator_service.AddAssetValuationResponse.valuation:type_name -> fin_aggregator_service.AssetValuation
+	111, // 75: fin_aggregator_service.ListAssetValuationResponse.valuations:type_name -> fin_aggregator_service.AssetValuation
+	10,  // 76: fin_aggregator_service.AssetClassValue.asset_class:type_name -> fin_aggregator_service.AssetClass
+	9,   // 77: fin_aggregator_service.AssetClassValue.kind:type_name -> fin_aggregator_service.AssetKind
+	235, // 78: fin_aggregator_service.NetWorthPoint.date:type_name -> google.protobuf.Timestamp
+	116, // 79: fin_aggregator_service.NetWorthPoint.breakdown:type_name -> fin_aggregator_service.AssetClassValue
+	235, // 80: fin_aggregator_service.GetNetWorthHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	235, // 81: fin_aggregator_service.GetNetWorthHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	117, // 82: fin_aggregator_service.GetNetWorthHistoryResponse.points:type_name -> fin_aggregator_service.NetWorthPoint
+	235, // 83: fin_aggregator_service.SavingsGoal.target_date:type_name -> google.protobuf.Timestamp
+	235, // 84: fin_aggregator_service.SavingsGoal.start_date:type_name -> google.protobuf.Timestamp
+	235, // 85: fin_aggregator_service.SavingsGoal.created_at:type_name -> google.protobuf.Timestamp
+	235, // 86: fin_aggregator_service.CreateSavingsGoalRequest.target_date:type_name -> google.protobuf.Timestamp
+	235, // 87: fin_aggregator_service.CreateSavingsGoalRequest.start_date:type_name -> google.protobuf.Timestamp
+	120, // 88: fin_aggregator_service.CreateSavingsGoalResponse.goal:type_name -> fin_aggregator_service.SavingsGoal
+	235, // 89: fin_aggregator_service.UpdateSavingsGoalRequest.target_date:type_name -> google.protobuf.Timestamp
+	120, // 90: fin_aggregator_service.UpdateSavingsGoalResponse.goal:type_name -> fin_aggregator_service.SavingsGoal
+	120, // 91: fin_aggregator_service.ListSavingsGoalResponse.goals:type_name -> fin_aggregator_service.SavingsGoal
+	120, // 92: fin_aggregator_service.GetSavingsGoalStatusResponse.goal:type_name -> fin_aggregator_service.SavingsGoal
+	11,  // 93: fin_aggregator_service.GetSavingsGoalStatusResponse.status:type_name -> fin_aggregator_service.SavingsGoalStatus
+	12,  // 94: fin_aggregator_service.GenerateReportRequest.period:type_name -> fin_aggregator_service.ReportPeriod
+	13,  // 95: fin_aggregator_service.GenerateReportRequest.format:type_name -> fin_aggregator_service.ReportFormat
+	14,  // 96: fin_aggregator_service.AlertRule.rule_type:type_name -> fin_aggregator_service.AlertRuleType
+	15,  // 97: fin_aggregator_service.AlertRule.period:type_name -> fin_aggregator_service.AlertPeriod
+	16,  // 98: fin_aggregator_service.AlertRule.channel:type_name -> fin_aggregator_service.AlertChannel
+	235, // 99: fin_aggregator_service.AlertRule.created_at:type_name -> google.protobuf.Timestamp
+	235, // 100: fin_aggregator_service.Alert.created_at:type_name -> google.protobuf.Timestamp
+	14,  // 101: fin_aggregator_service.CreateAlertRuleRequest.rule_type:type_name -> fin_aggregator_service.AlertRuleType
+	15,  // 102: fin_aggregator_service.CreateAlertRuleRequest.period:type_name -> fin_aggregator_service.AlertPeriod
+	16,  // 103: fin_aggregator_service.CreateAlertRuleRequest.channel:type_name -> fin_aggregator_service.AlertChannel
+	132, // 104: fin_aggregator_service.CreateAlertRuleResponse.rule:type_name -> fin_aggregator_service.AlertRule
+	15,  // 105: fin_aggregator_service.UpdateAlertRuleRequest.period:type_name -> fin_aggregator_service.AlertPeriod
+	16,  // 106: fin_aggregator_service.UpdateAlertRuleRequest.channel:type_name -> fin_aggregator_service.AlertChannel
+	132, // 107: fin_aggregator_service.UpdateAlertRuleResponse.rule:type_name -> fin_aggregator_service.AlertRule
+	132, // 108: fin_aggregator_service.ListAlertRuleResponse.rules:type_name -> fin_aggregator_service.AlertRule
+	133, // 109: fin_aggregator_service.ListAlertResponse.alerts:type_name -> fin_aggregator_service.Alert
+	235, // 110: fin_aggregator_service.WebhookSubscription.created_at:type_name -> google.protobuf.Timestamp
+	17,  // 111: fin_aggregator_service.WebhookDelivery.status:type_name -> fin_aggregator_service.WebhookDeliveryStatus
+	235, // 112: fin_aggregator_service.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	235, // 113: fin_aggregator_service.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	235, // 114: fin_aggregator_service.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	146, // 115: fin_aggregator_service.CreateWebhookSubscriptionResponse.subscription:type_name -> fin_aggregator_service.WebhookSubscription
+	146, // 116: fin_aggregator_service.UpdateWebhookSubscriptionResponse.subscription:type_name -> fin_aggregator_service.WebhookSubscription
+	146, // 117: fin_aggregator_service.ListWebhookSubscriptionResponse.subscriptions:type_name -> fin_aggregator_service.WebhookSubscription
+	17,  // 118: fin_aggregator_service.ListWebhookDeliveryRequest.status:type_name -> fin_aggregator_service.WebhookDeliveryStatus
+	147, // 119: fin_aggregator_service.ListWebhookDeliveryResponse.deliveries:type_name -> fin_aggregator_service.WebhookDelivery
+	147, // 120: fin_aggregator_service.RedeliverWebhookResponse.delivery:type_name -> fin_aggregator_service.WebhookDelivery
+	18,  // 121: fin_aggregator_service.CategorizationCondition.type:type_name -> fin_aggregator_service.CategorizationConditionType
+	19,  // 122: fin_aggregator_service.CategorizationCondition.sign:type_name -> fin_aggregator_service.AmountSign
+	0,   // 123: fin_aggregator_service.CategorizationActions.type:type_name -> fin_aggregator_service.TransactionType
+	160, // 124: fin_aggregator_service.CategorizationRule.conditions:type_name -> fin_aggregator_service.CategorizationCondition
+	161, // 125: fin_aggregator_service.CategorizationRule.actions:type_name -> fin_aggregator_service.CategorizationActions
+	235, // 126: fin_aggregator_service.CategorizationRule.created_at:type_name -> google.protobuf.Timestamp
+	235, // 127: fin_aggregator_service.CategorizationRule.last_hit_at:type_name -> google.protobuf.Timestamp
+	160, // 128: fin_aggregator_service.CreateCategorizationRuleRequest.conditions:type_name -> fin_aggregator_service.CategorizationCondition
+	161, // 129: fin_aggregator_service.CreateCategorizationRuleRequest.actions:type_name -> fin_aggregator_service.CategorizationActions
+	162, // 130: fin_aggregator_service.CreateCategorizationRuleResponse.rule:type_name -> fin_aggregator_service.CategorizationRule
+	160, // 131: fin_aggregator_service.UpdateCategorizationRuleRequest.conditions:type_name -> fin_aggregator_service.CategorizationCondition
+	161, // 132: fin_aggregator_service.UpdateCategorizationRuleRequest.actions:type_name -> fin_aggregator_service.CategorizationActions
+	162, // 133: fin_aggregator_service.UpdateCategorizationRuleResponse.rule:type_name -> fin_aggregator_service.CategorizationRule
+	162, // 134: fin_aggregator_service.ListCategorizationRuleResponse.rules:type_name -> fin_aggregator_service.CategorizationRule
+	3,   // 135: fin_aggregator_service.CreateCategoryRequest.kind:type_name -> fin_aggregator_service.CategoryKind
+	53,  // 136: fin_aggregator_service.CreateCategoryResponse.category:type_name -> fin_aggregator_service.Category
+	3,   // 137: fin_aggregator_service.UpdateCategoryRequest.kind:type_name -> fin_aggregator_service.CategoryKind
+	53,  // 138: fin_aggregator_service.UpdateCategoryResponse.category:type_name -> fin_aggregator_service.Category
+	171, // 139: fin_aggregator_service.ListCategoryKeywordResponse.keywords:type_name -> fin_aggregator_service.CategoryKeyword
+	171, // 140: fin_aggregator_service.AddCategoryKeywordResponse.keyword:type_name -> fin_aggregator_service.CategoryKeyword
+	20,  // 141: fin_aggregator_service.CategorySuggestion.status:type_name -> fin_aggregator_service.CategorySuggestionStatus
+	21,  // 142: fin_aggregator_service.CategorySuggestion.kind:type_name -> fin_aggregator_service.CategorySuggestionKind
+	235, // 143: fin_aggregator_service.CategorySuggestion.created_at:type_name -> google.protobuf.Timestamp
+	20,  // 144: fin_aggregator_service.ListCategorySuggestionRequest.status:type_name -> fin_aggregator_service.CategorySuggestionStatus
+	186, // 145: fin_aggregator_service.ListCategorySuggestionResponse.suggestions:type_name -> fin_aggregator_service.CategorySuggestion
+	21,  // 146: fin_aggregator_service.AcceptCategorySuggestionRequest.kind:type_name -> fin_aggregator_service.CategorySuggestionKind
+	186, // 147: fin_aggregator_service.AcceptCategorySuggestionResponse.suggestion:type_name -> fin_aggregator_service.CategorySuggestion
+	186, // 148: fin_aggregator_service.RejectCategorySuggestionResponse.suggestion:type_name -> fin_aggregator_service.CategorySuggestion
+	235, // 149: fin_aggregator_service.CategorizationModel.trained_at:type_name -> google.protobuf.Timestamp
+	193, // 150: fin_aggregator_service.TrainCategorizationModelResponse.model:type_name -> fin_aggregator_service.CategorizationModel
+	193, // 151: fin_aggregator_service.GetCategorizationModelResponse.model:type_name -> fin_aggregator_service.CategorizationModel
+	198, // 152: fin_aggregator_service.ListTransactionCategoryPredictionResponse.predictions:type_name -> fin_aggregator_service.CategoryPrediction
+	235, // 153: fin_aggregator_service.RecategorizeTransactionsRequest.from:type_name -> google.protobuf.Timestamp
+	235, // 154: fin_aggregator_service.RecategorizeTransactionsRequest.to:type_name -> google.protobuf.Timestamp
+	235, // 155: fin_aggregator_service.TransactionCategoryChange.transaction_date:type_name -> google.protobuf.Timestamp
+	0,   // 156: fin_aggregator_service.TransactionCategoryChange.previous_type:type_name -> fin_aggregator_service.TransactionType
+	0,   // 157: fin_aggregator_service.TransactionCategoryChange.type:type_name -> fin_aggregator_service.TransactionType
+	202, // 158: fin_aggregator_service.RecategorizeTransactionsResponse.changes:type_name -> fin_aggregator_service.TransactionCategoryChange
+	235, // 159: fin_aggregator_service.ProviderCategoryMapping.created_at:type_name -> google.protobuf.Timestamp
+	204, // 160: fin_aggregator_service.ListProviderCategoryMappingResponse.mappings:type_name -> fin_aggregator_service.ProviderCategoryMapping
+	204, // 161: fin_aggregator_service.CreateProviderCategoryMappingResponse.mapping:type_name -> fin_aggregator_service.ProviderCategoryMapping
+	204, // 162: fin_aggregator_service.UpdateProviderCategoryMappingResponse.mapping:type_name -> fin_aggregator_service.ProviderCategoryMapping
+	27,  // 163: fin_aggregator_service.CategoryEvaluation.provenance:type_name -> fin_aggregator_service.CategoryProvenance
+	214, // 164: fin_aggregator_service.CategoryEvaluation.keyword_matches:type_name -> fin_aggregator_service.CategoryKeywordMatch
+	27,  // 165: fin_aggregator_service.ExplainTransactionCategoryResponse.provenance:type_name -> fin_aggregator_service.CategoryProvenance
+	215, // 166: fin_aggregator_service.ExplainTransactionCategoryResponse.current:type_name -> fin_aggregator_service.CategoryEvaluation
+	22,  // 167: fin_aggregator_service.BankHeader.transaction_fields:type_name -> fin_aggregator_service.TransactionField
+	219, // 168: fin_aggregator_service.ListBankHeaderResponse.headers:type_name -> fin_aggregator_service.BankHeader
+	22,  // 169: fin_aggregator_service.ListBankHeaderResponse.missing_required_fields:type_name -> fin_aggregator_service.TransactionField
+	22,  // 170: fin_aggregator_service.CreateBankHeaderRequest.transaction_fields:type_name -> fin_aggregator_service.TransactionField
+	219, // 171: fin_aggregator_service.CreateBankHeaderResponse.header:type_name -> fin_aggregator_service.BankHeader
+	22,  // 172: fin_aggregator_service.UpdateBankHeaderRequest.transaction_fields:type_name -> fin_aggregator_service.TransactionField
+	219, // 173: fin_aggregator_service.UpdateBankHeaderResponse.header:type_name -> fin_aggregator_service.BankHeader
+	23,  // 174: fin_aggregator_service.BankParsingProfile.sign_convention:type_name -> fin_aggregator_service.SignConvention
+	24,  // 175: fin_aggregator_service.BankParsingProfile.amount_mode:type_name -> fin_aggregator_service.AmountMode
+	25,  // 176: fin_aggregator_service.BankParsingProfile.encoding:type_name -> fin_aggregator_service.CsvEncoding
+	228, // 177: fin_aggregator_service.GetBankParsingProfileResponse.profile:type_name -> fin_aggregator_service.BankParsingProfile
+	23,  // 178: fin_aggregator_service.UpdateBankParsingProfileRequest.sign_convention:type_name -> fin_aggregator_service.SignConvention
+	24,  // 179: fin_aggregator_service.UpdateBankParsingProfileRequest.amount_mode:type_name -> fin_aggregator_service.AmountMode
+	25,  // 180: fin_aggregator_service.UpdateBankParsingProfileRequest.encoding:type_name -> fin_aggregator_service.CsvEncoding
+	228, // 181: fin_aggregator_service.UpdateBankParsingProfileResponse.profile:type_name -> fin_aggregator_service.BankParsingProfile
+	28,  // 182: fin_aggregator_service.FinAggregatorService.GetTransactions:input_type -> fin_aggregator_service.GetTransactionsRequest
+	30,  // 183: fin_aggregator_service.FinAggregatorService.UpdateTransaction:input_type -> fin_aggregator_service.UpdateTransactionRequest
+	37,  // 184: fin_aggregator_service.FinAggregatorService.GetMonzoAuthURL:input_type -> fin_aggregator_service.GetMonzoAuthURLRequest
+	32,  // 185: fin_aggregator_service.FinAggregatorService.MonzoCallback:input_type -> fin_aggregator_service.MonzoCallbackRequest
+	34,  // 186: fin_aggregator_service.FinAggregatorService.GetMonzoAccount:input_type -> fin_aggregator_service.MonzoAccountRequest
+	39,  // 187: fin_aggregator_service.FinAggregatorService.LoadMonzoTransactions:input_type -> fin_aggregator_service.LoadMonzoTransactionsRequest
+	41,  // 188: fin_aggregator_service.FinAggregatorService.UploadCSV:input_type -> fin_aggregator_service.UploadCSVRequest
+	45,  // 189: fin_aggregator_service.FinAggregatorService.ListBank:input_type -> fin_aggregator_service.ListBankRequest
+	48,  // 190: fin_aggregator_service.FinAggregatorService.ListUser:input_type -> fin_aggregator_service.ListUserRequest
+	51,  // 191: fin_aggregator_service.FinAggregatorService.ListCategory:input_type -> fin_aggregator_service.ListCategoryRequest
+	54,  // 192: fin_aggregator_service.FinAggregatorService.ListTransactionType:input_type -> fin_aggregator_service.ListTransactionTypeRequest
+	56,  // 193: fin_aggregator_service.FinAggregatorService.GetSpendingInsights:input_type -> fin_aggregator_service.GetSpendingInsightsRequest
+	62,  // 194: fin_aggregator_service.FinAggregatorService.MarkSharedExpense:input_type -> fin_aggregator_service.MarkSharedExpenseRequest
+	64,  // 195: fin_aggregator_service.FinAggregatorService.UnmarkSharedExpense:input_type -> fin_aggregator_service.UnmarkSharedExpenseRequest
+	66,  // 196: fin_aggregator_service.FinAggregatorService.ListSharedExpense:input_type -> fin_aggregator_service.ListSharedExpenseRequest
+	69,  // 197: fin_aggregator_service.FinAggregatorService.GetUserBalances:input_type -> fin_aggregator_service.GetUserBalancesRequest
+	72,  // 198: fin_aggregator_service.FinAggregatorService.GetSettleUpSuggestions:input_type -> fin_aggregator_service.GetSettleUpSuggestionsRequest
+	75,  // 199: fin_aggregator_service.FinAggregatorService.RecordSettlement:input_type -> fin_aggregator_service.RecordSettlementRequest
+	77,  // 200: fin_aggregator_service.FinAggregatorService.ListSettlement:input_type -> fin_aggregator_service.ListSettlementRequest
+	80,  // 201: fin_aggregator_service.FinAggregatorService.CreateAccount:input_type -> fin_aggregator_service.CreateAccountRequest
+	82,  // 202: fin_aggregator_service.FinAggregatorService.UpdateAccount:input_type -> fin_aggregator_service.UpdateAccountRequest
+	84,  // 203: fin_aggregator_service.FinAggregatorService.DeleteAccount:input_type -> fin_aggregator_service.DeleteAccountRequest
+	86,  // 204: fin_aggregator_service.FinAggregatorService.GetAccount:input_type -> fin_aggregator_service.GetAccountRequest
+	88,  // 205: fin_aggregator_service.FinAggregatorService.ListAccount:input_type -> fin_aggregator_service.ListAccountRequest
+	90,  // 206: fin_aggregator_service.FinAggregatorService.ListAccountType:input_type -> fin_aggregator_service.ListAccountTypeRequest
+	93,  // 207: fin_aggregator_service.FinAggregatorService.CreateBalanceSnapshot:input_type -> fin_aggregator_service.CreateBalanceSnapshotRequest
+	95,  // 208: fin_aggregator_service.FinAggregatorService.ListBalanceSnapshot:input_type -> fin_aggregator_service.ListBalanceSnapshotRequest
+	97,  // 209: fin_aggregator_service.FinAggregatorService.DeleteBalanceSnapshot:input_type -> fin_aggregator_service.DeleteBalanceSnapshotRequest
+	100, // 210: fin_aggregator_service.FinAggregatorService.ReconcileAccount:input_type -> fin_aggregator_service.ReconcileAccountRequest
+	103, // 211: fin_aggregator_service.FinAggregatorService.CreateAsset:input_type -> fin_aggregator_service.CreateAssetRequest
+	105, // 212: fin_aggregator_service.FinAggregatorService.UpdateAsset:input_type -> fin_aggregator_service.UpdateAssetRequest
+	107, // 213: fin_aggregator_service.FinAggregatorService.DeleteAsset:input_type -> fin_aggregator_service.DeleteAssetRequest
+	109, // 214: fin_aggregator_service.FinAggregatorService.ListAsset:input_type -> fin_aggregator_service.ListAssetRequest
+	112, // 215: fin_aggregator_service.FinAggregatorService.AddAssetValuation:input_type -> fin_aggregator_service.AddAssetValuationRequest
+	114, // 216: fin_aggregator_service.FinAggregatorService.ListAssetValuation:input_type -> fin_aggregator_service.ListAssetValuationRequest
+	118, // 217: fin_aggregator_service.FinAggregatorService.GetNetWorthHistory:input_type -> fin_aggregator_service.GetNetWorthHistoryRequest
+	121, // 218: fin_aggregator_service.FinAggregatorService.CreateSavingsGoal:input_type -> fin_aggregator_service.CreateSavingsGoalRequest
+	123, // 219: fin_aggregator_service.FinAggregatorService.UpdateSavingsGoal:input_type -> fin_aggregator_service.UpdateSavingsGoalRequest
+	125, // 220: fin_aggregator_service.FinAggregatorService.DeleteSavingsGoal:input_type -> fin_aggregator_service.DeleteSavingsGoalRequest
+	127, // 221: fin_aggregator_service.FinAggregatorService.ListSavingsGoal:input_type -> fin_aggregator_service.ListSavingsGoalRequest
+	129, // 222: fin_aggregator_service.FinAggregatorService.GetSavingsGoalStatus:input_type -> fin_aggregator_service.GetSavingsGoalStatusRequest
+	131, // 223: fin_aggregator_service.FinAggregatorService.GenerateReport:input_type -> fin_aggregator_service.GenerateReportRequest
+	134, // 224: fin_aggregator_service.FinAggregatorService.CreateAlertRule:input_type -> fin_aggregator_service.CreateAlertRuleRequest
+	136, // 225: fin_aggregator_service.FinAggregatorService.UpdateAlertRule:input_type -> fin_aggregator_service.UpdateAlertRuleRequest
+	138, // 226: fin_aggregator_service.FinAggregatorService.DeleteAlertRule:input_type -> fin_aggregator_service.DeleteAlertRuleRequest
+	140, // 227: fin_aggregator_service.FinAggregatorService.ListAlertRule:input_type -> fin_aggregator_service.ListAlertRuleRequest
+	142, // 228: fin_aggregator_service.FinAggregatorService.TestAlertRule:input_type -> fin_aggregator_service.TestAlertRuleRequest
+	144, // 229: fin_aggregator_service.FinAggregatorService.ListAlert:input_type -> fin_aggregator_service.ListAlertRequest
+	148, // 230: fin_aggregator_service.FinAggregatorService.CreateWebhookSubscription:input_type -> fin_aggregator_service.CreateWebhookSubscriptionRequest
+	150, // 231: fin_aggregator_service.FinAggregatorService.UpdateWebhookSubscription:input_type -> fin_aggregator_service.UpdateWebhookSubscriptionRequest
+	152, // 232: fin_aggregator_service.FinAggregatorService.DeleteWebhookSubscription:input_type -> fin_aggregator_service.DeleteWebhookSubscriptionRequest
+	154, // 233: fin_aggregator_service.FinAggregatorService.ListWebhookSubscription:input_type -> fin_aggregator_service.ListWebhookSubscriptionRequest
+	156, // 234: fin_aggregator_service.FinAggregatorService.ListWebhookDelivery:input_type -> fin_aggregator_service.ListWebhookDeliveryRequest
+	158, // 235: fin_aggregator_service.FinAggregatorService.RedeliverWebhook:input_type -> fin_aggregator_service.RedeliverWebhookRequest
+	163, // 236: fin_aggregator_service.FinAggregatorService.CreateCategorizationRule:input_type -> fin_aggregator_service.CreateCategorizationRuleRequest
+	165, // 237: fin_aggregator_service.FinAggregatorService.UpdateCategorizationRule:input_type -> fin_aggregator_service.UpdateCategorizationRuleRequest
+	167, // 238: fin_aggregator_service.FinAggregatorService.DeleteCategorizationRule:input_type -> fin_aggregator_service.DeleteCategorizationRuleRequest
+	169, // 239: fin_aggregator_service.FinAggregatorService.ListCategorizationRule:input_type -> fin_aggregator_service.ListCategorizationRuleRequest
+	172, // 240: fin_aggregator_service.FinAggregatorService.CreateCategory:input_type -> fin_aggregator_service.CreateCategoryRequest
+	174, // 241: fin_aggregator_service.FinAggregatorService.UpdateCategory:input_type -> fin_aggregator_service.UpdateCategoryRequest
+	176, // 242: fin_aggregator_service.FinAggregatorService.DeleteCategory:input_type -> fin_aggregator_service.DeleteCategoryRequest
+	178, // 243: fin_aggregator_service.FinAggregatorService.MergeCategory:input_type -> fin_aggregator_service.MergeCategoryRequest
+	180, // 244: fin_aggregator_service.FinAggregatorService.ListCategoryKeyword:input_type -> fin_aggregator_service.ListCategoryKeywordRequest
+	182, // 245: fin_aggregator_service.FinAggregatorService.AddCategoryKeyword:input_type -> fin_aggregator_service.AddCategoryKeywordRequest
+	184, // 246: fin_aggregator_service.FinAggregatorService.DeleteCategoryKeyword:input_type -> fin_aggregator_service.DeleteCategoryKeywordRequest
+	187, // 247: fin_aggregator_service.FinAggregatorService.ListCategorySuggestion:input_type -> fin_aggregator_service.ListCategorySuggestionRequest
+	189, // 248: fin_aggregator_service.FinAggregatorService.AcceptCategorySuggestion:input_type -> fin_aggregator_service.AcceptCategorySuggestionRequest
+	191, // 249: fin_aggregator_service.FinAggregatorService.RejectCategorySuggestion:input_type -> fin_aggregator_service.RejectCategorySuggestionRequest
+	194, // 250: fin_aggregator_service.FinAggregatorService.TrainCategorizationModel:input_type -> fin_aggregator_service.TrainCategorizationModelRequest
+	196, // 251: fin_aggregator_service.FinAggregatorService.GetCategorizationModel:input_type -> fin_aggregator_service.GetCategorizationModelRequest
+	199, // 252: fin_aggregator_service.FinAggregatorService.ListTransactionCategoryPrediction:input_type -> fin_aggregator_service.ListTransactionCategoryPredictionRequest
+	201, // 253: fin_aggregator_service.FinAggregatorService.RecategorizeTransactions:input_type -> fin_aggregator_service.RecategorizeTransactionsRequest
+	205, // 254: fin_aggregator_service.FinAggregatorService.ListProviderCategoryMapping:input_type -> fin_aggregator_service.ListProviderCategoryMappingRequest
+	207, // 255: fin_aggregator_service.FinAggregatorService.CreateProviderCategoryMapping:input_type -> fin_aggregator_service.CreateProviderCategoryMappingRequest
+	209, // 256: fin_aggregator_service.FinAggregatorService.UpdateProviderCategoryMapping:input_type -> fin_aggregator_service.UpdateProviderCategoryMappingRequest
+	211, // 257: fin_aggregator_service.FinAggregatorService.DeleteProviderCategoryMapping:input_type -> fin_aggregator_service.DeleteProviderCategoryMappingRequest
+	213, // 258: fin_aggregator_service.FinAggregatorService.ExplainTransactionCategory:input_type -> fin_aggregator_service.ExplainTransactionCategoryRequest
+	217, // 259: fin_aggregator_service.FinAggregatorService.ReloadCaches:input_type -> fin_aggregator_service.ReloadCachesRequest
+	220, // 260: fin_aggregator_service.FinAggregatorService.ListBankHeader:input_type -> fin_aggregator_service.ListBankHeaderRequest
+	222, // 261: fin_aggregator_service.FinAggregatorService.CreateBankHeader:input_type -> fin_aggregator_service.CreateBankHeaderRequest
+	224, // 262: fin_aggregator_service.FinAggregatorService.UpdateBankHeader:input_type -> fin_aggregator_service.UpdateBankHeaderRequest
+	226, // 263: fin_aggregator_service.FinAggregatorService.DeleteBankHeader:input_type -> fin_aggregator_service.DeleteBankHeaderRequest
+	229, // 264: fin_aggregator_service.FinAggregatorService.GetBankParsingProfile:input_type -> fin_aggregator_service.GetBankParsingProfileRequest
+	231, // 265: fin_aggregator_service.FinAggregatorService.UpdateBankParsingProfile:input_type -> fin_aggregator_service.UpdateBankParsingProfileRequest
+	233, // 266: fin_aggregator_service.FinAggregatorService.DeleteBankParsingProfile:input_type -> fin_aggregator_service.DeleteBankParsingProfileRequest
+	29,  // 267: fin_aggregator_service.FinAggregatorService.GetTransactions:output_type -> fin_aggregator_service.GetTransactionsResponse
+	31,  // 268: fin_aggregator_service.FinAggregatorService.UpdateTransaction:output_type -> fin_aggregator_service.UpdateTransactionResponse
+	38,  // 269: fin_aggregator_service.FinAggregatorService.GetMonzoAuthURL:output_type -> fin_aggregator_service.GetMonzoAuthURLResponse
+	33,  // 270: fin_aggregator_service.FinAggregatorService.MonzoCallback:output_type -> fin_aggregator_service.MonzoCallbackResponse
+	35,  // 271: fin_aggregator_service.FinAggregatorService.GetMonzoAccount:output_type -> fin_aggregator_service.MonzoAccountResponse
+	40,  // 272: fin_aggregator_service.FinAggregatorService.LoadMonzoTransactions:output_type -> fin_aggregator_service.LoadMonzoTransactionsResponse
+	42,  // 273: fin_aggregator_service.FinAggregatorService.UploadCSV:output_type -> fin_aggregator_service.UploadCSVResponse
+	46,  // 274: fin_aggregator_service.FinAggregatorService.ListBank:output_type -> fin_aggregator_service.ListBankResponse
+	49,  // 275: fin_aggregator_service.FinAggregatorService.ListUser:output_type -> fin_aggregator_service.ListUserResponse
+	52,  // 276: fin_aggregator_service.FinAggregatorService.ListCategory:output_type -> fin_aggregator_service.ListCategoryResponse
+	55,  // 277: fin_aggregator_service.FinAggregatorService.ListTransactionType:output_type -> fin_aggregator_service.ListTransactionTypeResponse
+	57,  // 278: fin_aggregator_service.FinAggregatorService.GetSpendingInsights:output_type -> fin_aggregator_service.GetSpendingInsightsResponse
+	63,  // 279: fin_aggregator_service.FinAggregatorService.MarkSharedExpense:output_type -> fin_aggregator_service.MarkSharedExpenseResponse
+	65,  // 280: fin_aggregator_service.FinAggregatorService.UnmarkSharedExpense:output_type -> fin_aggregator_service.UnmarkSharedExpenseResponse
+	67,  // 281: fin_aggregator_service.FinAggregatorService.ListSharedExpense:output_type -> fin_aggregator_service.ListSharedExpenseResponse
+	70,  // 282: fin_aggregator_service.FinAggregatorService.GetUserBalances:output_type -> fin_aggregator_service.GetUserBalancesResponse
+	73,  // 283: fin_aggregator_service.FinAggregatorService.GetSettleUpSuggestions:output_type -> fin_aggregator_service.GetSettleUpSuggestionsResponse
+	76,  // 284: fin_aggregator_service.FinAggregatorService.RecordSettlement:output_type -> fin_aggregator_service.RecordSettlementResponse
+	78,  // 285: fin_aggregator_service.FinAggregatorService.ListSettlement:output_type -> fin_aggregator_service.ListSettlementResponse
+	81,  // 286: fin_aggregator_service.FinAggregatorService.CreateAccount:output_type -> fin_aggregator_service.CreateAccountResponse
+	83,  // 287: fin_aggregator_service.FinAggregatorService.UpdateAccount:output_type -> fin_aggregator_service.UpdateAccountResponse
+	85,  // 288: fin_aggregator_service.FinAggregatorService.DeleteAccount:output_type -> fin_aggregator_service.DeleteAccountResponse
+	87,  // 289: fin_aggregator_service.FinAggregatorService.GetAccount:output_type -> fin_aggregator_service.GetAccountResponse
+	89,  // 290: fin_aggregator_service.FinAggregatorService.ListAccount:output_type -> fin_aggregator_service.ListAccountResponse
+	91,  // 291: fin_aggregator_service.FinAggregatorService.ListAccountType:output_type -> fin_aggregator_service.ListAccountTypeResponse
+	94,  // 292: fin_aggregator_service.FinAggregatorService.CreateBalanceSnapshot:output_type -> fin_aggregator_service.CreateBalanceSnapshotResponse
+	96,  // 293: fin_aggregator_service.FinAggregatorService.ListBalanceSnapshot:output_type -> fin_aggregator_service.ListBalanceSnapshotResponse
+	98,  // 294: fin_aggregator_service.FinAggregatorService.DeleteBalanceSnapshot:output_type -> fin_aggregator_service.DeleteBalanceSnapshotResponse
+	101, // 295: fin_aggregator_service.FinAggregatorService.ReconcileAccount:output_type -> fin_aggregator_service.ReconcileAccountResponse
+	104, // 296: fin_aggregator_service.FinAggregatorService.CreateAsset:output_type -> fin_aggregator_service.CreateAssetResponse
+	106, // 297: fin_aggregator_service.FinAggregatorService.UpdateAsset:output_type -> fin_aggregator_service.UpdateAssetResponse
+	108, // 298: fin_aggregator_service.FinAggregatorService.DeleteAsset:output_type -> fin_aggregator_service.DeleteAssetResponse
+	110, // 299: fin_aggregator_service.FinAggregatorService.ListAsset:output_type -> fin_aggregator_service.ListAssetResponse
+	113, // 300: fin_aggregator_service.FinAggregatorService.AddAssetValuation:output_type -> fin_aggregator_service.AddAssetValuationResponse
+	115, // 301: fin_aggregator_service.FinAggregatorService.ListAssetValuation:output_type -> fin_aggregator_service.ListAssetValuationResponse
+	119, // 302: fin_aggregator_service.FinAggregatorService.GetNetWorthHistory:output_type -> fin_aggregator_service.GetNetWorthHistoryResponse
+	122, // 303: fin_aggregator_service.FinAggregatorService.CreateSavingsGoal:output_type -> fin_aggregator_service.CreateSavingsGoalResponse
+	124, // 304: fin_aggregator_service.FinAggregatorService.UpdateSavingsGoal:output_type -> fin_aggregator_service.UpdateSavingsGoalResponse
+	126, // 305: fin_aggregator_service.FinAggregatorService.DeleteSavingsGoal:output_type -> fin_aggregator_service.DeleteSavingsGoalResponse
+	128, // 306: fin_aggregator_service.FinAggregatorService.ListSavingsGoal:output_type -> fin_aggregator_service.ListSavingsGoalResponse
+	130, // 307: fin_aggregator_service.FinAggregatorService.GetSavingsGoalStatus:output_type -> fin_aggregator_service.GetSavingsGoalStatusResponse
+	236, // 308: fin_aggregator_service.FinAggregatorService.GenerateReport:output_type -> google.api.HttpBody
+	135, // 309: fin_aggregator_service.FinAggregatorService.CreateAlertRule:output_type -> fin_aggregator_service.CreateAlertRuleResponse
+	137, // 310: fin_aggregator_service.FinAggregatorService.UpdateAlertRule:output_type -> fin_aggregator_service.UpdateAlertRuleResponse
+	139, // 311: fin_aggregator_service.FinAggregatorService.DeleteAlertRule:output_type -> fin_aggregator_service.DeleteAlertRuleResponse
+	141, // 312: fin_aggregator_service.FinAggregatorService.ListAlertRule:output_type -> fin_aggregator_service.ListAlertRuleResponse
+	143, // 313: fin_aggregator_service.FinAggregatorService.TestAlertRule:output_type -> fin_aggregator_service.TestAlertRuleResponse
+	145, // 314: fin_aggregator_service.FinAggregatorService.ListAlert:output_type -> fin_aggregator_service.ListAlertResponse
+	149, // 315: fin_aggregator_service.FinAggregatorService.CreateWebhookSubscription:output_type -> fin_aggregator_service.CreateWebhookSubscriptionResponse
+	151, // 316: fin_aggregator_service.FinAggregatorService.UpdateWebhookSubscription:output_type -> fin_aggregator_service.UpdateWebhookSubscriptionResponse
+	153, // 317: fin_aggregator_service.FinAggregatorService.DeleteWebhookSubscription:output_type -> fin_aggregator_service.DeleteWebhookSubscriptionResponse
+	155, // 318: fin_aggregator_service.FinAggregatorService.ListWebhookSubscription:output_type -> fin_aggregator_service.ListWebhookSubscriptionResponse
+	157, // 319: fin_aggregator_service.FinAggregatorService.ListWebhookDelivery:output_type -> fin_aggregator_service.ListWebhookDeliveryResponse
+	159, // 320: fin_aggregator_service.FinAggregatorService.RedeliverWebhook:output_type -> fin_aggregator_service.RedeliverWebhookResponse
+	164, // 321: fin_aggregator_service.FinAggregatorService.CreateCategorizationRule:output_type -> fin_aggregator_service.CreateCategorizationRuleResponse
+	166, // 322: fin_aggregator_service.FinAggregatorService.UpdateCategorizationRule:output_type -> fin_aggregator_service.UpdateCategorizationRuleResponse
+	168, // 323: fin_aggregator_service.FinAggregatorService.DeleteCategorizationRule:output_type -> fin_aggregator_service.DeleteCategorizationRuleResponse
+	170, // 324: fin_aggregator_service.FinAggregatorService.ListCategorizationRule:output_type -> fin_aggregator_service.ListCategorizationRuleResponse
+	173, // 325: fin_aggregator_service.FinAggregatorService.CreateCategory:output_type -> fin_aggregator_service.CreateCategoryResponse
+	175, // 326: fin_aggregator_service.FinAggregatorService.UpdateCategory:output_type -> fin_aggregator_service.UpdateCategoryResponse
+	177, // 327: fin_aggregator_service.FinAggregatorService.DeleteCategory:output_type -> fin_aggregator_service.DeleteCategoryResponse
+	179, // 328: fin_aggregator_service.FinAggregatorService.MergeCategory:output_type -> fin_aggregator_service.MergeCategoryResponse
+	181, // 329: fin_aggregator_service.FinAggregatorService.ListCategoryKeyword:output_type -> fin_aggregator_service.ListCategoryKeywordResponse
+	183, // 330: fin_aggregator_service.FinAggregatorService.AddCategoryKeyword:output_type -> fin_aggregator_service.AddCategoryKeywordResponse
+	185, // 331: fin_aggregator_service.FinAggregatorService.DeleteCategoryKeyword:output_type -> fin_aggregator_service.DeleteCategoryKeywordResponse
+	188, // 332: fin_aggregator_service.FinAggregatorService.ListCategorySuggestion:output_type -> fin_aggregator_service.ListCategorySuggestionResponse
+	190, // 333: fin_aggregator_service.FinAggregatorService.AcceptCategorySuggestion:output_type -> fin_aggregator_service.AcceptCategorySuggestionResponse
+	192, // 334: fin_aggregator_service.FinAggregatorService.RejectCategorySuggestion:output_type -> fin_aggregator_service.RejectCategorySuggestionResponse
+	195, // 335: fin_aggregator_service.FinAggregatorService.TrainCategorizationModel:output_type -> fin_aggregator_service.TrainCategorizationModelResponse
+	197, // 336: fin_aggregator_service.FinAggregatorService.GetCategorizationModel:output_type -> fin_aggregator_service.GetCategorizationModelResponse
+	200, // 337: fin_aggregator_service.FinAggregatorService.ListTransactionCategoryPrediction:output_type -> fin_aggregator_service.ListTransactionCategoryPredictionResponse
+	203, // 338: fin_aggregator_service.FinAggregatorService.RecategorizeTransactions:output_type -> fin_aggregator_service.RecategorizeTransactionsResponse
+	206, // 339: fin_aggregator_service.FinAggregatorService.ListProviderCategoryMapping:output_type -> fin_aggregator_service.ListProviderCategoryMappingResponse
+	208, // 340: fin_aggregator_service.FinAggregatorService.CreateProviderCategoryMapping:output_type -> fin_aggregator_service.CreateProviderCategoryMappingResponse
+	210, // 341: fin_aggregator_service.FinAggregatorService.UpdateProviderCategoryMapping:output_type -> fin_aggregator_service.UpdateProviderCategoryMappingResponse
+	212, // 342: fin_aggregator_service.FinAggregatorService.DeleteProviderCategoryMapping:output_type -> fin_aggregator_service.DeleteProviderCategoryMappingResponse
+	216, // 343: fin_aggregator_service.FinAggregatorService.ExplainTransactionCategory:output_type -> fin_aggregator_service.ExplainTransactionCategoryResponse
+	218, // 344: fin_aggregator_service.FinAggregatorService.ReloadCaches:output_type -> fin_aggregator_service.ReloadCachesResponse
+	221, // 345: fin_aggregator_service.FinAggregatorService.ListBankHeader:output_type -> fin_aggregator_service.ListBankHeaderResponse
+	223, // 346: fin_aggregator_service.FinAggregatorService.CreateBankHeader:output_type -> fin_aggregator_service.CreateBankHeaderResponse
+	225, // 347: fin_aggregator_service.FinAggregatorService.UpdateBankHeader:output_type -> fin_aggregator_service.UpdateBankHeaderResponse
+	227, // 348: fin_aggregator_service.FinAggregatorService.DeleteBankHeader:output_type -> fin_aggregator_service.DeleteBankHeaderResponse
+	230, // 349: fin_aggregator_service.FinAggregatorService.GetBankParsingProfile:output_type -> fin_aggregator_service.GetBankParsingProfileResponse
+	232, // 350: fin_aggregator_service.FinAggregatorService.UpdateBankParsingProfile:output_type -> fin_aggregator_service.UpdateBankParsingProfileResponse
+	234, // 351: fin_aggregator_service.FinAggregatorService.DeleteBankParsingProfile:output_type -> fin_aggregator_service.DeleteBankParsingProfileResponse
+	267, // [267:352] is the sub-list for method output_type
+	182, // [182:267] is the sub-list for method input_type
+	182, // [182:182] is the sub-list for extension type_name
+	182, // [182:182] is the sub-list for extension extendee
+	0,   // [0:182] is the sub-list for field type_name
 }
 
 func init() { file_api_fin_aggregate_service_fin_aggregate_service_proto_init() }
@@ -1728,14 +15851,77 @@ func file_api_fin_aggregate_service_fin_aggregate_service_proto_init() {
 	if File_api_fin_aggregate_service_fin_aggregate_service_proto != nil {
 		return
 	}
-	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[4].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[30].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[32].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[34].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[36].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[40].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[51].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[53].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[54].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[56].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[62].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[66].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[67].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[76].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[77].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[79].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[83].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[85].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[86].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[92].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[94].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[95].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[97].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[101].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[106].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[107].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[108].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[110].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[114].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[118].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[120].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[121].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[122].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[124].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[130].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[134].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[135].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[136].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[137].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[139].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[143].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[145].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[146].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[148].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[150].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[156].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[160].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[163].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[173].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[175].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[179].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[189].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[198].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[202].OneofWrappers = []any{}
+	file_api_fin_aggregate_service_fin_aggregate_service_proto_msgTypes[205].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDesc), len(file_api_fin_aggregate_service_fin_aggregate_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   27,
+			NumEnums:      26,
+			NumMessages:   209,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
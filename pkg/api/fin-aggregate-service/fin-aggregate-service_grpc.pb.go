@@ -8,6 +8,7 @@ package fin_aggregator_service
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,17 +20,91 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FinAggregatorService_GetTransactions_FullMethodName       = "/fin_aggregator_service.FinAggregatorService/GetTransactions"
-	FinAggregatorService_UpdateTransaction_FullMethodName     = "/fin_aggregator_service.FinAggregatorService/UpdateTransaction"
-	FinAggregatorService_GetMonzoAuthURL_FullMethodName       = "/fin_aggregator_service.FinAggregatorService/GetMonzoAuthURL"
-	FinAggregatorService_MonzoCallback_FullMethodName         = "/fin_aggregator_service.FinAggregatorService/MonzoCallback"
-	FinAggregatorService_GetMonzoAccount_FullMethodName       = "/fin_aggregator_service.FinAggregatorService/GetMonzoAccount"
-	FinAggregatorService_LoadMonzoTransactions_FullMethodName = "/fin_aggregator_service.FinAggregatorService/LoadMonzoTransactions"
-	FinAggregatorService_UploadCSV_FullMethodName             = "/fin_aggregator_service.FinAggregatorService/UploadCSV"
-	FinAggregatorService_ListBank_FullMethodName              = "/fin_aggregator_service.FinAggregatorService/ListBank"
-	FinAggregatorService_ListUser_FullMethodName              = "/fin_aggregator_service.FinAggregatorService/ListUser"
-	FinAggregatorService_ListCategory_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/ListCategory"
-	FinAggregatorService_ListTransactionType_FullMethodName   = "/fin_aggregator_service.FinAggregatorService/ListTransactionType"
+	FinAggregatorService_GetTransactions_FullMethodName                   = "/fin_aggregator_service.FinAggregatorService/GetTransactions"
+	FinAggregatorService_UpdateTransaction_FullMethodName                 = "/fin_aggregator_service.FinAggregatorService/UpdateTransaction"
+	FinAggregatorService_GetMonzoAuthURL_FullMethodName                   = "/fin_aggregator_service.FinAggregatorService/GetMonzoAuthURL"
+	FinAggregatorService_MonzoCallback_FullMethodName                     = "/fin_aggregator_service.FinAggregatorService/MonzoCallback"
+	FinAggregatorService_GetMonzoAccount_FullMethodName                   = "/fin_aggregator_service.FinAggregatorService/GetMonzoAccount"
+	FinAggregatorService_LoadMonzoTransactions_FullMethodName             = "/fin_aggregator_service.FinAggregatorService/LoadMonzoTransactions"
+	FinAggregatorService_UploadCSV_FullMethodName                         = "/fin_aggregator_service.FinAggregatorService/UploadCSV"
+	FinAggregatorService_ListBank_FullMethodName                          = "/fin_aggregator_service.FinAggregatorService/ListBank"
+	FinAggregatorService_ListUser_FullMethodName                          = "/fin_aggregator_service.FinAggregatorService/ListUser"
+	FinAggregatorService_ListCategory_FullMethodName                      = "/fin_aggregator_service.FinAggregatorService/ListCategory"
+	FinAggregatorService_ListTransactionType_FullMethodName               = "/fin_aggregator_service.FinAggregatorService/ListTransactionType"
+	FinAggregatorService_GetSpendingInsights_FullMethodName               = "/fin_aggregator_service.FinAggregatorService/GetSpendingInsights"
+	FinAggregatorService_MarkSharedExpense_FullMethodName                 = "/fin_aggregator_service.FinAggregatorService/MarkSharedExpense"
+	FinAggregatorService_UnmarkSharedExpense_FullMethodName               = "/fin_aggregator_service.FinAggregatorService/UnmarkSharedExpense"
+	FinAggregatorService_ListSharedExpense_FullMethodName                 = "/fin_aggregator_service.FinAggregatorService/ListSharedExpense"
+	FinAggregatorService_GetUserBalances_FullMethodName                   = "/fin_aggregator_service.FinAggregatorService/GetUserBalances"
+	FinAggregatorService_GetSettleUpSuggestions_FullMethodName            = "/fin_aggregator_service.FinAggregatorService/GetSettleUpSuggestions"
+	FinAggregatorService_RecordSettlement_FullMethodName                  = "/fin_aggregator_service.FinAggregatorService/RecordSettlement"
+	FinAggregatorService_ListSettlement_FullMethodName                    = "/fin_aggregator_service.FinAggregatorService/ListSettlement"
+	FinAggregatorService_CreateAccount_FullMethodName                     = "/fin_aggregator_service.FinAggregatorService/CreateAccount"
+	FinAggregatorService_UpdateAccount_FullMethodName                     = "/fin_aggregator_service.FinAggregatorService/UpdateAccount"
+	FinAggregatorService_DeleteAccount_FullMethodName                     = "/fin_aggregator_service.FinAggregatorService/DeleteAccount"
+	FinAggregatorService_GetAccount_FullMethodName                        = "/fin_aggregator_service.FinAggregatorService/GetAccount"
+	FinAggregatorService_ListAccount_FullMethodName                       = "/fin_aggregator_service.FinAggregatorService/ListAccount"
+	FinAggregatorService_ListAccountType_FullMethodName                   = "/fin_aggregator_service.FinAggregatorService/ListAccountType"
+	FinAggregatorService_CreateBalanceSnapshot_FullMethodName             = "/fin_aggregator_service.FinAggregatorService/CreateBalanceSnapshot"
+	FinAggregatorService_ListBalanceSnapshot_FullMethodName               = "/fin_aggregator_service.FinAggregatorService/ListBalanceSnapshot"
+	FinAggregatorService_DeleteBalanceSnapshot_FullMethodName             = "/fin_aggregator_service.FinAggregatorService/DeleteBalanceSnapshot"
+	FinAggregatorService_ReconcileAccount_FullMethodName                  = "/fin_aggregator_service.FinAggregatorService/ReconcileAccount"
+	FinAggregatorService_CreateAsset_FullMethodName                       = "/fin_aggregator_service.FinAggregatorService/CreateAsset"
+	FinAggregatorService_UpdateAsset_FullMethodName                       = "/fin_aggregator_service.FinAggregatorService/UpdateAsset"
+	FinAggregatorService_DeleteAsset_FullMethodName                       = "/fin_aggregator_service.FinAggregatorService/DeleteAsset"
+	FinAggregatorService_ListAsset_FullMethodName                         = "/fin_aggregator_service.FinAggregatorService/ListAsset"
+	FinAggregatorService_AddAssetValuation_FullMethodName                 = "/fin_aggregator_service.FinAggregatorService/AddAssetValuation"
+	FinAggregatorService_ListAssetValuation_FullMethodName                = "/fin_aggregator_service.FinAggregatorService/ListAssetValuation"
+	FinAggregatorService_GetNetWorthHistory_FullMethodName                = "/fin_aggregator_service.FinAggregatorService/GetNetWorthHistory"
+	FinAggregatorService_CreateSavingsGoal_FullMethodName                 = "/fin_aggregator_service.FinAggregatorService/CreateSavingsGoal"
+	FinAggregatorService_UpdateSavingsGoal_FullMethodName                 = "/fin_aggregator_service.FinAggregatorService/UpdateSavingsGoal"
+	FinAggregatorService_DeleteSavingsGoal_FullMethodName                 = "/fin_aggregator_service.FinAggregatorService/DeleteSavingsGoal"
+	FinAggregatorService_ListSavingsGoal_FullMethodName                   = "/fin_aggregator_service.FinAggregatorService/ListSavingsGoal"
+	FinAggregatorService_GetSavingsGoalStatus_FullMethodName              = "/fin_aggregator_service.FinAggregatorService/GetSavingsGoalStatus"
+	FinAggregatorService_GenerateReport_FullMethodName                    = "/fin_aggregator_service.FinAggregatorService/GenerateReport"
+	FinAggregatorService_CreateAlertRule_FullMethodName                   = "/fin_aggregator_service.FinAggregatorService/CreateAlertRule"
+	FinAggregatorService_UpdateAlertRule_FullMethodName                   = "/fin_aggregator_service.FinAggregatorService/UpdateAlertRule"
+	FinAggregatorService_DeleteAlertRule_FullMethodName                   = "/fin_aggregator_service.FinAggregatorService/DeleteAlertRule"
+	FinAggregatorService_ListAlertRule_FullMethodName                     = "/fin_aggregator_service.FinAggregatorService/ListAlertRule"
+	FinAggregatorService_TestAlertRule_FullMethodName                     = "/fin_aggregator_service.FinAggregatorService/TestAlertRule"
+	FinAggregatorService_ListAlert_FullMethodName                         = "/fin_aggregator_service.FinAggregatorService/ListAlert"
+	FinAggregatorService_CreateWebhookSubscription_FullMethodName         = "/fin_aggregator_service.FinAggregatorService/CreateWebhookSubscription"
+	FinAggregatorService_UpdateWebhookSubscription_FullMethodName         = "/fin_aggregator_service.FinAggregatorService/UpdateWebhookSubscription"
+	FinAggregatorService_DeleteWebhookSubscription_FullMethodName         = "/fin_aggregator_service.FinAggregatorService/DeleteWebhookSubscription"
+	FinAggregatorService_ListWebhookSubscription_FullMethodName           = "/fin_aggregator_service.FinAggregatorService/ListWebhookSubscription"
+	FinAggregatorService_ListWebhookDelivery_FullMethodName               = "/fin_aggregator_service.FinAggregatorService/ListWebhookDelivery"
+	FinAggregatorService_RedeliverWebhook_FullMethodName                  = "/fin_aggregator_service.FinAggregatorService/RedeliverWebhook"
+	FinAggregatorService_CreateCategorizationRule_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/CreateCategorizationRule"
+	FinAggregatorService_UpdateCategorizationRule_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/UpdateCategorizationRule"
+	FinAggregatorService_DeleteCategorizationRule_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/DeleteCategorizationRule"
+	FinAggregatorService_ListCategorizationRule_FullMethodName            = "/fin_aggregator_service.FinAggregatorService/ListCategorizationRule"
+	FinAggregatorService_CreateCategory_FullMethodName                    = "/fin_aggregator_service.FinAggregatorService/CreateCategory"
+	FinAggregatorService_UpdateCategory_FullMethodName                    = "/fin_aggregator_service.FinAggregatorService/UpdateCategory"
+	FinAggregatorService_DeleteCategory_FullMethodName                    = "/fin_aggregator_service.FinAggregatorService/DeleteCategory"
+	FinAggregatorService_MergeCategory_FullMethodName                     = "/fin_aggregator_service.FinAggregatorService/MergeCategory"
+	FinAggregatorService_ListCategoryKeyword_FullMethodName               = "/fin_aggregator_service.FinAggregatorService/ListCategoryKeyword"
+	FinAggregatorService_AddCategoryKeyword_FullMethodName                = "/fin_aggregator_service.FinAggregatorService/AddCategoryKeyword"
+	FinAggregatorService_DeleteCategoryKeyword_FullMethodName             = "/fin_aggregator_service.FinAggregatorService/DeleteCategoryKeyword"
+	FinAggregatorService_ListCategorySuggestion_FullMethodName            = "/fin_aggregator_service.FinAggregatorService/ListCategorySuggestion"
+	FinAggregatorService_AcceptCategorySuggestion_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/AcceptCategorySuggestion"
+	FinAggregatorService_RejectCategorySuggestion_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/RejectCategorySuggestion"
+	FinAggregatorService_TrainCategorizationModel_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/TrainCategorizationModel"
+	FinAggregatorService_GetCategorizationModel_FullMethodName            = "/fin_aggregator_service.FinAggregatorService/GetCategorizationModel"
+	FinAggregatorService_ListTransactionCategoryPrediction_FullMethodName = "/fin_aggregator_service.FinAggregatorService/ListTransactionCategoryPrediction"
+	FinAggregatorService_RecategorizeTransactions_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/RecategorizeTransactions"
+	FinAggregatorService_ListProviderCategoryMapping_FullMethodName       = "/fin_aggregator_service.FinAggregatorService/ListProviderCategoryMapping"
+	FinAggregatorService_CreateProviderCategoryMapping_FullMethodName     = "/fin_aggregator_service.FinAggregatorService/CreateProviderCategoryMapping"
+	FinAggregatorService_UpdateProviderCategoryMapping_FullMethodName     = "/fin_aggregator_service.FinAggregatorService/UpdateProviderCategoryMapping"
+	FinAggregatorService_DeleteProviderCategoryMapping_FullMethodName     = "/fin_aggregator_service.FinAggregatorService/DeleteProviderCategoryMapping"
+	FinAggregatorService_ExplainTransactionCategory_FullMethodName        = "/fin_aggregator_service.FinAggregatorService/ExplainTransactionCategory"
+	FinAggregatorService_ReloadCaches_FullMethodName                      = "/fin_aggregator_service.FinAggregatorService/ReloadCaches"
+	FinAggregatorService_ListBankHeader_FullMethodName                    = "/fin_aggregator_service.FinAggregatorService/ListBankHeader"
+	FinAggregatorService_CreateBankHeader_FullMethodName                  = "/fin_aggregator_service.FinAggregatorService/CreateBankHeader"
+	FinAggregatorService_UpdateBankHeader_FullMethodName                  = "/fin_aggregator_service.FinAggregatorService/UpdateBankHeader"
+	FinAggregatorService_DeleteBankHeader_FullMethodName                  = "/fin_aggregator_service.FinAggregatorService/DeleteBankHeader"
+	FinAggregatorService_GetBankParsingProfile_FullMethodName             = "/fin_aggregator_service.FinAggregatorService/GetBankParsingProfile"
+	FinAggregatorService_UpdateBankParsingProfile_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/UpdateBankParsingProfile"
+	FinAggregatorService_DeleteBankParsingProfile_FullMethodName          = "/fin_aggregator_service.FinAggregatorService/DeleteBankParsingProfile"
 )
 
 // FinAggregatorServiceClient is the client API for FinAggregatorService service.
@@ -47,6 +122,80 @@ type FinAggregatorServiceClient interface {
 	ListUser(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error)
 	ListCategory(ctx context.Context, in *ListCategoryRequest, opts ...grpc.CallOption) (*ListCategoryResponse, error)
 	ListTransactionType(ctx context.Context, in *ListTransactionTypeRequest, opts ...grpc.CallOption) (*ListTransactionTypeResponse, error)
+	GetSpendingInsights(ctx context.Context, in *GetSpendingInsightsRequest, opts ...grpc.CallOption) (*GetSpendingInsightsResponse, error)
+	MarkSharedExpense(ctx context.Context, in *MarkSharedExpenseRequest, opts ...grpc.CallOption) (*MarkSharedExpenseResponse, error)
+	UnmarkSharedExpense(ctx context.Context, in *UnmarkSharedExpenseRequest, opts ...grpc.CallOption) (*UnmarkSharedExpenseResponse, error)
+	ListSharedExpense(ctx context.Context, in *ListSharedExpenseRequest, opts ...grpc.CallOption) (*ListSharedExpenseResponse, error)
+	GetUserBalances(ctx context.Context, in *GetUserBalancesRequest, opts ...grpc.CallOption) (*GetUserBalancesResponse, error)
+	GetSettleUpSuggestions(ctx context.Context, in *GetSettleUpSuggestionsRequest, opts ...grpc.CallOption) (*GetSettleUpSuggestionsResponse, error)
+	RecordSettlement(ctx context.Context, in *RecordSettlementRequest, opts ...grpc.CallOption) (*RecordSettlementResponse, error)
+	ListSettlement(ctx context.Context, in *ListSettlementRequest, opts ...grpc.CallOption) (*ListSettlementResponse, error)
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	ListAccount(ctx context.Context, in *ListAccountRequest, opts ...grpc.CallOption) (*ListAccountResponse, error)
+	ListAccountType(ctx context.Context, in *ListAccountTypeRequest, opts ...grpc.CallOption) (*ListAccountTypeResponse, error)
+	CreateBalanceSnapshot(ctx context.Context, in *CreateBalanceSnapshotRequest, opts ...grpc.CallOption) (*CreateBalanceSnapshotResponse, error)
+	ListBalanceSnapshot(ctx context.Context, in *ListBalanceSnapshotRequest, opts ...grpc.CallOption) (*ListBalanceSnapshotResponse, error)
+	DeleteBalanceSnapshot(ctx context.Context, in *DeleteBalanceSnapshotRequest, opts ...grpc.CallOption) (*DeleteBalanceSnapshotResponse, error)
+	ReconcileAccount(ctx context.Context, in *ReconcileAccountRequest, opts ...grpc.CallOption) (*ReconcileAccountResponse, error)
+	CreateAsset(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error)
+	UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*UpdateAssetResponse, error)
+	DeleteAsset(ctx context.Context, in *DeleteAssetRequest, opts ...grpc.CallOption) (*DeleteAssetResponse, error)
+	ListAsset(ctx context.Context, in *ListAssetRequest, opts ...grpc.CallOption) (*ListAssetResponse, error)
+	AddAssetValuation(ctx context.Context, in *AddAssetValuationRequest, opts ...grpc.CallOption) (*AddAssetValuationResponse, error)
+	ListAssetValuation(ctx context.Context, in *ListAssetValuationRequest, opts ...grpc.CallOption) (*ListAssetValuationResponse, error)
+	GetNetWorthHistory(ctx context.Context, in *GetNetWorthHistoryRequest, opts ...grpc.CallOption) (*GetNetWorthHistoryResponse, error)
+	CreateSavingsGoal(ctx context.Context, in *CreateSavingsGoalRequest, opts ...grpc.CallOption) (*CreateSavingsGoalResponse, error)
+	UpdateSavingsGoal(ctx context.Context, in *UpdateSavingsGoalRequest, opts ...grpc.CallOption) (*UpdateSavingsGoalResponse, error)
+	DeleteSavingsGoal(ctx context.Context, in *DeleteSavingsGoalRequest, opts ...grpc.CallOption) (*DeleteSavingsGoalResponse, error)
+	ListSavingsGoal(ctx context.Context, in *ListSavingsGoalRequest, opts ...grpc.CallOption) (*ListSavingsGoalResponse, error)
+	GetSavingsGoalStatus(ctx context.Context, in *GetSavingsGoalStatusRequest, opts ...grpc.CallOption) (*GetSavingsGoalStatusResponse, error)
+	GenerateReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
+	UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleResponse, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	ListAlertRule(ctx context.Context, in *ListAlertRuleRequest, opts ...grpc.CallOption) (*ListAlertRuleResponse, error)
+	TestAlertRule(ctx context.Context, in *TestAlertRuleRequest, opts ...grpc.CallOption) (*TestAlertRuleResponse, error)
+	ListAlert(ctx context.Context, in *ListAlertRequest, opts ...grpc.CallOption) (*ListAlertResponse, error)
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	UpdateWebhookSubscription(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*UpdateWebhookSubscriptionResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookSubscription(ctx context.Context, in *ListWebhookSubscriptionRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionResponse, error)
+	ListWebhookDelivery(ctx context.Context, in *ListWebhookDeliveryRequest, opts ...grpc.CallOption) (*ListWebhookDeliveryResponse, error)
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error)
+	CreateCategorizationRule(ctx context.Context, in *CreateCategorizationRuleRequest, opts ...grpc.CallOption) (*CreateCategorizationRuleResponse, error)
+	UpdateCategorizationRule(ctx context.Context, in *UpdateCategorizationRuleRequest, opts ...grpc.CallOption) (*UpdateCategorizationRuleResponse, error)
+	DeleteCategorizationRule(ctx context.Context, in *DeleteCategorizationRuleRequest, opts ...grpc.CallOption) (*DeleteCategorizationRuleResponse, error)
+	ListCategorizationRule(ctx context.Context, in *ListCategorizationRuleRequest, opts ...grpc.CallOption) (*ListCategorizationRuleResponse, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	MergeCategory(ctx context.Context, in *MergeCategoryRequest, opts ...grpc.CallOption) (*MergeCategoryResponse, error)
+	ListCategoryKeyword(ctx context.Context, in *ListCategoryKeywordRequest, opts ...grpc.CallOption) (*ListCategoryKeywordResponse, error)
+	AddCategoryKeyword(ctx context.Context, in *AddCategoryKeywordRequest, opts ...grpc.CallOption) (*AddCategoryKeywordResponse, error)
+	DeleteCategoryKeyword(ctx context.Context, in *DeleteCategoryKeywordRequest, opts ...grpc.CallOption) (*DeleteCategoryKeywordResponse, error)
+	ListCategorySuggestion(ctx context.Context, in *ListCategorySuggestionRequest, opts ...grpc.CallOption) (*ListCategorySuggestionResponse, error)
+	AcceptCategorySuggestion(ctx context.Context, in *AcceptCategorySuggestionRequest, opts ...grpc.CallOption) (*AcceptCategorySuggestionResponse, error)
+	RejectCategorySuggestion(ctx context.Context, in *RejectCategorySuggestionRequest, opts ...grpc.CallOption) (*RejectCategorySuggestionResponse, error)
+	TrainCategorizationModel(ctx context.Context, in *TrainCategorizationModelRequest, opts ...grpc.CallOption) (*TrainCategorizationModelResponse, error)
+	GetCategorizationModel(ctx context.Context, in *GetCategorizationModelRequest, opts ...grpc.CallOption) (*GetCategorizationModelResponse, error)
+	ListTransactionCategoryPrediction(ctx context.Context, in *ListTransactionCategoryPredictionRequest, opts ...grpc.CallOption) (*ListTransactionCategoryPredictionResponse, error)
+	RecategorizeTransactions(ctx context.Context, in *RecategorizeTransactionsRequest, opts ...grpc.CallOption) (*RecategorizeTransactionsResponse, error)
+	ListProviderCategoryMapping(ctx context.Context, in *ListProviderCategoryMappingRequest, opts ...grpc.CallOption) (*ListProviderCategoryMappingResponse, error)
+	CreateProviderCategoryMapping(ctx context.Context, in *CreateProviderCategoryMappingRequest, opts ...grpc.CallOption) (*CreateProviderCategoryMappingResponse, error)
+	UpdateProviderCategoryMapping(ctx context.Context, in *UpdateProviderCategoryMappingRequest, opts ...grpc.CallOption) (*UpdateProviderCategoryMappingResponse, error)
+	DeleteProviderCategoryMapping(ctx context.Context, in *DeleteProviderCategoryMappingRequest, opts ...grpc.CallOption) (*DeleteProviderCategoryMappingResponse, error)
+	ExplainTransactionCategory(ctx context.Context, in *ExplainTransactionCategoryRequest, opts ...grpc.CallOption) (*ExplainTransactionCategoryResponse, error)
+	ReloadCaches(ctx context.Context, in *ReloadCachesRequest, opts ...grpc.CallOption) (*ReloadCachesResponse, error)
+	ListBankHeader(ctx context.Context, in *ListBankHeaderRequest, opts ...grpc.CallOption) (*ListBankHeaderResponse, error)
+	CreateBankHeader(ctx context.Context, in *CreateBankHeaderRequest, opts ...grpc.CallOption) (*CreateBankHeaderResponse, error)
+	UpdateBankHeader(ctx context.Context, in *UpdateBankHeaderRequest, opts ...grpc.CallOption) (*UpdateBankHeaderResponse, error)
+	DeleteBankHeader(ctx context.Context, in *DeleteBankHeaderRequest, opts ...grpc.CallOption) (*DeleteBankHeaderResponse, error)
+	GetBankParsingProfile(ctx context.Context, in *GetBankParsingProfileRequest, opts ...grpc.CallOption) (*GetBankParsingProfileResponse, error)
+	UpdateBankParsingProfile(ctx context.Context, in *UpdateBankParsingProfileRequest, opts ...grpc.CallOption) (*UpdateBankParsingProfileResponse, error)
+	DeleteBankParsingProfile(ctx context.Context, in *DeleteBankParsingProfileRequest, opts ...grpc.CallOption) (*DeleteBankParsingProfileResponse, error)
 }
 
 type finAggregatorServiceClient struct {
@@ -167,6 +316,746 @@ func (c *finAggregatorServiceClient) ListTransactionType(ctx context.Context, in
 	return out, nil
 }
 
+func (c *finAggregatorServiceClient) GetSpendingInsights(ctx context.Context, in *GetSpendingInsightsRequest, opts ...grpc.CallOption) (*GetSpendingInsightsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpendingInsightsResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_GetSpendingInsights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) MarkSharedExpense(ctx context.Context, in *MarkSharedExpenseRequest, opts ...grpc.CallOption) (*MarkSharedExpenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkSharedExpenseResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_MarkSharedExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UnmarkSharedExpense(ctx context.Context, in *UnmarkSharedExpenseRequest, opts ...grpc.CallOption) (*UnmarkSharedExpenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmarkSharedExpenseResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UnmarkSharedExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListSharedExpense(ctx context.Context, in *ListSharedExpenseRequest, opts ...grpc.CallOption) (*ListSharedExpenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharedExpenseResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListSharedExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) GetUserBalances(ctx context.Context, in *GetUserBalancesRequest, opts ...grpc.CallOption) (*GetUserBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserBalancesResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_GetUserBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) GetSettleUpSuggestions(ctx context.Context, in *GetSettleUpSuggestionsRequest, opts ...grpc.CallOption) (*GetSettleUpSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSettleUpSuggestionsResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_GetSettleUpSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) RecordSettlement(ctx context.Context, in *RecordSettlementRequest, opts ...grpc.CallOption) (*RecordSettlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSettlementResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_RecordSettlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListSettlement(ctx context.Context, in *ListSettlementRequest, opts ...grpc.CallOption) (*ListSettlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSettlementResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListSettlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccountResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListAccount(ctx context.Context, in *ListAccountRequest, opts ...grpc.CallOption) (*ListAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListAccountType(ctx context.Context, in *ListAccountTypeRequest, opts ...grpc.CallOption) (*ListAccountTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountTypeResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListAccountType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateBalanceSnapshot(ctx context.Context, in *CreateBalanceSnapshotRequest, opts ...grpc.CallOption) (*CreateBalanceSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateBalanceSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListBalanceSnapshot(ctx context.Context, in *ListBalanceSnapshotRequest, opts ...grpc.CallOption) (*ListBalanceSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListBalanceSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteBalanceSnapshot(ctx context.Context, in *DeleteBalanceSnapshotRequest, opts ...grpc.CallOption) (*DeleteBalanceSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteBalanceSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ReconcileAccount(ctx context.Context, in *ReconcileAccountRequest, opts ...grpc.CallOption) (*ReconcileAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileAccountResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ReconcileAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateAsset(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAssetResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*UpdateAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAssetResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteAsset(ctx context.Context, in *DeleteAssetRequest, opts ...grpc.CallOption) (*DeleteAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAssetResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListAsset(ctx context.Context, in *ListAssetRequest, opts ...grpc.CallOption) (*ListAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssetResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) AddAssetValuation(ctx context.Context, in *AddAssetValuationRequest, opts ...grpc.CallOption) (*AddAssetValuationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAssetValuationResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_AddAssetValuation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListAssetValuation(ctx context.Context, in *ListAssetValuationRequest, opts ...grpc.CallOption) (*ListAssetValuationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssetValuationResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListAssetValuation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) GetNetWorthHistory(ctx context.Context, in *GetNetWorthHistoryRequest, opts ...grpc.CallOption) (*GetNetWorthHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNetWorthHistoryResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_GetNetWorthHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateSavingsGoal(ctx context.Context, in *CreateSavingsGoalRequest, opts ...grpc.CallOption) (*CreateSavingsGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSavingsGoalResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateSavingsGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateSavingsGoal(ctx context.Context, in *UpdateSavingsGoalRequest, opts ...grpc.CallOption) (*UpdateSavingsGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSavingsGoalResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateSavingsGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteSavingsGoal(ctx context.Context, in *DeleteSavingsGoalRequest, opts ...grpc.CallOption) (*DeleteSavingsGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavingsGoalResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteSavingsGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListSavingsGoal(ctx context.Context, in *ListSavingsGoalRequest, opts ...grpc.CallOption) (*ListSavingsGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavingsGoalResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListSavingsGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) GetSavingsGoalStatus(ctx context.Context, in *GetSavingsGoalStatusRequest, opts ...grpc.CallOption) (*GetSavingsGoalStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSavingsGoalStatusResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_GetSavingsGoalStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) GenerateReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, FinAggregatorService_GenerateReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListAlertRule(ctx context.Context, in *ListAlertRuleRequest, opts ...grpc.CallOption) (*ListAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRuleResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) TestAlertRule(ctx context.Context, in *TestAlertRuleRequest, opts ...grpc.CallOption) (*TestAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestAlertRuleResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_TestAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListAlert(ctx context.Context, in *ListAlertRequest, opts ...grpc.CallOption) (*ListAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateWebhookSubscription(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*UpdateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListWebhookSubscription(ctx context.Context, in *ListWebhookSubscriptionRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListWebhookDelivery(ctx context.Context, in *ListWebhookDeliveryRequest, opts ...grpc.CallOption) (*ListWebhookDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeliverWebhookResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_RedeliverWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateCategorizationRule(ctx context.Context, in *CreateCategorizationRuleRequest, opts ...grpc.CallOption) (*CreateCategorizationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategorizationRuleResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateCategorizationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateCategorizationRule(ctx context.Context, in *UpdateCategorizationRuleRequest, opts ...grpc.CallOption) (*UpdateCategorizationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategorizationRuleResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateCategorizationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteCategorizationRule(ctx context.Context, in *DeleteCategorizationRuleRequest, opts ...grpc.CallOption) (*DeleteCategorizationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategorizationRuleResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteCategorizationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListCategorizationRule(ctx context.Context, in *ListCategorizationRuleRequest, opts ...grpc.CallOption) (*ListCategorizationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategorizationRuleResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListCategorizationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) MergeCategory(ctx context.Context, in *MergeCategoryRequest, opts ...grpc.CallOption) (*MergeCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCategoryResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_MergeCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListCategoryKeyword(ctx context.Context, in *ListCategoryKeywordRequest, opts ...grpc.CallOption) (*ListCategoryKeywordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryKeywordResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListCategoryKeyword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) AddCategoryKeyword(ctx context.Context, in *AddCategoryKeywordRequest, opts ...grpc.CallOption) (*AddCategoryKeywordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCategoryKeywordResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_AddCategoryKeyword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteCategoryKeyword(ctx context.Context, in *DeleteCategoryKeywordRequest, opts ...grpc.CallOption) (*DeleteCategoryKeywordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryKeywordResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteCategoryKeyword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListCategorySuggestion(ctx context.Context, in *ListCategorySuggestionRequest, opts ...grpc.CallOption) (*ListCategorySuggestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategorySuggestionResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListCategorySuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) AcceptCategorySuggestion(ctx context.Context, in *AcceptCategorySuggestionRequest, opts ...grpc.CallOption) (*AcceptCategorySuggestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptCategorySuggestionResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_AcceptCategorySuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) RejectCategorySuggestion(ctx context.Context, in *RejectCategorySuggestionRequest, opts ...grpc.CallOption) (*RejectCategorySuggestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectCategorySuggestionResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_RejectCategorySuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) TrainCategorizationModel(ctx context.Context, in *TrainCategorizationModelRequest, opts ...grpc.CallOption) (*TrainCategorizationModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrainCategorizationModelResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_TrainCategorizationModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) GetCategorizationModel(ctx context.Context, in *GetCategorizationModelRequest, opts ...grpc.CallOption) (*GetCategorizationModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategorizationModelResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_GetCategorizationModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListTransactionCategoryPrediction(ctx context.Context, in *ListTransactionCategoryPredictionRequest, opts ...grpc.CallOption) (*ListTransactionCategoryPredictionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionCategoryPredictionResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListTransactionCategoryPrediction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) RecategorizeTransactions(ctx context.Context, in *RecategorizeTransactionsRequest, opts ...grpc.CallOption) (*RecategorizeTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecategorizeTransactionsResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_RecategorizeTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListProviderCategoryMapping(ctx context.Context, in *ListProviderCategoryMappingRequest, opts ...grpc.CallOption) (*ListProviderCategoryMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProviderCategoryMappingResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListProviderCategoryMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateProviderCategoryMapping(ctx context.Context, in *CreateProviderCategoryMappingRequest, opts ...grpc.CallOption) (*CreateProviderCategoryMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProviderCategoryMappingResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateProviderCategoryMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateProviderCategoryMapping(ctx context.Context, in *UpdateProviderCategoryMappingRequest, opts ...grpc.CallOption) (*UpdateProviderCategoryMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProviderCategoryMappingResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateProviderCategoryMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteProviderCategoryMapping(ctx context.Context, in *DeleteProviderCategoryMappingRequest, opts ...grpc.CallOption) (*DeleteProviderCategoryMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProviderCategoryMappingResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteProviderCategoryMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ExplainTransactionCategory(ctx context.Context, in *ExplainTransactionCategoryRequest, opts ...grpc.CallOption) (*ExplainTransactionCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainTransactionCategoryResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ExplainTransactionCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ReloadCaches(ctx context.Context, in *ReloadCachesRequest, opts ...grpc.CallOption) (*ReloadCachesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadCachesResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ReloadCaches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) ListBankHeader(ctx context.Context, in *ListBankHeaderRequest, opts ...grpc.CallOption) (*ListBankHeaderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBankHeaderResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_ListBankHeader_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) CreateBankHeader(ctx context.Context, in *CreateBankHeaderRequest, opts ...grpc.CallOption) (*CreateBankHeaderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBankHeaderResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_CreateBankHeader_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateBankHeader(ctx context.Context, in *UpdateBankHeaderRequest, opts ...grpc.CallOption) (*UpdateBankHeaderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBankHeaderResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateBankHeader_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteBankHeader(ctx context.Context, in *DeleteBankHeaderRequest, opts ...grpc.CallOption) (*DeleteBankHeaderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBankHeaderResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteBankHeader_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) GetBankParsingProfile(ctx context.Context, in *GetBankParsingProfileRequest, opts ...grpc.CallOption) (*GetBankParsingProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBankParsingProfileResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_GetBankParsingProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) UpdateBankParsingProfile(ctx context.Context, in *UpdateBankParsingProfileRequest, opts ...grpc.CallOption) (*UpdateBankParsingProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBankParsingProfileResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_UpdateBankParsingProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finAggregatorServiceClient) DeleteBankParsingProfile(ctx context.Context, in *DeleteBankParsingProfileRequest, opts ...grpc.CallOption) (*DeleteBankParsingProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBankParsingProfileResponse)
+	err := c.cc.Invoke(ctx, FinAggregatorService_DeleteBankParsingProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FinAggregatorServiceServer is the server API for FinAggregatorService service.
 // All implementations must embed UnimplementedFinAggregatorServiceServer
 // for forward compatibility.
@@ -182,264 +1071,1892 @@ type FinAggregatorServiceServer interface {
 	ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error)
 	ListCategory(context.Context, *ListCategoryRequest) (*ListCategoryResponse, error)
 	ListTransactionType(context.Context, *ListTransactionTypeRequest) (*ListTransactionTypeResponse, error)
+	GetSpendingInsights(context.Context, *GetSpendingInsightsRequest) (*GetSpendingInsightsResponse, error)
+	MarkSharedExpense(context.Context, *MarkSharedExpenseRequest) (*MarkSharedExpenseResponse, error)
+	UnmarkSharedExpense(context.Context, *UnmarkSharedExpenseRequest) (*UnmarkSharedExpenseResponse, error)
+	ListSharedExpense(context.Context, *ListSharedExpenseRequest) (*ListSharedExpenseResponse, error)
+	GetUserBalances(context.Context, *GetUserBalancesRequest) (*GetUserBalancesResponse, error)
+	GetSettleUpSuggestions(context.Context, *GetSettleUpSuggestionsRequest) (*GetSettleUpSuggestionsResponse, error)
+	RecordSettlement(context.Context, *RecordSettlementRequest) (*RecordSettlementResponse, error)
+	ListSettlement(context.Context, *ListSettlementRequest) (*ListSettlementResponse, error)
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	ListAccount(context.Context, *ListAccountRequest) (*ListAccountResponse, error)
+	ListAccountType(context.Context, *ListAccountTypeRequest) (*ListAccountTypeResponse, error)
+	CreateBalanceSnapshot(context.Context, *CreateBalanceSnapshotRequest) (*CreateBalanceSnapshotResponse, error)
+	ListBalanceSnapshot(context.Context, *ListBalanceSnapshotRequest) (*ListBalanceSnapshotResponse, error)
+	DeleteBalanceSnapshot(context.Context, *DeleteBalanceSnapshotRequest) (*DeleteBalanceSnapshotResponse, error)
+	ReconcileAccount(context.Context, *ReconcileAccountRequest) (*ReconcileAccountResponse, error)
+	CreateAsset(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error)
+	UpdateAsset(context.Context, *UpdateAssetRequest) (*UpdateAssetResponse, error)
+	DeleteAsset(context.Context, *DeleteAssetRequest) (*DeleteAssetResponse, error)
+	ListAsset(context.Context, *ListAssetRequest) (*ListAssetResponse, error)
+	AddAssetValuation(context.Context, *AddAssetValuationRequest) (*AddAssetValuationResponse, error)
+	ListAssetValuation(context.Context, *ListAssetValuationRequest) (*ListAssetValuationResponse, error)
+	GetNetWorthHistory(context.Context, *GetNetWorthHistoryRequest) (*GetNetWorthHistoryResponse, error)
+	CreateSavingsGoal(context.Context, *CreateSavingsGoalRequest) (*CreateSavingsGoalResponse, error)
+	UpdateSavingsGoal(context.Context, *UpdateSavingsGoalRequest) (*UpdateSavingsGoalResponse, error)
+	DeleteSavingsGoal(context.Context, *DeleteSavingsGoalRequest) (*DeleteSavingsGoalResponse, error)
+	ListSavingsGoal(context.Context, *ListSavingsGoalRequest) (*ListSavingsGoalResponse, error)
+	GetSavingsGoalStatus(context.Context, *GetSavingsGoalStatusRequest) (*GetSavingsGoalStatusResponse, error)
+	GenerateReport(context.Context, *GenerateReportRequest) (*httpbody.HttpBody, error)
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
+	UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleResponse, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	ListAlertRule(context.Context, *ListAlertRuleRequest) (*ListAlertRuleResponse, error)
+	TestAlertRule(context.Context, *TestAlertRuleRequest) (*TestAlertRuleResponse, error)
+	ListAlert(context.Context, *ListAlertRequest) (*ListAlertResponse, error)
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	UpdateWebhookSubscription(context.Context, *UpdateWebhookSubscriptionRequest) (*UpdateWebhookSubscriptionResponse, error)
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookSubscription(context.Context, *ListWebhookSubscriptionRequest) (*ListWebhookSubscriptionResponse, error)
+	ListWebhookDelivery(context.Context, *ListWebhookDeliveryRequest) (*ListWebhookDeliveryResponse, error)
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error)
+	CreateCategorizationRule(context.Context, *CreateCategorizationRuleRequest) (*CreateCategorizationRuleResponse, error)
+	UpdateCategorizationRule(context.Context, *UpdateCategorizationRuleRequest) (*UpdateCategorizationRuleResponse, error)
+	DeleteCategorizationRule(context.Context, *DeleteCategorizationRuleRequest) (*DeleteCategorizationRuleResponse, error)
+	ListCategorizationRule(context.Context, *ListCategorizationRuleRequest) (*ListCategorizationRuleResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	MergeCategory(context.Context, *MergeCategoryRequest) (*MergeCategoryResponse, error)
+	ListCategoryKeyword(context.Context, *ListCategoryKeywordRequest) (*ListCategoryKeywordResponse, error)
+	AddCategoryKeyword(context.Context, *AddCategoryKeywordRequest) (*AddCategoryKeywordResponse, error)
+	DeleteCategoryKeyword(context.Context, *DeleteCategoryKeywordRequest) (*DeleteCategoryKeywordResponse, error)
+	ListCategorySuggestion(context.Context, *ListCategorySuggestionRequest) (*ListCategorySuggestionResponse, error)
+	AcceptCategorySuggestion(context.Context, *AcceptCategorySuggestionRequest) (*AcceptCategorySuggestionResponse, error)
+	RejectCategorySuggestion(context.Context, *RejectCategorySuggestionRequest) (*RejectCategorySuggestionResponse, error)
+	TrainCategorizationModel(context.Context, *TrainCategorizationModelRequest) (*TrainCategorizationModelResponse, error)
+	GetCategorizationModel(context.Context, *GetCategorizationModelRequest) (*GetCategorizationModelResponse, error)
+	ListTransactionCategoryPrediction(context.Context, *ListTransactionCategoryPredictionRequest) (*ListTransactionCategoryPredictionResponse, error)
+	RecategorizeTransactions(context.Context, *RecategorizeTransactionsRequest) (*RecategorizeTransactionsResponse, error)
+	ListProviderCategoryMapping(context.Context, *ListProviderCategoryMappingRequest) (*ListProviderCategoryMappingResponse, error)
+	CreateProviderCategoryMapping(context.Context, *CreateProviderCategoryMappingRequest) (*CreateProviderCategoryMappingResponse, error)
+	UpdateProviderCategoryMapping(context.Context, *UpdateProviderCategoryMappingRequest) (*UpdateProviderCategoryMappingResponse, error)
+	DeleteProviderCategoryMapping(context.Context, *DeleteProviderCategoryMappingRequest) (*DeleteProviderCategoryMappingResponse, error)
+	ExplainTransactionCategory(context.Context, *ExplainTransactionCategoryRequest) (*ExplainTransactionCategoryResponse, error)
+	ReloadCaches(context.Context, *ReloadCachesRequest) (*ReloadCachesResponse, error)
+	ListBankHeader(context.Context, *ListBankHeaderRequest) (*ListBankHeaderResponse, error)
+	CreateBankHeader(context.Context, *CreateBankHeaderRequest) (*CreateBankHeaderResponse, error)
+	UpdateBankHeader(context.Context, *UpdateBankHeaderRequest) (*UpdateBankHeaderResponse, error)
+	DeleteBankHeader(context.Context, *DeleteBankHeaderRequest) (*DeleteBankHeaderResponse, error)
+	GetBankParsingProfile(context.Context, *GetBankParsingProfileRequest) (*GetBankParsingProfileResponse, error)
+	UpdateBankParsingProfile(context.Context, *UpdateBankParsingProfileRequest) (*UpdateBankParsingProfileResponse, error)
+	DeleteBankParsingProfile(context.Context, *DeleteBankParsingProfileRequest) (*DeleteBankParsingProfileResponse, error)
+	mustEmbedUnimplementedFinAggregatorServiceServer()
+}
+
+// UnimplementedFinAggregatorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFinAggregatorServiceServer struct{}
+
+func (UnimplementedFinAggregatorServiceServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransaction not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetMonzoAuthURL(context.Context, *GetMonzoAuthURLRequest) (*GetMonzoAuthURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonzoAuthURL not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) MonzoCallback(context.Context, *MonzoCallbackRequest) (*MonzoCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonzoCallback not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetMonzoAccount(context.Context, *MonzoAccountRequest) (*MonzoAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonzoAccount not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) LoadMonzoTransactions(context.Context, *LoadMonzoTransactionsRequest) (*LoadMonzoTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadMonzoTransactions not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UploadCSV(context.Context, *UploadCSVRequest) (*UploadCSVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadCSV not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListBank(context.Context, *ListBankRequest) (*ListBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBank not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUser not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListCategory(context.Context, *ListCategoryRequest) (*ListCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategory not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListTransactionType(context.Context, *ListTransactionTypeRequest) (*ListTransactionTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactionType not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetSpendingInsights(context.Context, *GetSpendingInsightsRequest) (*GetSpendingInsightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingInsights not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) MarkSharedExpense(context.Context, *MarkSharedExpenseRequest) (*MarkSharedExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkSharedExpense not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UnmarkSharedExpense(context.Context, *UnmarkSharedExpenseRequest) (*UnmarkSharedExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmarkSharedExpense not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListSharedExpense(context.Context, *ListSharedExpenseRequest) (*ListSharedExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedExpense not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetUserBalances(context.Context, *GetUserBalancesRequest) (*GetUserBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBalances not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetSettleUpSuggestions(context.Context, *GetSettleUpSuggestionsRequest) (*GetSettleUpSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettleUpSuggestions not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) RecordSettlement(context.Context, *RecordSettlementRequest) (*RecordSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSettlement not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListSettlement(context.Context, *ListSettlementRequest) (*ListSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSettlement not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListAccount(context.Context, *ListAccountRequest) (*ListAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccount not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListAccountType(context.Context, *ListAccountTypeRequest) (*ListAccountTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountType not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateBalanceSnapshot(context.Context, *CreateBalanceSnapshotRequest) (*CreateBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBalanceSnapshot not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListBalanceSnapshot(context.Context, *ListBalanceSnapshotRequest) (*ListBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalanceSnapshot not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteBalanceSnapshot(context.Context, *DeleteBalanceSnapshotRequest) (*DeleteBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBalanceSnapshot not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ReconcileAccount(context.Context, *ReconcileAccountRequest) (*ReconcileAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileAccount not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateAsset(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAsset not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateAsset(context.Context, *UpdateAssetRequest) (*UpdateAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAsset not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteAsset(context.Context, *DeleteAssetRequest) (*DeleteAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAsset not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListAsset(context.Context, *ListAssetRequest) (*ListAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAsset not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) AddAssetValuation(context.Context, *AddAssetValuationRequest) (*AddAssetValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAssetValuation not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListAssetValuation(context.Context, *ListAssetValuationRequest) (*ListAssetValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssetValuation not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetNetWorthHistory(context.Context, *GetNetWorthHistoryRequest) (*GetNetWorthHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetWorthHistory not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateSavingsGoal(context.Context, *CreateSavingsGoalRequest) (*CreateSavingsGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavingsGoal not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateSavingsGoal(context.Context, *UpdateSavingsGoalRequest) (*UpdateSavingsGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavingsGoal not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteSavingsGoal(context.Context, *DeleteSavingsGoalRequest) (*DeleteSavingsGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavingsGoal not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListSavingsGoal(context.Context, *ListSavingsGoalRequest) (*ListSavingsGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavingsGoal not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetSavingsGoalStatus(context.Context, *GetSavingsGoalStatusRequest) (*GetSavingsGoalStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavingsGoalStatus not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GenerateReport(context.Context, *GenerateReportRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateReport not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListAlertRule(context.Context, *ListAlertRuleRequest) (*ListAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRule not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) TestAlertRule(context.Context, *TestAlertRuleRequest) (*TestAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestAlertRule not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListAlert(context.Context, *ListAlertRequest) (*ListAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlert not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateWebhookSubscription(context.Context, *UpdateWebhookSubscriptionRequest) (*UpdateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhookSubscription not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListWebhookSubscription(context.Context, *ListWebhookSubscriptionRequest) (*ListWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscription not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListWebhookDelivery(context.Context, *ListWebhookDeliveryRequest) (*ListWebhookDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDelivery not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateCategorizationRule(context.Context, *CreateCategorizationRuleRequest) (*CreateCategorizationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategorizationRule not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateCategorizationRule(context.Context, *UpdateCategorizationRuleRequest) (*UpdateCategorizationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategorizationRule not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteCategorizationRule(context.Context, *DeleteCategorizationRuleRequest) (*DeleteCategorizationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategorizationRule not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListCategorizationRule(context.Context, *ListCategorizationRuleRequest) (*ListCategorizationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategorizationRule not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) MergeCategory(context.Context, *MergeCategoryRequest) (*MergeCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCategory not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListCategoryKeyword(context.Context, *ListCategoryKeywordRequest) (*ListCategoryKeywordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryKeyword not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) AddCategoryKeyword(context.Context, *AddCategoryKeywordRequest) (*AddCategoryKeywordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCategoryKeyword not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteCategoryKeyword(context.Context, *DeleteCategoryKeywordRequest) (*DeleteCategoryKeywordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryKeyword not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListCategorySuggestion(context.Context, *ListCategorySuggestionRequest) (*ListCategorySuggestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategorySuggestion not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) AcceptCategorySuggestion(context.Context, *AcceptCategorySuggestionRequest) (*AcceptCategorySuggestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCategorySuggestion not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) RejectCategorySuggestion(context.Context, *RejectCategorySuggestionRequest) (*RejectCategorySuggestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCategorySuggestion not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) TrainCategorizationModel(context.Context, *TrainCategorizationModelRequest) (*TrainCategorizationModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrainCategorizationModel not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetCategorizationModel(context.Context, *GetCategorizationModelRequest) (*GetCategorizationModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategorizationModel not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListTransactionCategoryPrediction(context.Context, *ListTransactionCategoryPredictionRequest) (*ListTransactionCategoryPredictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactionCategoryPrediction not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) RecategorizeTransactions(context.Context, *RecategorizeTransactionsRequest) (*RecategorizeTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecategorizeTransactions not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListProviderCategoryMapping(context.Context, *ListProviderCategoryMappingRequest) (*ListProviderCategoryMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviderCategoryMapping not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateProviderCategoryMapping(context.Context, *CreateProviderCategoryMappingRequest) (*CreateProviderCategoryMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProviderCategoryMapping not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateProviderCategoryMapping(context.Context, *UpdateProviderCategoryMappingRequest) (*UpdateProviderCategoryMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProviderCategoryMapping not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteProviderCategoryMapping(context.Context, *DeleteProviderCategoryMappingRequest) (*DeleteProviderCategoryMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProviderCategoryMapping not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ExplainTransactionCategory(context.Context, *ExplainTransactionCategoryRequest) (*ExplainTransactionCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainTransactionCategory not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ReloadCaches(context.Context, *ReloadCachesRequest) (*ReloadCachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadCaches not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) ListBankHeader(context.Context, *ListBankHeaderRequest) (*ListBankHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBankHeader not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) CreateBankHeader(context.Context, *CreateBankHeaderRequest) (*CreateBankHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBankHeader not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateBankHeader(context.Context, *UpdateBankHeaderRequest) (*UpdateBankHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankHeader not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteBankHeader(context.Context, *DeleteBankHeaderRequest) (*DeleteBankHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBankHeader not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) GetBankParsingProfile(context.Context, *GetBankParsingProfileRequest) (*GetBankParsingProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBankParsingProfile not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) UpdateBankParsingProfile(context.Context, *UpdateBankParsingProfileRequest) (*UpdateBankParsingProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankParsingProfile not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) DeleteBankParsingProfile(context.Context, *DeleteBankParsingProfileRequest) (*DeleteBankParsingProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBankParsingProfile not implemented")
+}
+func (UnimplementedFinAggregatorServiceServer) mustEmbedUnimplementedFinAggregatorServiceServer() {}
+func (UnimplementedFinAggregatorServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeFinAggregatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FinAggregatorServiceServer will
+// result in compilation errors.
+type UnsafeFinAggregatorServiceServer interface {
 	mustEmbedUnimplementedFinAggregatorServiceServer()
 }
 
-// UnimplementedFinAggregatorServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedFinAggregatorServiceServer struct{}
+func RegisterFinAggregatorServiceServer(s grpc.ServiceRegistrar, srv FinAggregatorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFinAggregatorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FinAggregatorService_ServiceDesc, srv)
+}
+
+func _FinAggregatorService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_UpdateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UpdateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UpdateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UpdateTransaction(ctx, req.(*UpdateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GetMonzoAuthURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMonzoAuthURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetMonzoAuthURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetMonzoAuthURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetMonzoAuthURL(ctx, req.(*GetMonzoAuthURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_MonzoCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonzoCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).MonzoCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_MonzoCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).MonzoCallback(ctx, req.(*MonzoCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GetMonzoAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonzoAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetMonzoAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetMonzoAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetMonzoAccount(ctx, req.(*MonzoAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_LoadMonzoTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadMonzoTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).LoadMonzoTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_LoadMonzoTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).LoadMonzoTransactions(ctx, req.(*LoadMonzoTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_UploadCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadCSVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UploadCSV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UploadCSV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UploadCSV(ctx, req.(*UploadCSVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListBank(ctx, req.(*ListBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListUser(ctx, req.(*ListUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListCategory(ctx, req.(*ListCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListTransactionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListTransactionType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListTransactionType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListTransactionType(ctx, req.(*ListTransactionTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GetSpendingInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingInsightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetSpendingInsights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetSpendingInsights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetSpendingInsights(ctx, req.(*GetSpendingInsightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_MarkSharedExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkSharedExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).MarkSharedExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_MarkSharedExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).MarkSharedExpense(ctx, req.(*MarkSharedExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_UnmarkSharedExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmarkSharedExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UnmarkSharedExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UnmarkSharedExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UnmarkSharedExpense(ctx, req.(*UnmarkSharedExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListSharedExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListSharedExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListSharedExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListSharedExpense(ctx, req.(*ListSharedExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GetUserBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetUserBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetUserBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetUserBalances(ctx, req.(*GetUserBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GetSettleUpSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettleUpSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetSettleUpSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetSettleUpSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetSettleUpSuggestions(ctx, req.(*GetSettleUpSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_RecordSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).RecordSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_RecordSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).RecordSettlement(ctx, req.(*RecordSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListSettlement(ctx, req.(*ListSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UpdateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListAccount(ctx, req.(*ListAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListAccountType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListAccountType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListAccountType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListAccountType(ctx, req.(*ListAccountTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_CreateBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBalanceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).CreateBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_CreateBalanceSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).CreateBalanceSnapshot(ctx, req.(*CreateBalanceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalanceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListBalanceSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListBalanceSnapshot(ctx, req.(*ListBalanceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_DeleteBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBalanceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).DeleteBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_DeleteBalanceSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).DeleteBalanceSnapshot(ctx, req.(*DeleteBalanceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ReconcileAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ReconcileAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ReconcileAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ReconcileAccount(ctx, req.(*ReconcileAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_CreateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).CreateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_CreateAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).CreateAsset(ctx, req.(*CreateAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_UpdateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UpdateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UpdateAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UpdateAsset(ctx, req.(*UpdateAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_DeleteAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).DeleteAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_DeleteAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).DeleteAsset(ctx, req.(*DeleteAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListAsset(ctx, req.(*ListAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_AddAssetValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAssetValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).AddAssetValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_AddAssetValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).AddAssetValuation(ctx, req.(*AddAssetValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListAssetValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssetValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListAssetValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListAssetValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListAssetValuation(ctx, req.(*ListAssetValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GetNetWorthHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetWorthHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetNetWorthHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetNetWorthHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetNetWorthHistory(ctx, req.(*GetNetWorthHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_CreateSavingsGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavingsGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).CreateSavingsGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_CreateSavingsGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).CreateSavingsGoal(ctx, req.(*CreateSavingsGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_UpdateSavingsGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavingsGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UpdateSavingsGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UpdateSavingsGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UpdateSavingsGoal(ctx, req.(*UpdateSavingsGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_DeleteSavingsGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavingsGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).DeleteSavingsGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_DeleteSavingsGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).DeleteSavingsGoal(ctx, req.(*DeleteSavingsGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListSavingsGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavingsGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListSavingsGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListSavingsGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListSavingsGoal(ctx, req.(*ListSavingsGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GetSavingsGoalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavingsGoalStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetSavingsGoalStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetSavingsGoalStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetSavingsGoalStatus(ctx, req.(*GetSavingsGoalStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GenerateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GenerateReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GenerateReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GenerateReport(ctx, req.(*GenerateReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UpdateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UpdateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UpdateAlertRule(ctx, req.(*UpdateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListAlertRule(ctx, req.(*ListAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_TestAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).TestAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_TestAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).TestAlertRule(ctx, req.(*TestAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListAlert(ctx, req.(*ListAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_UpdateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UpdateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UpdateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UpdateWebhookSubscription(ctx, req.(*UpdateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListWebhookSubscription(ctx, req.(*ListWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListWebhookDelivery(ctx, req.(*ListWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedFinAggregatorServiceServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+func _FinAggregatorService_CreateCategorizationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategorizationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).CreateCategorizationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_CreateCategorizationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).CreateCategorizationRule(ctx, req.(*CreateCategorizationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransaction not implemented")
+
+func _FinAggregatorService_UpdateCategorizationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategorizationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UpdateCategorizationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UpdateCategorizationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UpdateCategorizationRule(ctx, req.(*UpdateCategorizationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) GetMonzoAuthURL(context.Context, *GetMonzoAuthURLRequest) (*GetMonzoAuthURLResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMonzoAuthURL not implemented")
+
+func _FinAggregatorService_DeleteCategorizationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategorizationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).DeleteCategorizationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_DeleteCategorizationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).DeleteCategorizationRule(ctx, req.(*DeleteCategorizationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) MonzoCallback(context.Context, *MonzoCallbackRequest) (*MonzoCallbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MonzoCallback not implemented")
+
+func _FinAggregatorService_ListCategorizationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategorizationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListCategorizationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListCategorizationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListCategorizationRule(ctx, req.(*ListCategorizationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) GetMonzoAccount(context.Context, *MonzoAccountRequest) (*MonzoAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMonzoAccount not implemented")
+
+func _FinAggregatorService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) LoadMonzoTransactions(context.Context, *LoadMonzoTransactionsRequest) (*LoadMonzoTransactionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoadMonzoTransactions not implemented")
+
+func _FinAggregatorService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) UploadCSV(context.Context, *UploadCSVRequest) (*UploadCSVResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadCSV not implemented")
+
+func _FinAggregatorService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) ListBank(context.Context, *ListBankRequest) (*ListBankResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBank not implemented")
+
+func _FinAggregatorService_MergeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).MergeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_MergeCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).MergeCategory(ctx, req.(*MergeCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUser not implemented")
+
+func _FinAggregatorService_ListCategoryKeyword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryKeywordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListCategoryKeyword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListCategoryKeyword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListCategoryKeyword(ctx, req.(*ListCategoryKeywordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) ListCategory(context.Context, *ListCategoryRequest) (*ListCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCategory not implemented")
+
+func _FinAggregatorService_AddCategoryKeyword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCategoryKeywordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).AddCategoryKeyword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_AddCategoryKeyword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).AddCategoryKeyword(ctx, req.(*AddCategoryKeywordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_DeleteCategoryKeyword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryKeywordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).DeleteCategoryKeyword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_DeleteCategoryKeyword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).DeleteCategoryKeyword(ctx, req.(*DeleteCategoryKeywordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListCategorySuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategorySuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListCategorySuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListCategorySuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListCategorySuggestion(ctx, req.(*ListCategorySuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_AcceptCategorySuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCategorySuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).AcceptCategorySuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_AcceptCategorySuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).AcceptCategorySuggestion(ctx, req.(*AcceptCategorySuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_RejectCategorySuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectCategorySuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).RejectCategorySuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_RejectCategorySuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).RejectCategorySuggestion(ctx, req.(*RejectCategorySuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_TrainCategorizationModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrainCategorizationModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).TrainCategorizationModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_TrainCategorizationModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).TrainCategorizationModel(ctx, req.(*TrainCategorizationModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_GetCategorizationModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategorizationModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).GetCategorizationModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_GetCategorizationModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).GetCategorizationModel(ctx, req.(*GetCategorizationModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinAggregatorService_ListTransactionCategoryPrediction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionCategoryPredictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListTransactionCategoryPrediction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListTransactionCategoryPrediction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListTransactionCategoryPrediction(ctx, req.(*ListTransactionCategoryPredictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) ListTransactionType(context.Context, *ListTransactionTypeRequest) (*ListTransactionTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTransactionType not implemented")
+
+func _FinAggregatorService_RecategorizeTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecategorizeTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).RecategorizeTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_RecategorizeTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).RecategorizeTransactions(ctx, req.(*RecategorizeTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinAggregatorServiceServer) mustEmbedUnimplementedFinAggregatorServiceServer() {}
-func (UnimplementedFinAggregatorServiceServer) testEmbeddedByValue()                              {}
 
-// UnsafeFinAggregatorServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to FinAggregatorServiceServer will
-// result in compilation errors.
-type UnsafeFinAggregatorServiceServer interface {
-	mustEmbedUnimplementedFinAggregatorServiceServer()
+func _FinAggregatorService_ListProviderCategoryMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderCategoryMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).ListProviderCategoryMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_ListProviderCategoryMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).ListProviderCategoryMapping(ctx, req.(*ListProviderCategoryMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterFinAggregatorServiceServer(s grpc.ServiceRegistrar, srv FinAggregatorServiceServer) {
-	// If the following call pancis, it indicates UnimplementedFinAggregatorServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _FinAggregatorService_CreateProviderCategoryMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProviderCategoryMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&FinAggregatorService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(FinAggregatorServiceServer).CreateProviderCategoryMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinAggregatorService_CreateProviderCategoryMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinAggregatorServiceServer).CreateProviderCategoryMapping(ctx, req.(*CreateProviderCategoryMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionsRequest)
+func _FinAggregatorService_UpdateProviderCategoryMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProviderCategoryMappingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).GetTransactions(ctx, in)
+		return srv.(FinAggregatorServiceServer).UpdateProviderCategoryMapping(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_GetTransactions_FullMethodName,
+		FullMethod: FinAggregatorService_UpdateProviderCategoryMapping_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+		return srv.(FinAggregatorServiceServer).UpdateProviderCategoryMapping(ctx, req.(*UpdateProviderCategoryMappingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_UpdateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTransactionRequest)
+func _FinAggregatorService_DeleteProviderCategoryMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProviderCategoryMappingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).UpdateTransaction(ctx, in)
+		return srv.(FinAggregatorServiceServer).DeleteProviderCategoryMapping(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_UpdateTransaction_FullMethodName,
+		FullMethod: FinAggregatorService_DeleteProviderCategoryMapping_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).UpdateTransaction(ctx, req.(*UpdateTransactionRequest))
+		return srv.(FinAggregatorServiceServer).DeleteProviderCategoryMapping(ctx, req.(*DeleteProviderCategoryMappingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_GetMonzoAuthURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMonzoAuthURLRequest)
+func _FinAggregatorService_ExplainTransactionCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainTransactionCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).GetMonzoAuthURL(ctx, in)
+		return srv.(FinAggregatorServiceServer).ExplainTransactionCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_GetMonzoAuthURL_FullMethodName,
+		FullMethod: FinAggregatorService_ExplainTransactionCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).GetMonzoAuthURL(ctx, req.(*GetMonzoAuthURLRequest))
+		return srv.(FinAggregatorServiceServer).ExplainTransactionCategory(ctx, req.(*ExplainTransactionCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_MonzoCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MonzoCallbackRequest)
+func _FinAggregatorService_ReloadCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadCachesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).MonzoCallback(ctx, in)
+		return srv.(FinAggregatorServiceServer).ReloadCaches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_MonzoCallback_FullMethodName,
+		FullMethod: FinAggregatorService_ReloadCaches_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).MonzoCallback(ctx, req.(*MonzoCallbackRequest))
+		return srv.(FinAggregatorServiceServer).ReloadCaches(ctx, req.(*ReloadCachesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_GetMonzoAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MonzoAccountRequest)
+func _FinAggregatorService_ListBankHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankHeaderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).GetMonzoAccount(ctx, in)
+		return srv.(FinAggregatorServiceServer).ListBankHeader(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_GetMonzoAccount_FullMethodName,
+		FullMethod: FinAggregatorService_ListBankHeader_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).GetMonzoAccount(ctx, req.(*MonzoAccountRequest))
+		return srv.(FinAggregatorServiceServer).ListBankHeader(ctx, req.(*ListBankHeaderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_LoadMonzoTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadMonzoTransactionsRequest)
+func _FinAggregatorService_CreateBankHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBankHeaderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).LoadMonzoTransactions(ctx, in)
+		return srv.(FinAggregatorServiceServer).CreateBankHeader(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_LoadMonzoTransactions_FullMethodName,
+		FullMethod: FinAggregatorService_CreateBankHeader_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).LoadMonzoTransactions(ctx, req.(*LoadMonzoTransactionsRequest))
+		return srv.(FinAggregatorServiceServer).CreateBankHeader(ctx, req.(*CreateBankHeaderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_UploadCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadCSVRequest)
+func _FinAggregatorService_UpdateBankHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBankHeaderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).UploadCSV(ctx, in)
+		return srv.(FinAggregatorServiceServer).UpdateBankHeader(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_UploadCSV_FullMethodName,
+		FullMethod: FinAggregatorService_UpdateBankHeader_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).UploadCSV(ctx, req.(*UploadCSVRequest))
+		return srv.(FinAggregatorServiceServer).UpdateBankHeader(ctx, req.(*UpdateBankHeaderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_ListBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBankRequest)
+func _FinAggregatorService_DeleteBankHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBankHeaderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).ListBank(ctx, in)
+		return srv.(FinAggregatorServiceServer).DeleteBankHeader(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_ListBank_FullMethodName,
+		FullMethod: FinAggregatorService_DeleteBankHeader_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).ListBank(ctx, req.(*ListBankRequest))
+		return srv.(FinAggregatorServiceServer).DeleteBankHeader(ctx, req.(*DeleteBankHeaderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_ListUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserRequest)
+func _FinAggregatorService_GetBankParsingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBankParsingProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).ListUser(ctx, in)
+		return srv.(FinAggregatorServiceServer).GetBankParsingProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_ListUser_FullMethodName,
+		FullMethod: FinAggregatorService_GetBankParsingProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).ListUser(ctx, req.(*ListUserRequest))
+		return srv.(FinAggregatorServiceServer).GetBankParsingProfile(ctx, req.(*GetBankParsingProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_ListCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCategoryRequest)
+func _FinAggregatorService_UpdateBankParsingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBankParsingProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).ListCategory(ctx, in)
+		return srv.(FinAggregatorServiceServer).UpdateBankParsingProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_ListCategory_FullMethodName,
+		FullMethod: FinAggregatorService_UpdateBankParsingProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).ListCategory(ctx, req.(*ListCategoryRequest))
+		return srv.(FinAggregatorServiceServer).UpdateBankParsingProfile(ctx, req.(*UpdateBankParsingProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinAggregatorService_ListTransactionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTransactionTypeRequest)
+func _FinAggregatorService_DeleteBankParsingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBankParsingProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinAggregatorServiceServer).ListTransactionType(ctx, in)
+		return srv.(FinAggregatorServiceServer).DeleteBankParsingProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinAggregatorService_ListTransactionType_FullMethodName,
+		FullMethod: FinAggregatorService_DeleteBankParsingProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinAggregatorServiceServer).ListTransactionType(ctx, req.(*ListTransactionTypeRequest))
+		return srv.(FinAggregatorServiceServer).DeleteBankParsingProfile(ctx, req.(*DeleteBankParsingProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -495,6 +3012,302 @@ var FinAggregatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTransactionType",
 			Handler:    _FinAggregatorService_ListTransactionType_Handler,
 		},
+		{
+			MethodName: "GetSpendingInsights",
+			Handler:    _FinAggregatorService_GetSpendingInsights_Handler,
+		},
+		{
+			MethodName: "MarkSharedExpense",
+			Handler:    _FinAggregatorService_MarkSharedExpense_Handler,
+		},
+		{
+			MethodName: "UnmarkSharedExpense",
+			Handler:    _FinAggregatorService_UnmarkSharedExpense_Handler,
+		},
+		{
+			MethodName: "ListSharedExpense",
+			Handler:    _FinAggregatorService_ListSharedExpense_Handler,
+		},
+		{
+			MethodName: "GetUserBalances",
+			Handler:    _FinAggregatorService_GetUserBalances_Handler,
+		},
+		{
+			MethodName: "GetSettleUpSuggestions",
+			Handler:    _FinAggregatorService_GetSettleUpSuggestions_Handler,
+		},
+		{
+			MethodName: "RecordSettlement",
+			Handler:    _FinAggregatorService_RecordSettlement_Handler,
+		},
+		{
+			MethodName: "ListSettlement",
+			Handler:    _FinAggregatorService_ListSettlement_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _FinAggregatorService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _FinAggregatorService_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _FinAggregatorService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _FinAggregatorService_GetAccount_Handler,
+		},
+		{
+			MethodName: "ListAccount",
+			Handler:    _FinAggregatorService_ListAccount_Handler,
+		},
+		{
+			MethodName: "ListAccountType",
+			Handler:    _FinAggregatorService_ListAccountType_Handler,
+		},
+		{
+			MethodName: "CreateBalanceSnapshot",
+			Handler:    _FinAggregatorService_CreateBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "ListBalanceSnapshot",
+			Handler:    _FinAggregatorService_ListBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteBalanceSnapshot",
+			Handler:    _FinAggregatorService_DeleteBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "ReconcileAccount",
+			Handler:    _FinAggregatorService_ReconcileAccount_Handler,
+		},
+		{
+			MethodName: "CreateAsset",
+			Handler:    _FinAggregatorService_CreateAsset_Handler,
+		},
+		{
+			MethodName: "UpdateAsset",
+			Handler:    _FinAggregatorService_UpdateAsset_Handler,
+		},
+		{
+			MethodName: "DeleteAsset",
+			Handler:    _FinAggregatorService_DeleteAsset_Handler,
+		},
+		{
+			MethodName: "ListAsset",
+			Handler:    _FinAggregatorService_ListAsset_Handler,
+		},
+		{
+			MethodName: "AddAssetValuation",
+			Handler:    _FinAggregatorService_AddAssetValuation_Handler,
+		},
+		{
+			MethodName: "ListAssetValuation",
+			Handler:    _FinAggregatorService_ListAssetValuation_Handler,
+		},
+		{
+			MethodName: "GetNetWorthHistory",
+			Handler:    _FinAggregatorService_GetNetWorthHistory_Handler,
+		},
+		{
+			MethodName: "CreateSavingsGoal",
+			Handler:    _FinAggregatorService_CreateSavingsGoal_Handler,
+		},
+		{
+			MethodName: "UpdateSavingsGoal",
+			Handler:    _FinAggregatorService_UpdateSavingsGoal_Handler,
+		},
+		{
+			MethodName: "DeleteSavingsGoal",
+			Handler:    _FinAggregatorService_DeleteSavingsGoal_Handler,
+		},
+		{
+			MethodName: "ListSavingsGoal",
+			Handler:    _FinAggregatorService_ListSavingsGoal_Handler,
+		},
+		{
+			MethodName: "GetSavingsGoalStatus",
+			Handler:    _FinAggregatorService_GetSavingsGoalStatus_Handler,
+		},
+		{
+			MethodName: "GenerateReport",
+			Handler:    _FinAggregatorService_GenerateReport_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _FinAggregatorService_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _FinAggregatorService_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _FinAggregatorService_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRule",
+			Handler:    _FinAggregatorService_ListAlertRule_Handler,
+		},
+		{
+			MethodName: "TestAlertRule",
+			Handler:    _FinAggregatorService_TestAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlert",
+			Handler:    _FinAggregatorService_ListAlert_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _FinAggregatorService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "UpdateWebhookSubscription",
+			Handler:    _FinAggregatorService_UpdateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _FinAggregatorService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscription",
+			Handler:    _FinAggregatorService_ListWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDelivery",
+			Handler:    _FinAggregatorService_ListWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _FinAggregatorService_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "CreateCategorizationRule",
+			Handler:    _FinAggregatorService_CreateCategorizationRule_Handler,
+		},
+		{
+			MethodName: "UpdateCategorizationRule",
+			Handler:    _FinAggregatorService_UpdateCategorizationRule_Handler,
+		},
+		{
+			MethodName: "DeleteCategorizationRule",
+			Handler:    _FinAggregatorService_DeleteCategorizationRule_Handler,
+		},
+		{
+			MethodName: "ListCategorizationRule",
+			Handler:    _FinAggregatorService_ListCategorizationRule_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _FinAggregatorService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _FinAggregatorService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _FinAggregatorService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "MergeCategory",
+			Handler:    _FinAggregatorService_MergeCategory_Handler,
+		},
+		{
+			MethodName: "ListCategoryKeyword",
+			Handler:    _FinAggregatorService_ListCategoryKeyword_Handler,
+		},
+		{
+			MethodName: "AddCategoryKeyword",
+			Handler:    _FinAggregatorService_AddCategoryKeyword_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryKeyword",
+			Handler:    _FinAggregatorService_DeleteCategoryKeyword_Handler,
+		},
+		{
+			MethodName: "ListCategorySuggestion",
+			Handler:    _FinAggregatorService_ListCategorySuggestion_Handler,
+		},
+		{
+			MethodName: "AcceptCategorySuggestion",
+			Handler:    _FinAggregatorService_AcceptCategorySuggestion_Handler,
+		},
+		{
+			MethodName: "RejectCategorySuggestion",
+			Handler:    _FinAggregatorService_RejectCategorySuggestion_Handler,
+		},
+		{
+			MethodName: "TrainCategorizationModel",
+			Handler:    _FinAggregatorService_TrainCategorizationModel_Handler,
+		},
+		{
+			MethodName: "GetCategorizationModel",
+			Handler:    _FinAggregatorService_GetCategorizationModel_Handler,
+		},
+		{
+			MethodName: "ListTransactionCategoryPrediction",
+			Handler:    _FinAggregatorService_ListTransactionCategoryPrediction_Handler,
+		},
+		{
+			MethodName: "RecategorizeTransactions",
+			Handler:    _FinAggregatorService_RecategorizeTransactions_Handler,
+		},
+		{
+			MethodName: "ListProviderCategoryMapping",
+			Handler:    _FinAggregatorService_ListProviderCategoryMapping_Handler,
+		},
+		{
+			MethodName: "CreateProviderCategoryMapping",
+			Handler:    _FinAggregatorService_CreateProviderCategoryMapping_Handler,
+		},
+		{
+			MethodName: "UpdateProviderCategoryMapping",
+			Handler:    _FinAggregatorService_UpdateProviderCategoryMapping_Handler,
+		},
+		{
+			MethodName: "DeleteProviderCategoryMapping",
+			Handler:    _FinAggregatorService_DeleteProviderCategoryMapping_Handler,
+		},
+		{
+			MethodName: "ExplainTransactionCategory",
+			Handler:    _FinAggregatorService_ExplainTransactionCategory_Handler,
+		},
+		{
+			MethodName: "ReloadCaches",
+			Handler:    _FinAggregatorService_ReloadCaches_Handler,
+		},
+		{
+			MethodName: "ListBankHeader",
+			Handler:    _FinAggregatorService_ListBankHeader_Handler,
+		},
+		{
+			MethodName: "CreateBankHeader",
+			Handler:    _FinAggregatorService_CreateBankHeader_Handler,
+		},
+		{
+			MethodName: "UpdateBankHeader",
+			Handler:    _FinAggregatorService_UpdateBankHeader_Handler,
+		},
+		{
+			MethodName: "DeleteBankHeader",
+			Handler:    _FinAggregatorService_DeleteBankHeader_Handler,
+		},
+		{
+			MethodName: "GetBankParsingProfile",
+			Handler:    _FinAggregatorService_GetBankParsingProfile_Handler,
+		},
+		{
+			MethodName: "UpdateBankParsingProfile",
+			Handler:    _FinAggregatorService_UpdateBankParsingProfile_Handler,
+		},
+		{
+			MethodName: "DeleteBankParsingProfile",
+			Handler:    _FinAggregatorService_DeleteBankParsingProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/fin-aggregate-service/fin-aggregate-service.proto",